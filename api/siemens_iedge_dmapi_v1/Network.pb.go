@@ -21,6 +21,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -33,10 +34,307 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Serialization of a ConfigurationDocument.
+type DocumentFormat int32
+
+const (
+	DocumentFormat_JSON DocumentFormat = 0
+	DocumentFormat_YAML DocumentFormat = 1
+)
+
+// Enum value maps for DocumentFormat.
+var (
+	DocumentFormat_name = map[int32]string{
+		0: "JSON",
+		1: "YAML",
+	}
+	DocumentFormat_value = map[string]int32{
+		"JSON": 0,
+		"YAML": 1,
+	}
+)
+
+func (x DocumentFormat) Enum() *DocumentFormat {
+	p := new(DocumentFormat)
+	*p = x
+	return p
+}
+
+func (x DocumentFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DocumentFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_Network_proto_enumTypes[0].Descriptor()
+}
+
+func (DocumentFormat) Type() protoreflect.EnumType {
+	return &file_Network_proto_enumTypes[0]
+}
+
+func (x DocumentFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DocumentFormat.Descriptor instead.
+func (DocumentFormat) EnumDescriptor() ([]byte, []int) {
+	return file_Network_proto_rawDescGZIP(), []int{0}
+}
+
+// ReconcileMode defines how the service handles drift of the interface from the settings applied last.
+type Interface_ReconcileMode int32
+
+const (
+	Interface_OBSERVE Interface_ReconcileMode = 0 // Drift is only reported by GetDrift. Default.
+	Interface_ENFORCE Interface_ReconcileMode = 1 // Drift is reported and the applied settings are restored automatically.
+)
+
+// Enum value maps for Interface_ReconcileMode.
+var (
+	Interface_ReconcileMode_name = map[int32]string{
+		0: "OBSERVE",
+		1: "ENFORCE",
+	}
+	Interface_ReconcileMode_value = map[string]int32{
+		"OBSERVE": 0,
+		"ENFORCE": 1,
+	}
+)
+
+func (x Interface_ReconcileMode) Enum() *Interface_ReconcileMode {
+	p := new(Interface_ReconcileMode)
+	*p = x
+	return p
+}
+
+func (x Interface_ReconcileMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Interface_ReconcileMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_Network_proto_enumTypes[1].Descriptor()
+}
+
+func (Interface_ReconcileMode) Type() protoreflect.EnumType {
+	return &file_Network_proto_enumTypes[1]
+}
+
+func (x Interface_ReconcileMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Interface_ReconcileMode.Descriptor instead.
+func (Interface_ReconcileMode) EnumDescriptor() ([]byte, []int) {
+	return file_Network_proto_rawDescGZIP(), []int{3, 0}
+}
+
+// AutoMode defines how the DNS servers obtained by DHCP are combined with the servers above.
+type Interface_Dns_AutoMode int32
+
+const (
+	Interface_Dns_DEFAULT Interface_Dns_AutoMode = 0 // The DNS servers of DHCP are ignored if DHCP is used and DNS settings are given. Default.
+	Interface_Dns_IGNORE  Interface_Dns_AutoMode = 1 // Only the servers above are used.
+	Interface_Dns_MERGE   Interface_Dns_AutoMode = 2 // The servers above are used before the ones of DHCP.
+)
+
+// Enum value maps for Interface_Dns_AutoMode.
+var (
+	Interface_Dns_AutoMode_name = map[int32]string{
+		0: "DEFAULT",
+		1: "IGNORE",
+		2: "MERGE",
+	}
+	Interface_Dns_AutoMode_value = map[string]int32{
+		"DEFAULT": 0,
+		"IGNORE":  1,
+		"MERGE":   2,
+	}
+)
+
+func (x Interface_Dns_AutoMode) Enum() *Interface_Dns_AutoMode {
+	p := new(Interface_Dns_AutoMode)
+	*p = x
+	return p
+}
+
+func (x Interface_Dns_AutoMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Interface_Dns_AutoMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_Network_proto_enumTypes[2].Descriptor()
+}
+
+func (Interface_Dns_AutoMode) Type() protoreflect.EnumType {
+	return &file_Network_proto_enumTypes[2]
+}
+
+func (x Interface_Dns_AutoMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Interface_Dns_AutoMode.Descriptor instead.
+func (Interface_Dns_AutoMode) EnumDescriptor() ([]byte, []int) {
+	return file_Network_proto_rawDescGZIP(), []int{3, 1, 0}
+}
+
+// ForeignProfilePolicy defines how connection profiles, which were not created by this service, are handled.
+type ApplyOptions_ForeignProfilePolicy int32
+
+const (
+	ApplyOptions_DELETE  ApplyOptions_ForeignProfilePolicy = 0 // Foreign profiles of a configured interface are replaced by the profile of this service. Default.
+	ApplyOptions_LEAVE   ApplyOptions_ForeignProfilePolicy = 1 // Foreign profiles are left unchanged, the profile of this service is activated instead.
+	ApplyOptions_DISABLE ApplyOptions_ForeignProfilePolicy = 2 // Foreign profiles are kept, but autoconnect is turned off, so they are only activated manually, e.g. for maintenance.
+)
+
+// Enum value maps for ApplyOptions_ForeignProfilePolicy.
+var (
+	ApplyOptions_ForeignProfilePolicy_name = map[int32]string{
+		0: "DELETE",
+		1: "LEAVE",
+		2: "DISABLE",
+	}
+	ApplyOptions_ForeignProfilePolicy_value = map[string]int32{
+		"DELETE":  0,
+		"LEAVE":   1,
+		"DISABLE": 2,
+	}
+)
+
+func (x ApplyOptions_ForeignProfilePolicy) Enum() *ApplyOptions_ForeignProfilePolicy {
+	p := new(ApplyOptions_ForeignProfilePolicy)
+	*p = x
+	return p
+}
+
+func (x ApplyOptions_ForeignProfilePolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ApplyOptions_ForeignProfilePolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_Network_proto_enumTypes[3].Descriptor()
+}
+
+func (ApplyOptions_ForeignProfilePolicy) Type() protoreflect.EnumType {
+	return &file_Network_proto_enumTypes[3]
+}
+
+func (x ApplyOptions_ForeignProfilePolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ApplyOptions_ForeignProfilePolicy.Descriptor instead.
+func (ApplyOptions_ForeignProfilePolicy) EnumDescriptor() ([]byte, []int) {
+	return file_Network_proto_rawDescGZIP(), []int{12, 0}
+}
+
+type ConnectivityCheck_CheckType int32
+
+const (
+	ConnectivityCheck_GATEWAY    ConnectivityCheck_CheckType = 0 // The gateway answers ICMP echo requests or ARP requests.
+	ConnectivityCheck_DNS        ConnectivityCheck_CheckType = 1 // The name resolves.
+	ConnectivityCheck_TCP        ConnectivityCheck_CheckType = 2 // A TCP connection to the endpoint can be established.
+	ConnectivityCheck_DHCP_LEASE ConnectivityCheck_CheckType = 3 // The interface obtained a DHCP lease.
+)
+
+// Enum value maps for ConnectivityCheck_CheckType.
+var (
+	ConnectivityCheck_CheckType_name = map[int32]string{
+		0: "GATEWAY",
+		1: "DNS",
+		2: "TCP",
+		3: "DHCP_LEASE",
+	}
+	ConnectivityCheck_CheckType_value = map[string]int32{
+		"GATEWAY":    0,
+		"DNS":        1,
+		"TCP":        2,
+		"DHCP_LEASE": 3,
+	}
+)
+
+func (x ConnectivityCheck_CheckType) Enum() *ConnectivityCheck_CheckType {
+	p := new(ConnectivityCheck_CheckType)
+	*p = x
+	return p
+}
+
+func (x ConnectivityCheck_CheckType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ConnectivityCheck_CheckType) Descriptor() protoreflect.EnumDescriptor {
+	return file_Network_proto_enumTypes[4].Descriptor()
+}
+
+func (ConnectivityCheck_CheckType) Type() protoreflect.EnumType {
+	return &file_Network_proto_enumTypes[4]
+}
+
+func (x ConnectivityCheck_CheckType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ConnectivityCheck_CheckType.Descriptor instead.
+func (ConnectivityCheck_CheckType) EnumDescriptor() ([]byte, []int) {
+	return file_Network_proto_rawDescGZIP(), []int{13, 0}
+}
+
+// Reason why the profile is reported.
+type ProfileFinding_Reason int32
+
+const (
+	ProfileFinding_DUPLICATE      ProfileFinding_Reason = 0 // Another profile of this service exists for the same device. The active or most recently used profile is kept.
+	ProfileFinding_ORPHAN         ProfileFinding_Reason = 1 // No device with the MAC address or interface name of the profile exists.
+	ProfileFinding_LABEL_CONFLICT ProfileFinding_Reason = 2 // The profile was created for a label, which is not in the label map or is mapped to another interface now.
+)
+
+// Enum value maps for ProfileFinding_Reason.
+var (
+	ProfileFinding_Reason_name = map[int32]string{
+		0: "DUPLICATE",
+		1: "ORPHAN",
+		2: "LABEL_CONFLICT",
+	}
+	ProfileFinding_Reason_value = map[string]int32{
+		"DUPLICATE":      0,
+		"ORPHAN":         1,
+		"LABEL_CONFLICT": 2,
+	}
+)
+
+func (x ProfileFinding_Reason) Enum() *ProfileFinding_Reason {
+	p := new(ProfileFinding_Reason)
+	*p = x
+	return p
+}
+
+func (x ProfileFinding_Reason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ProfileFinding_Reason) Descriptor() protoreflect.EnumDescriptor {
+	return file_Network_proto_enumTypes[5].Descriptor()
+}
+
+func (ProfileFinding_Reason) Type() protoreflect.EnumType {
+	return &file_Network_proto_enumTypes[5]
+}
+
+func (x ProfileFinding_Reason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ProfileFinding_Reason.Descriptor instead.
+func (ProfileFinding_Reason) EnumDescriptor() ([]byte, []int) {
+	return file_Network_proto_rawDescGZIP(), []int{35, 0}
+}
+
 // Contains MAC address, used for retrieving specified Network Interface settings.
 type NetworkInterfaceRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Mac           string                 `protobuf:"bytes,1,opt,name=mac,proto3" json:"mac,omitempty"`
+	FieldMask     *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask,omitempty"` // Optional. Paths of Interface fields to resolve, e.g: "DHCP", "Static", "L2Conf". Empty means all fields.
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -78,10 +376,18 @@ func (x *NetworkInterfaceRequest) GetMac() string {
 	return ""
 }
 
+func (x *NetworkInterfaceRequest) GetFieldMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.FieldMask
+	}
+	return nil
+}
+
 // Returns an Network Interface with
 type NetworkInterfaceRequestWithLabel struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Label         string                 `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	FieldMask     *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask,omitempty"` // Optional. Paths of Interface fields to resolve, e.g: "DHCP", "Static", "L2Conf". Empty means all fields.
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -97,10 +403,3130 @@ func (x *NetworkInterfaceRequestWithLabel) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NetworkInterfaceRequestWithLabel) ProtoMessage() {}
+func (*NetworkInterfaceRequestWithLabel) ProtoMessage() {}
+
+func (x *NetworkInterfaceRequestWithLabel) ProtoReflect() protoreflect.Message {
+	mi := &file_Network_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetworkInterfaceRequestWithLabel.ProtoReflect.Descriptor instead.
+func (*NetworkInterfaceRequestWithLabel) Descriptor() ([]byte, []int) {
+	return file_Network_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *NetworkInterfaceRequestWithLabel) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *NetworkInterfaceRequestWithLabel) GetFieldMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.FieldMask
+	}
+	return nil
+}
+
+// Used for retrieving the settings of all ethernet typed network interfaces with a subset of fields.
+type NetworkSettingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FieldMask     *fieldmaskpb.FieldMask `protobuf:"bytes,1,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask,omitempty"` // Optional. Paths are relative to Interface and applied to every returned interface. Empty means all fields.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NetworkSettingsRequest) Reset() {
+	*x = NetworkSettingsRequest{}
+	mi := &file_Network_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NetworkSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetworkSettingsRequest) ProtoMessage() {}
+
+func (x *NetworkSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_Network_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetworkSettingsRequest.ProtoReflect.Descriptor instead.
+func (*NetworkSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_Network_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *NetworkSettingsRequest) GetFieldMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.FieldMask
+	}
+	return nil
+}
+
+// Interface type holds settings for a Network Interface.
+type Interface struct {
+	state            protoimpl.MessageState  `protogen:"open.v1"`
+	GatewayInterface bool                    `protobuf:"varint,1,opt,name=GatewayInterface,proto3" json:"GatewayInterface,omitempty"` // if true, route metric will be set to 1. Otherwise route metric is -1. Similarly, when the interface is requested,return value will be true if route metric is 1.
+	MacAddress       string                  `protobuf:"bytes,2,opt,name=MacAddress,proto3" json:"MacAddress,omitempty"`              // "20:87:56:b5:ed:e0"
+	DHCP             string                  `protobuf:"bytes,3,opt,name=DHCP,proto3" json:"DHCP,omitempty"`                          // values can be 'enabled', 'disabled' or 'fallback'. for compatiblity reasons it is not boolean. 'fallback' uses DHCP and switches to the Fallback settings if no DHCP server answers.
+	Static           *Interface_StaticConf   `protobuf:"bytes,4,opt,name=Static,proto3" json:"Static,omitempty"`                      // Static field is StaticConf type instance.
+	DNSConfig        *Interface_Dns          `protobuf:"bytes,5,opt,name=DNSConfig,proto3" json:"DNSConfig,omitempty"`                // DNSConfig is dns type instance.
+	L2Conf           *Interface_L2           `protobuf:"bytes,6,opt,name=L2Conf,proto3" json:"L2Conf,omitempty"`
+	InterfaceName    string                  `protobuf:"bytes,7,opt,name=InterfaceName,proto3" json:"InterfaceName,omitempty"`                                                       // ens2p
+	Label            string                  `protobuf:"bytes,8,opt,name=Label,proto3" json:"Label,omitempty"`                                                                       // x1
+	Generation       uint64                  `protobuf:"varint,9,opt,name=Generation,proto3" json:"Generation,omitempty"`                                                            // Read only. Generation of the service's interface snapshot in which this interface changed last. 0 if the snapshot is not used.
+	ResourceVersion  string                  `protobuf:"bytes,10,opt,name=ResourceVersion,proto3" json:"ResourceVersion,omitempty"`                                                  // Opaque version of the interface's connection profiles and label. If set in ApplySettings, the request is rejected with ABORTED when the interface changed since it was read.
+	ProfileOwner     string                  `protobuf:"bytes,11,opt,name=ProfileOwner,proto3" json:"ProfileOwner,omitempty"`                                                        // Read only. Owner of the interface's connection profile: "dm-network" if it was created by this service, "foreign" if it was created by another tool, empty if there is no profile.
+	Reconcile        Interface_ReconcileMode `protobuf:"varint,12,opt,name=Reconcile,proto3,enum=siemens.iedge.dmapi.network.v1.Interface_ReconcileMode" json:"Reconcile,omitempty"` // Optional. Set in ApplySettings, the read RPCs return the default.
+	Routes           []*Interface_Route      `protobuf:"bytes,13,rep,name=Routes,proto3" json:"Routes,omitempty"`                                                                    // Static routes. If empty in ApplySettings, the routes of the existing profile are kept.
+	Fallback         *Interface_FallbackConf `protobuf:"bytes,14,opt,name=Fallback,proto3" json:"Fallback,omitempty"`                                                                // Settings of DHCP 'fallback'. Ignored for the other DHCP values.
+	AddressSource    string                  `protobuf:"bytes,15,opt,name=AddressSource,proto3" json:"AddressSource,omitempty"`                                                      // Read only. Source of the IPv4 address in effect: "dhcp", "static" or "link-local".
+	DHCPOptions      *Interface_DHCPConf     `protobuf:"bytes,16,opt,name=DHCPOptions,proto3" json:"DHCPOptions,omitempty"`                                                          // Options of the DHCP client, used with DHCP 'enabled' or 'fallback'.
+	Lease            *DHCPLease              `protobuf:"bytes,17,opt,name=Lease,proto3" json:"Lease,omitempty"`                                                                      // Read only. Lease of the DHCP client, empty if the interface has no lease.
+	Link             *Interface_LinkConf     `protobuf:"bytes,18,opt,name=Link,proto3" json:"Link,omitempty"`
+	LinkStatus       *Interface_LinkState    `protobuf:"bytes,19,opt,name=LinkStatus,proto3" json:"LinkStatus,omitempty"` // Read only. Live link values of the interface.
+	Vlans            *Interface_VlanList     `protobuf:"bytes,20,opt,name=Vlans,proto3" json:"Vlans,omitempty"`           // If set in ApplySettings, it replaces the VLANs of this service on the interface, an empty list removes them. VLANs are kept if not set.
+	Bond             string                  `protobuf:"bytes,21,opt,name=Bond,proto3" json:"Bond,omitempty"`             // Read only. Name of the bond the interface is a member of, its IP settings are the ones of the bond.
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Interface) Reset() {
+	*x = Interface{}
+	mi := &file_Network_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Interface) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Interface) ProtoMessage() {}
+
+func (x *Interface) ProtoReflect() protoreflect.Message {
+	mi := &file_Network_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Interface.ProtoReflect.Descriptor instead.
+func (*Interface) Descriptor() ([]byte, []int) {
+	return file_Network_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Interface) GetGatewayInterface() bool {
+	if x != nil {
+		return x.GatewayInterface
+	}
+	return false
+}
+
+func (x *Interface) GetMacAddress() string {
+	if x != nil {
+		return x.MacAddress
+	}
+	return ""
+}
+
+func (x *Interface) GetDHCP() string {
+	if x != nil {
+		return x.DHCP
+	}
+	return ""
+}
+
+func (x *Interface) GetStatic() *Interface_StaticConf {
+	if x != nil {
+		return x.Static
+	}
+	return nil
+}
+
+func (x *Interface) GetDNSConfig() *Interface_Dns {
+	if x != nil {
+		return x.DNSConfig
+	}
+	return nil
+}
+
+func (x *Interface) GetL2Conf() *Interface_L2 {
+	if x != nil {
+		return x.L2Conf
+	}
+	return nil
+}
+
+func (x *Interface) GetInterfaceName() string {
+	if x != nil {
+		return x.InterfaceName
+	}
+	return ""
+}
+
+func (x *Interface) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *Interface) GetGeneration() uint64 {
+	if x != nil {
+		return x.Generation
+	}
+	return 0
+}
+
+func (x *Interface) GetResourceVersion() string {
+	if x != nil {
+		return x.ResourceVersion
+	}
+	return ""
+}
+
+func (x *Interface) GetProfileOwner() string {
+	if x != nil {
+		return x.ProfileOwner
+	}
+	return ""
+}
+
+func (x *Interface) GetReconcile() Interface_ReconcileMode {
+	if x != nil {
+		return x.Reconcile
+	}
+	return Interface_OBSERVE
+}
+
+func (x *Interface) GetRoutes() []*Interface_Route {
+	if x != nil {
+		return x.Routes
+	}
+	return nil
+}
+
+func (x *Interface) GetFallback() *Interface_FallbackConf {
+	if x != nil {
+		return x.Fallback
+	}
+	return nil
+}
+
+func (x *Interface) GetAddressSource() string {
+	if x != nil {
+		return x.AddressSource
+	}
+	return ""
+}
+
+func (x *Interface) GetDHCPOptions() *Interface_DHCPConf {
+	if x != nil {
+		return x.DHCPOptions
+	}
+	return nil
+}
+
+func (x *Interface) GetLease() *DHCPLease {
+	if x != nil {
+		return x.Lease
+	}
+	return nil
+}
+
+func (x *Interface) GetLink() *Interface_LinkConf {
+	if x != nil {
+		return x.Link
+	}
+	return nil
+}
+
+func (x *Interface) GetLinkStatus() *Interface_LinkState {
+	if x != nil {
+		return x.LinkStatus
+	}
+	return nil
+}
+
+func (x *Interface) GetVlans() *Interface_VlanList {
+	if x != nil {
+		return x.Vlans
+	}
+	return nil
+}
+
+func (x *Interface) GetBond() string {
+	if x != nil {
+		return x.Bond
+	}
+	return ""
+}
+
+// Bond joins Ethernet interfaces to one interface with one IP configuration, e.g. two ports in active-backup mode for a redundant uplink.
+type Bond struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`       // Interface name of the bond, at most 15 characters, e.g: "bond0"
+	Mode          string                 `protobuf:"bytes,2,opt,name=Mode,proto3" json:"Mode,omitempty"`       // values can be 'active-backup', 'balance-rr', 'balance-xor', 'broadcast', '802.3ad', 'balance-tlb' or 'balance-alb'. 'active-backup' if empty.
+	Members       []string               `protobuf:"bytes,3,rep,name=Members,proto3" json:"Members,omitempty"` // Member interfaces by label or MAC address, e.g: ["X1", "X2"]. Read back by label if they have one.
+	MiiMon        uint32                 `protobuf:"varint,4,opt,name=MiiMon,proto3" json:"MiiMon,omitempty"`  // Interval of the link monitoring in milliseconds, 100 if zero.
+	Primary       string                 `protobuf:"bytes,5,opt,name=Primary,proto3" json:"Primary,omitempty"` // Optional. Member, by label or MAC address, which carries the traffic while it has link. Only for 'active-backup', 'balance-tlb' and 'balance-alb'.
+	DHCP          string                 `protobuf:"bytes,6,opt,name=DHCP,proto3" json:"DHCP,omitempty"`       // values can be 'enabled' or 'disabled'
+	Static        *Interface_StaticConf  `protobuf:"bytes,7,opt,name=Static,proto3" json:"Static,omitempty"`
+	DNSConfig     *Interface_Dns         `protobuf:"bytes,8,opt,name=DNSConfig,proto3" json:"DNSConfig,omitempty"`
+	Status        *Bond_BondState        `protobuf:"bytes,9,opt,name=Status,proto3" json:"Status,omitempty"` // Read only. Live state of the bond, not set if it is not there.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Bond) Reset() {
+	*x = Bond{}
+	mi := &file_Network_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Bond) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Bond) ProtoMessage() {}
+
+func (x *Bond) ProtoReflect() protoreflect.Message {
+	mi := &file_Network_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Bond.ProtoReflect.Descriptor instead.
+func (*Bond) Descriptor() ([]byte, []int) {
+	return file_Network_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Bond) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Bond) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *Bond) GetMembers() []string {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *Bond) GetMiiMon() uint32 {
+	if x != nil {
+		return x.MiiMon
+	}
+	return 0
+}
+
+func (x *Bond) GetPrimary() string {
+	if x != nil {
+		return x.Primary
+	}
+	return ""
+}
+
+func (x *Bond) GetDHCP() string {
+	if x != nil {
+		return x.DHCP
+	}
+	return ""
+}
+
+func (x *Bond) GetStatic() *Interface_StaticConf {
+	if x != nil {
+		return x.Static
+	}
+	return nil
+}
+
+func (x *Bond) GetDNSConfig() *Interface_Dns {
+	if x != nil {
+		return x.DNSConfig
+	}
+	return nil
+}
+
+func (x *Bond) GetStatus() *Bond_BondState {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+// Type that contains the bonds of the device.
+type BondList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Interfaces    []*Bond                `protobuf:"bytes,1,rep,name=Interfaces,proto3" json:"Interfaces,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BondList) Reset() {
+	*x = BondList{}
+	mi := &file_Network_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BondList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BondList) ProtoMessage() {}
+
+func (x *BondList) ProtoReflect() protoreflect.Message {
+	mi := &file_Network_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BondList.ProtoReflect.Descriptor instead.
+func (*BondList) Descriptor() ([]byte, []int) {
+	return file_Network_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *BondList) GetInterfaces() []*Bond {
+	if x != nil {
+		return x.Interfaces
+	}
+	return nil
+}
+
+// DHCPLease is the lease the DHCP client of an interface obtained, as reported by NetworkManager.
+type DHCPLease struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Server        string                 `protobuf:"bytes,1,opt,name=Server,proto3" json:"Server,omitempty"`                                                                             // DHCP server identifier, e.g: 192.168.0.1
+	LeaseTime     uint32                 `protobuf:"varint,2,opt,name=LeaseTime,proto3" json:"LeaseTime,omitempty"`                                                                      // Lease time in seconds.
+	Expiry        int64                  `protobuf:"varint,3,opt,name=Expiry,proto3" json:"Expiry,omitempty"`                                                                            // Unix time the lease expires at.
+	Options       map[string]string      `protobuf:"bytes,4,rep,name=Options,proto3" json:"Options,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // All received options, e.g: "ntp_servers", "domain_name", "vendor_encapsulated_options" (option 43).
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DHCPLease) Reset() {
+	*x = DHCPLease{}
+	mi := &file_Network_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DHCPLease) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DHCPLease) ProtoMessage() {}
+
+func (x *DHCPLease) ProtoReflect() protoreflect.Message {
+	mi := &file_Network_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DHCPLease.ProtoReflect.Descriptor instead.
+func (*DHCPLease) Descriptor() ([]byte, []int) {
+	return file_Network_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DHCPLease) GetServer() string {
+	if x != nil {
+		return x.Server
+	}
+	return ""
+}
+
+func (x *DHCPLease) GetLeaseTime() uint32 {
+	if x != nil {
+		return x.LeaseTime
+	}
+	return 0
+}
+
+func (x *DHCPLease) GetExpiry() int64 {
+	if x != nil {
+		return x.Expiry
+	}
+	return 0
+}
+
+func (x *DHCPLease) GetOptions() map[string]string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+// Used for renewing or releasing the DHCP lease of an interface, given by MAC address or label.
+type DHCPLeaseRequest struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Mac                   string                 `protobuf:"bytes,1,opt,name=mac,proto3" json:"mac,omitempty"`
+	Label                 string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	AllowManagementChange bool                   `protobuf:"varint,3,opt,name=AllowManagementChange,proto3" json:"AllowManagementChange,omitempty"` // Release only. Allows to release the lease of the interface the caller is connected through.
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *DHCPLeaseRequest) Reset() {
+	*x = DHCPLeaseRequest{}
+	mi := &file_Network_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DHCPLeaseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DHCPLeaseRequest) ProtoMessage() {}
+
+func (x *DHCPLeaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_Network_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DHCPLeaseRequest.ProtoReflect.Descriptor instead.
+func (*DHCPLeaseRequest) Descriptor() ([]byte, []int) {
+	return file_Network_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DHCPLeaseRequest) GetMac() string {
+	if x != nil {
+		return x.Mac
+	}
+	return ""
+}
+
+func (x *DHCPLeaseRequest) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *DHCPLeaseRequest) GetAllowManagementChange() bool {
+	if x != nil {
+		return x.AllowManagementChange
+	}
+	return false
+}
+
+// HostEntry maps host names to an address in the hosts file, e.g. for PLCs which are in no DNS.
+type HostEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IP            string                 `protobuf:"bytes,1,opt,name=IP,proto3" json:"IP,omitempty"`       // e.g: "192.168.0.10"
+	Names         []string               `protobuf:"bytes,2,rep,name=Names,proto3" json:"Names,omitempty"` // e.g: ["plc-line1", "plc-line1.plant.local"]
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HostEntry) Reset() {
+	*x = HostEntry{}
+	mi := &file_Network_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HostEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HostEntry) ProtoMessage() {}
+
+func (x *HostEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_Network_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HostEntry.ProtoReflect.Descriptor instead.
+func (*HostEntry) Descriptor() ([]byte, []int) {
+	return file_Network_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *HostEntry) GetIP() string {
+	if x != nil {
+		return x.IP
+	}
+	return ""
+}
+
+func (x *HostEntry) GetNames() []string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+// HostEntries are the static host entries of this service. Entries of other tools in the hosts file are not included.
+type HostEntries struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*HostEntry           `protobuf:"bytes,1,rep,name=Entries,proto3" json:"Entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HostEntries) Reset() {
+	*x = HostEntries{}
+	mi := &file_Network_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HostEntries) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HostEntries) ProtoMessage() {}
+
+func (x *HostEntries) ProtoReflect() protoreflect.Message {
+	mi := &file_Network_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HostEntries.ProtoReflect.Descriptor instead.
+func (*HostEntries) Descriptor() ([]byte, []int) {
+	return file_Network_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *HostEntries) GetEntries() []*HostEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+// GlobalDns is the global DNS configuration of NetworkManager. If it has servers, they are used instead of the DNS
+// settings of all interfaces.
+type GlobalDns struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Servers       []string               `protobuf:"bytes,1,rep,name=Servers,proto3" json:"Servers,omitempty"`    // e.g: ["10.0.0.53", "10.0.1.53"]
+	Searches      []string               `protobuf:"bytes,2,rep,name=Searches,proto3" json:"Searches,omitempty"`  // Search domains, e.g: ["plant.local"]
+	Timeout       uint32                 `protobuf:"varint,3,opt,name=Timeout,proto3" json:"Timeout,omitempty"`   // Seconds the resolver waits for an answer (resolv.conf option timeout, at most 30). The resolver's default if zero.
+	Attempts      uint32                 `protobuf:"varint,4,opt,name=Attempts,proto3" json:"Attempts,omitempty"` // Number of queries sent to each server (resolv.conf option attempts, at most 5). The resolver's default if zero.
+	Rotate        bool                   `protobuf:"varint,5,opt,name=Rotate,proto3" json:"Rotate,omitempty"`     // if true, the servers are queried in turn (resolv.conf option rotate).
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GlobalDns) Reset() {
+	*x = GlobalDns{}
+	mi := &file_Network_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GlobalDns) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GlobalDns) ProtoMessage() {}
+
+func (x *GlobalDns) ProtoReflect() protoreflect.Message {
+	mi := &file_Network_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GlobalDns.ProtoReflect.Descriptor instead.
+func (*GlobalDns) Descriptor() ([]byte, []int) {
+	return file_Network_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GlobalDns) GetServers() []string {
+	if x != nil {
+		return x.Servers
+	}
+	return nil
+}
+
+func (x *GlobalDns) GetSearches() []string {
+	if x != nil {
+		return x.Searches
+	}
+	return nil
+}
+
+func (x *GlobalDns) GetTimeout() uint32 {
+	if x != nil {
+		return x.Timeout
+	}
+	return 0
+}
+
+func (x *GlobalDns) GetAttempts() uint32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *GlobalDns) GetRotate() bool {
+	if x != nil {
+		return x.Rotate
+	}
+	return false
+}
+
+// ResolverStatus is the effective resolver configuration of the device.
+type ResolverStatus struct {
+	state         protoimpl.MessageState           `protogen:"open.v1"`
+	Mode          string                           `protobuf:"bytes,1,opt,name=Mode,proto3" json:"Mode,omitempty"`               // DNS processing mode of NetworkManager, e.g: "default", "systemd-resolved", "dnsmasq"
+	RcManager     string                           `protobuf:"bytes,2,opt,name=RcManager,proto3" json:"RcManager,omitempty"`     // How NetworkManager writes the resolver configuration file, e.g: "symlink", "file", "unmanaged"
+	Global        *GlobalDns                       `protobuf:"bytes,3,opt,name=Global,proto3" json:"Global,omitempty"`           // Global DNS configuration, empty if there is none.
+	Sources       []*ResolverStatus_ResolverSource `protobuf:"bytes,4,rep,name=Sources,proto3" json:"Sources,omitempty"`         // in the order they are used
+	Servers       []string                         `protobuf:"bytes,5,rep,name=Servers,proto3" json:"Servers,omitempty"`         // DNS servers of the resolver configuration file, e.g: ["127.0.0.53"] with systemd-resolved
+	Searches      []string                         `protobuf:"bytes,6,rep,name=Searches,proto3" json:"Searches,omitempty"`       // Search domains of the resolver configuration file
+	Options       []string                         `protobuf:"bytes,7,rep,name=Options,proto3" json:"Options,omitempty"`         // Options of the resolver configuration file, e.g: ["timeout:2", "rotate"]
+	HostEntries   []*HostEntry                     `protobuf:"bytes,8,rep,name=HostEntries,proto3" json:"HostEntries,omitempty"` // Static host entries of this service
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolverStatus) Reset() {
+	*x = ResolverStatus{}
+	mi := &file_Network_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolverStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolverStatus) ProtoMessage() {}
+
+func (x *ResolverStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_Network_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolverStatus.ProtoReflect.Descriptor instead.
+func (*ResolverStatus) Descriptor() ([]byte, []int) {
+	return file_Network_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ResolverStatus) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *ResolverStatus) GetRcManager() string {
+	if x != nil {
+		return x.RcManager
+	}
+	return ""
+}
+
+func (x *ResolverStatus) GetGlobal() *GlobalDns {
+	if x != nil {
+		return x.Global
+	}
+	return nil
+}
+
+func (x *ResolverStatus) GetSources() []*ResolverStatus_ResolverSource {
+	if x != nil {
+		return x.Sources
+	}
+	return nil
+}
+
+func (x *ResolverStatus) GetServers() []string {
+	if x != nil {
+		return x.Servers
+	}
+	return nil
+}
+
+func (x *ResolverStatus) GetSearches() []string {
+	if x != nil {
+		return x.Searches
+	}
+	return nil
+}
+
+func (x *ResolverStatus) GetOptions() []string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *ResolverStatus) GetHostEntries() []*HostEntry {
+	if x != nil {
+		return x.HostEntries
+	}
+	return nil
+}
+
+// ApplyOptions controls how ApplySettings treats the existing configuration of a device.
+type ApplyOptions struct {
+	state           protoimpl.MessageState            `protogen:"open.v1"`
+	ForeignProfiles ApplyOptions_ForeignProfilePolicy `protobuf:"varint,1,opt,name=ForeignProfiles,proto3,enum=siemens.iedge.dmapi.network.v1.ApplyOptions_ForeignProfilePolicy" json:"ForeignProfiles,omitempty"`
+	Comment         string                            `protobuf:"bytes,2,opt,name=Comment,proto3" json:"Comment,omitempty"` // Optional. Stored with the revision of the applied settings.
+	// Changes of the interface, the calling TCP connection uses, are rejected if they change its address or take away its
+	// default route. If true, they are applied and have to be confirmed with ConfirmSettings, otherwise they are rolled back.
+	AllowManagementChange bool   `protobuf:"varint,3,opt,name=AllowManagementChange,proto3" json:"AllowManagementChange,omitempty"`
+	ConfirmTimeout        uint32 `protobuf:"varint,4,opt,name=ConfirmTimeout,proto3" json:"ConfirmTimeout,omitempty"` // Seconds to confirm a change of the management interface, 120 if zero.
+	// Optional. Checked after the settings were applied, in the given order. If a check fails, the previous settings are
+	// restored and the apply fails with the failed check.
+	Checks        []*ConnectivityCheck `protobuf:"bytes,5,rep,name=Checks,proto3" json:"Checks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplyOptions) Reset() {
+	*x = ApplyOptions{}
+	mi := &file_Network_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyOptions) ProtoMessage() {}
+
+func (x *ApplyOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_Network_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyOptions.ProtoReflect.Descriptor instead.
+func (*ApplyOptions) Descriptor() ([]byte, []int) {
+	return file_Network_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ApplyOptions) GetForeignProfiles() ApplyOptions_ForeignProfilePolicy {
+	if x != nil {
+		return x.ForeignProfiles
+	}
+	return ApplyOptions_DELETE
+}
+
+func (x *ApplyOptions) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *ApplyOptions) GetAllowManagementChange() bool {
+	if x != nil {
+		return x.AllowManagementChange
+	}
+	return false
+}
+
+func (x *ApplyOptions) GetConfirmTimeout() uint32 {
+	if x != nil {
+		return x.ConfirmTimeout
+	}
+	return 0
+}
+
+func (x *ApplyOptions) GetChecks() []*ConnectivityCheck {
+	if x != nil {
+		return x.Checks
+	}
+	return nil
+}
+
+// A check of the network after an apply.
+type ConnectivityCheck struct {
+	state protoimpl.MessageState      `protogen:"open.v1"`
+	Type  ConnectivityCheck_CheckType `protobuf:"varint,1,opt,name=Type,proto3,enum=siemens.iedge.dmapi.network.v1.ConnectivityCheck_CheckType" json:"Type,omitempty"`
+	// GATEWAY: IPv4 address of the gateway, the gateway of the gateway interface if empty.
+	// DNS: name to resolve, e.g: example.com
+	// TCP: endpoint, e.g: 192.168.1.20:443
+	// DHCP_LEASE: label or MAC address of the interface, every interface of the settings with DHCP enabled if empty.
+	Target        string `protobuf:"bytes,2,opt,name=Target,proto3" json:"Target,omitempty"`
+	Timeout       uint32 `protobuf:"varint,3,opt,name=Timeout,proto3" json:"Timeout,omitempty"` // Seconds until the check has to succeed, 10 if zero.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConnectivityCheck) Reset() {
+	*x = ConnectivityCheck{}
+	mi := &file_Network_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConnectivityCheck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConnectivityCheck) ProtoMessage() {}
+
+func (x *ConnectivityCheck) ProtoReflect() protoreflect.Message {
+	mi := &file_Network_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConnectivityCheck.ProtoReflect.Descriptor instead.
+func (*ConnectivityCheck) Descriptor() ([]byte, []int) {
+	return file_Network_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ConnectivityCheck) GetType() ConnectivityCheck_CheckType {
+	if x != nil {
+		return x.Type
+	}
+	return ConnectivityCheck_GATEWAY
+}
+
+func (x *ConnectivityCheck) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *ConnectivityCheck) GetTimeout() uint32 {
+	if x != nil {
+		return x.Timeout
+	}
+	return 0
+}
+
+// Contains multiple network interface settings. It can be used to apply or get the settings.
+type NetworkSettings struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Interfaces      []*Interface           `protobuf:"bytes,1,rep,name=Interfaces,proto3" json:"Interfaces,omitempty"`                                                                       // Network settings contains an array of Interfaces.Applying new settings or receiving current settings is supported for multiple ethernet typed network interfaces supported.
+	LabelMap        map[string]string      `protobuf:"bytes,2,rep,name=LabelMap,proto3" json:"LabelMap,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // LabelMap contains port label and corresponding interface-name. e.g key : x1 value: enp2s0
+	Generation      uint64                 `protobuf:"varint,3,opt,name=Generation,proto3" json:"Generation,omitempty"`                                                                      // Read only. Generation of the service's interface snapshot, increased whenever any interface data changes. 0 if the snapshot is not used.
+	ResourceVersion string                 `protobuf:"bytes,4,opt,name=ResourceVersion,proto3" json:"ResourceVersion,omitempty"`                                                             // Opaque version of all interfaces and the label map. If set in ApplySettings, the request is rejected with ABORTED when any interface or the label map changed since it was read. It also covers the bonds.
+	Options         *ApplyOptions          `protobuf:"bytes,5,opt,name=Options,proto3" json:"Options,omitempty"`                                                                             // Optional. Options for ApplySettings, not set by the read RPCs.
+	Bonds           *BondList              `protobuf:"bytes,6,opt,name=Bonds,proto3" json:"Bonds,omitempty"`                                                                                 // If set in ApplySettings, it replaces the bonds of this service, an empty list removes them. Bonds are kept if not set. The read RPCs return all bonds, unless the field mask does not select Bond.
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *NetworkSettings) Reset() {
+	*x = NetworkSettings{}
+	mi := &file_Network_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NetworkSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetworkSettings) ProtoMessage() {}
+
+func (x *NetworkSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_Network_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetworkSettings.ProtoReflect.Descriptor instead.
+func (*NetworkSettings) Descriptor() ([]byte, []int) {
+	return file_Network_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *NetworkSettings) GetInterfaces() []*Interface {
+	if x != nil {
+		return x.Interfaces
+	}
+	return nil
+}
+
+func (x *NetworkSettings) GetLabelMap() map[string]string {
+	if x != nil {
+		return x.LabelMap
+	}
+	return nil
+}
+
+func (x *NetworkSettings) GetGeneration() uint64 {
+	if x != nil {
+		return x.Generation
+	}
+	return 0
+}
+
+func (x *NetworkSettings) GetResourceVersion() string {
+	if x != nil {
+		return x.ResourceVersion
+	}
+	return ""
+}
+
+func (x *NetworkSettings) GetOptions() *ApplyOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *NetworkSettings) GetBonds() *BondList {
+	if x != nil {
+		return x.Bonds
+	}
+	return nil
+}
+
+// InterfaceDrift describes an interface whose actual settings differ from the settings applied last.
+type InterfaceDrift struct {
+	state               protoimpl.MessageState  `protogen:"open.v1"`
+	MacAddress          string                  `protobuf:"bytes,1,opt,name=MacAddress,proto3" json:"MacAddress,omitempty"` // MAC address of the applied settings, empty if they were applied by label.
+	Label               string                  `protobuf:"bytes,2,opt,name=Label,proto3" json:"Label,omitempty"`           // Label of the applied settings, empty if they were applied by MAC address.
+	Mode                Interface_ReconcileMode `protobuf:"varint,3,opt,name=Mode,proto3,enum=siemens.iedge.dmapi.network.v1.Interface_ReconcileMode" json:"Mode,omitempty"`
+	Fields              []string                `protobuf:"bytes,4,rep,name=Fields,proto3" json:"Fields,omitempty"`                           // Paths of the Interface fields which differ, e.g: "DHCP", "Static.IPv4".
+	Missing             bool                    `protobuf:"varint,5,opt,name=Missing,proto3" json:"Missing,omitempty"`                        // true if the interface does not exist anymore.
+	Desired             *Interface              `protobuf:"bytes,6,opt,name=Desired,proto3" json:"Desired,omitempty"`                         // Settings applied last.
+	Actual              *Interface              `protobuf:"bytes,7,opt,name=Actual,proto3" json:"Actual,omitempty"`                           // Current settings, not set if the interface is missing.
+	LastCorrection      int64                   `protobuf:"varint,8,opt,name=LastCorrection,proto3" json:"LastCorrection,omitempty"`          // Unix time of the last automatic correction of an enforced interface, 0 if there was none.
+	LastCorrectionError string                  `protobuf:"bytes,9,opt,name=LastCorrectionError,proto3" json:"LastCorrectionError,omitempty"` // Error of the last automatic correction, empty if it succeeded.
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *InterfaceDrift) Reset() {
+	*x = InterfaceDrift{}
+	mi := &file_Network_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InterfaceDrift) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InterfaceDrift) ProtoMessage() {}
+
+func (x *InterfaceDrift) ProtoReflect() protoreflect.Message {
+	mi := &file_Network_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InterfaceDrift.ProtoReflect.Descriptor instead.
+func (*InterfaceDrift) Descriptor() ([]byte, []int) {
+	return file_Network_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *InterfaceDrift) GetMacAddress() string {
+	if x != nil {
+		return x.MacAddress
+	}
+	return ""
+}
+
+func (x *InterfaceDrift) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *InterfaceDrift) GetMode() Interface_ReconcileMode {
+	if x != nil {
+		return x.Mode
+	}
+	return Interface_OBSERVE
+}
+
+func (x *InterfaceDrift) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *InterfaceDrift) GetMissing() bool {
+	if x != nil {
+		return x.Missing
+	}
+	return false
+}
+
+func (x *InterfaceDrift) GetDesired() *Interface {
+	if x != nil {
+		return x.Desired
+	}
+	return nil
+}
+
+func (x *InterfaceDrift) GetActual() *Interface {
+	if x != nil {
+		return x.Actual
+	}
+	return nil
+}
+
+func (x *InterfaceDrift) GetLastCorrection() int64 {
+	if x != nil {
+		return x.LastCorrection
+	}
+	return 0
+}
+
+func (x *InterfaceDrift) GetLastCorrectionError() string {
+	if x != nil {
+		return x.LastCorrectionError
+	}
+	return ""
+}
+
+// Result of GetDrift.
+type DriftReport struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Interfaces    []*InterfaceDrift      `protobuf:"bytes,1,rep,name=Interfaces,proto3" json:"Interfaces,omitempty"` // Interfaces with drift. Empty if all interfaces match the settings applied last.
+	CheckedAt     int64                  `protobuf:"varint,2,opt,name=CheckedAt,proto3" json:"CheckedAt,omitempty"`  // Unix time of the check.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DriftReport) Reset() {
+	*x = DriftReport{}
+	mi := &file_Network_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DriftReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DriftReport) ProtoMessage() {}
+
+func (x *DriftReport) ProtoReflect() protoreflect.Message {
+	mi := &file_Network_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DriftReport.ProtoReflect.Descriptor instead.
+func (*DriftReport) Descriptor() ([]byte, []int) {
+	return file_Network_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *DriftReport) GetInterfaces() []*InterfaceDrift {
+	if x != nil {
+		return x.Interfaces
+	}
+	return nil
+}
+
+func (x *DriftReport) GetCheckedAt() int64 {
+	if x != nil {
+		return x.CheckedAt
+	}
+	return 0
+}
+
+// Revision is a configuration, which was applied successfully.
+type Revision struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Number        uint64                 `protobuf:"varint,1,opt,name=Number,proto3" json:"Number,omitempty"`         // Increasing number of the revision.
+	Timestamp     int64                  `protobuf:"varint,2,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`   // Unix time of the apply.
+	Caller        string                 `protobuf:"bytes,3,opt,name=Caller,proto3" json:"Caller,omitempty"`          // Identity of the caller, the "caller" metadata of the request if set, the peer address otherwise.
+	Comment       string                 `protobuf:"bytes,4,opt,name=Comment,proto3" json:"Comment,omitempty"`        // Comment given in the ApplyOptions or the rollback request.
+	Settings      *NetworkSettings       `protobuf:"bytes,5,opt,name=Settings,proto3" json:"Settings,omitempty"`      // All interface settings applied so far, including the label map. Not set by ListRevisions.
+	RollbackOf    uint64                 `protobuf:"varint,6,opt,name=RollbackOf,proto3" json:"RollbackOf,omitempty"` // Number of the revision restored by a rollback, 0 for ApplySettings.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Revision) Reset() {
+	*x = Revision{}
+	mi := &file_Network_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Revision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Revision) ProtoMessage() {}
+
+func (x *Revision) ProtoReflect() protoreflect.Message {
+	mi := &file_Network_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Revision.ProtoReflect.Descriptor instead.
+func (*Revision) Descriptor() ([]byte, []int) {
+	return file_Network_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *Revision) GetNumber() uint64 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+func (x *Revision) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *Revision) GetCaller() string {
+	if x != nil {
+		return x.Caller
+	}
+	return ""
+}
+
+func (x *Revision) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *Revision) GetSettings() *NetworkSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+func (x *Revision) GetRollbackOf() uint64 {
+	if x != nil {
+		return x.RollbackOf
+	}
+	return 0
+}
+
+// Contains the stored revisions, the oldest first. Only the latest revisions are kept.
+type RevisionList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Revisions     []*Revision            `protobuf:"bytes,1,rep,name=Revisions,proto3" json:"Revisions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevisionList) Reset() {
+	*x = RevisionList{}
+	mi := &file_Network_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevisionList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevisionList) ProtoMessage() {}
+
+func (x *RevisionList) ProtoReflect() protoreflect.Message {
+	mi := &file_Network_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevisionList.ProtoReflect.Descriptor instead.
+func (*RevisionList) Descriptor() ([]byte, []int) {
+	return file_Network_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *RevisionList) GetRevisions() []*Revision {
+	if x != nil {
+		return x.Revisions
+	}
+	return nil
+}
+
+// Used for retrieving or rolling back to a revision.
+type RevisionRequest struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Number                uint64                 `protobuf:"varint,1,opt,name=Number,proto3" json:"Number,omitempty"`
+	Comment               string                 `protobuf:"bytes,2,opt,name=Comment,proto3" json:"Comment,omitempty"`                              // Optional. Comment of the revision created by RollbackToRevision.
+	AllowManagementChange bool                   `protobuf:"varint,3,opt,name=AllowManagementChange,proto3" json:"AllowManagementChange,omitempty"` // Like in ApplyOptions, the options of the revision are not used for the rollback.
+	ConfirmTimeout        uint32                 `protobuf:"varint,4,opt,name=ConfirmTimeout,proto3" json:"ConfirmTimeout,omitempty"`               // Seconds to confirm a change of the management interface, 120 if zero.
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *RevisionRequest) Reset() {
+	*x = RevisionRequest{}
+	mi := &file_Network_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevisionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevisionRequest) ProtoMessage() {}
+
+func (x *RevisionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_Network_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevisionRequest.ProtoReflect.Descriptor instead.
+func (*RevisionRequest) Descriptor() ([]byte, []int) {
+	return file_Network_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *RevisionRequest) GetNumber() uint64 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+func (x *RevisionRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *RevisionRequest) GetAllowManagementChange() bool {
+	if x != nil {
+		return x.AllowManagementChange
+	}
+	return false
+}
+
+func (x *RevisionRequest) GetConfirmTimeout() uint32 {
+	if x != nil {
+		return x.ConfirmTimeout
+	}
+	return 0
+}
+
+// Used for comparing two revisions.
+type DiffRevisionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          uint64                 `protobuf:"varint,1,opt,name=From,proto3" json:"From,omitempty"`
+	To            uint64                 `protobuf:"varint,2,opt,name=To,proto3" json:"To,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiffRevisionsRequest) Reset() {
+	*x = DiffRevisionsRequest{}
+	mi := &file_Network_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffRevisionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffRevisionsRequest) ProtoMessage() {}
+
+func (x *DiffRevisionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_Network_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffRevisionsRequest.ProtoReflect.Descriptor instead.
+func (*DiffRevisionsRequest) Descriptor() ([]byte, []int) {
+	return file_Network_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *DiffRevisionsRequest) GetFrom() uint64 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *DiffRevisionsRequest) GetTo() uint64 {
+	if x != nil {
+		return x.To
+	}
+	return 0
+}
+
+// RevisionChange is a single difference between two revisions.
+type RevisionChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Interface     string                 `protobuf:"bytes,1,opt,name=Interface,proto3" json:"Interface,omitempty"` // MAC address or "LABEL:<label>" of the interface, empty for label map changes.
+	Field         string                 `protobuf:"bytes,2,opt,name=Field,proto3" json:"Field,omitempty"`         // Path of the changed field, e.g: "Static.IPv4" or "LabelMap.X1". Empty if the whole interface was added or removed.
+	From          string                 `protobuf:"bytes,3,opt,name=From,proto3" json:"From,omitempty"`           // Value in the From revision, empty if it was not set.
+	To            string                 `protobuf:"bytes,4,opt,name=To,proto3" json:"To,omitempty"`               // Value in the To revision, empty if it was not set.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevisionChange) Reset() {
+	*x = RevisionChange{}
+	mi := &file_Network_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevisionChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevisionChange) ProtoMessage() {}
+
+func (x *RevisionChange) ProtoReflect() protoreflect.Message {
+	mi := &file_Network_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevisionChange.ProtoReflect.Descriptor instead.
+func (*RevisionChange) Descriptor() ([]byte, []int) {
+	return file_Network_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *RevisionChange) GetInterface() string {
+	if x != nil {
+		return x.Interface
+	}
+	return ""
+}
+
+func (x *RevisionChange) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *RevisionChange) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *RevisionChange) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+// Result of DiffRevisions.
+type RevisionDiff struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          uint64                 `protobuf:"varint,1,opt,name=From,proto3" json:"From,omitempty"`
+	To            uint64                 `protobuf:"varint,2,opt,name=To,proto3" json:"To,omitempty"`
+	Changes       []*RevisionChange      `protobuf:"bytes,3,rep,name=Changes,proto3" json:"Changes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevisionDiff) Reset() {
+	*x = RevisionDiff{}
+	mi := &file_Network_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevisionDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevisionDiff) ProtoMessage() {}
+
+func (x *RevisionDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_Network_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevisionDiff.ProtoReflect.Descriptor instead.
+func (*RevisionDiff) Descriptor() ([]byte, []int) {
+	return file_Network_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *RevisionDiff) GetFrom() uint64 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *RevisionDiff) GetTo() uint64 {
+	if x != nil {
+		return x.To
+	}
+	return 0
+}
+
+func (x *RevisionDiff) GetChanges() []*RevisionChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+// ConfigurationDocument is the versioned document of ExportConfiguration and ImportConfiguration.
+// It contains all interfaces with their IP, DNS, route and L2 settings and the label map. Field names are the
+// names of this file, e.g: {"ApiVersion": "network.iedge.siemens.com/v1", "Kind": "NetworkConfiguration", "Settings": {"Interfaces": [...], "LabelMap": {...}}}
+type ConfigurationDocument struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApiVersion    string                 `protobuf:"bytes,1,opt,name=ApiVersion,proto3" json:"ApiVersion,omitempty"`  // Version of the document, "network.iedge.siemens.com/v1".
+	Kind          string                 `protobuf:"bytes,2,opt,name=Kind,proto3" json:"Kind,omitempty"`              // Always "NetworkConfiguration".
+	ExportedAt    int64                  `protobuf:"varint,3,opt,name=ExportedAt,proto3" json:"ExportedAt,omitempty"` // Unix time of the export.
+	Hostname      string                 `protobuf:"bytes,4,opt,name=Hostname,proto3" json:"Hostname,omitempty"`      // Host name of the exporting device, informational only.
+	Settings      *NetworkSettings       `protobuf:"bytes,5,opt,name=Settings,proto3" json:"Settings,omitempty"`      // Interface settings and label map. Read only fields are not exported.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfigurationDocument) Reset() {
+	*x = ConfigurationDocument{}
+	mi := &file_Network_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfigurationDocument) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigurationDocument) ProtoMessage() {}
+
+func (x *ConfigurationDocument) ProtoReflect() protoreflect.Message {
+	mi := &file_Network_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigurationDocument.ProtoReflect.Descriptor instead.
+func (*ConfigurationDocument) Descriptor() ([]byte, []int) {
+	return file_Network_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ConfigurationDocument) GetApiVersion() string {
+	if x != nil {
+		return x.ApiVersion
+	}
+	return ""
+}
+
+func (x *ConfigurationDocument) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ConfigurationDocument) GetExportedAt() int64 {
+	if x != nil {
+		return x.ExportedAt
+	}
+	return 0
+}
+
+func (x *ConfigurationDocument) GetHostname() string {
+	if x != nil {
+		return x.Hostname
+	}
+	return ""
+}
+
+func (x *ConfigurationDocument) GetSettings() *NetworkSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+// Used for exporting the configuration of the device.
+type ExportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Format        DocumentFormat         `protobuf:"varint,1,opt,name=Format,proto3,enum=siemens.iedge.dmapi.network.v1.DocumentFormat" json:"Format,omitempty"`
+	ByLabel       bool                   `protobuf:"varint,2,opt,name=ByLabel,proto3" json:"ByLabel,omitempty"` // if true, interfaces with a label are exported without MAC address, so the document can be imported on identical devices.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportRequest) Reset() {
+	*x = ExportRequest{}
+	mi := &file_Network_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportRequest) ProtoMessage() {}
+
+func (x *ExportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_Network_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportRequest.ProtoReflect.Descriptor instead.
+func (*ExportRequest) Descriptor() ([]byte, []int) {
+	return file_Network_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ExportRequest) GetFormat() DocumentFormat {
+	if x != nil {
+		return x.Format
+	}
+	return DocumentFormat_JSON
+}
+
+func (x *ExportRequest) GetByLabel() bool {
+	if x != nil {
+		return x.ByLabel
+	}
+	return false
+}
+
+// Result of ExportConfiguration.
+type ConfigurationExport struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Format        DocumentFormat         `protobuf:"varint,1,opt,name=Format,proto3,enum=siemens.iedge.dmapi.network.v1.DocumentFormat" json:"Format,omitempty"`
+	Document      string                 `protobuf:"bytes,2,opt,name=Document,proto3" json:"Document,omitempty"` // The serialized ConfigurationDocument.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfigurationExport) Reset() {
+	*x = ConfigurationExport{}
+	mi := &file_Network_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfigurationExport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigurationExport) ProtoMessage() {}
+
+func (x *ConfigurationExport) ProtoReflect() protoreflect.Message {
+	mi := &file_Network_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigurationExport.ProtoReflect.Descriptor instead.
+func (*ConfigurationExport) Descriptor() ([]byte, []int) {
+	return file_Network_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ConfigurationExport) GetFormat() DocumentFormat {
+	if x != nil {
+		return x.Format
+	}
+	return DocumentFormat_JSON
+}
+
+func (x *ConfigurationExport) GetDocument() string {
+	if x != nil {
+		return x.Document
+	}
+	return ""
+}
+
+// Used for importing a configuration document.
+type ImportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Format        DocumentFormat         `protobuf:"varint,1,opt,name=Format,proto3,enum=siemens.iedge.dmapi.network.v1.DocumentFormat" json:"Format,omitempty"`
+	Document      string                 `protobuf:"bytes,2,opt,name=Document,proto3" json:"Document,omitempty"`          // The serialized ConfigurationDocument.
+	MatchByLabel  bool                   `protobuf:"varint,3,opt,name=MatchByLabel,proto3" json:"MatchByLabel,omitempty"` // if true, interfaces are matched by their label instead of their MAC address. Interfaces without a label are rejected.
+	ValidateOnly  bool                   `protobuf:"varint,4,opt,name=ValidateOnly,proto3" json:"ValidateOnly,omitempty"` // if true, the document is only validated against this device, nothing is changed.
+	Options       *ApplyOptions          `protobuf:"bytes,5,opt,name=Options,proto3" json:"Options,omitempty"`            // Optional. Options used for applying the settings of the document.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportRequest) Reset() {
+	*x = ImportRequest{}
+	mi := &file_Network_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportRequest) ProtoMessage() {}
+
+func (x *ImportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_Network_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportRequest.ProtoReflect.Descriptor instead.
+func (*ImportRequest) Descriptor() ([]byte, []int) {
+	return file_Network_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ImportRequest) GetFormat() DocumentFormat {
+	if x != nil {
+		return x.Format
+	}
+	return DocumentFormat_JSON
+}
+
+func (x *ImportRequest) GetDocument() string {
+	if x != nil {
+		return x.Document
+	}
+	return ""
+}
+
+func (x *ImportRequest) GetMatchByLabel() bool {
+	if x != nil {
+		return x.MatchByLabel
+	}
+	return false
+}
+
+func (x *ImportRequest) GetValidateOnly() bool {
+	if x != nil {
+		return x.ValidateOnly
+	}
+	return false
+}
+
+func (x *ImportRequest) GetOptions() *ApplyOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+// Result of ImportConfiguration.
+type ImportResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Settings      *NetworkSettings       `protobuf:"bytes,1,opt,name=Settings,proto3" json:"Settings,omitempty"` // Settings built from the document, as they are or would be applied.
+	Warnings      []string               `protobuf:"bytes,2,rep,name=Warnings,proto3" json:"Warnings,omitempty"` // Parts of the document, which were not or could not be applied, e.g. an existing L2 network.
+	Applied       bool                   `protobuf:"varint,3,opt,name=Applied,proto3" json:"Applied,omitempty"`  // false for ValidateOnly.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportResult) Reset() {
+	*x = ImportResult{}
+	mi := &file_Network_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportResult) ProtoMessage() {}
+
+func (x *ImportResult) ProtoReflect() protoreflect.Message {
+	mi := &file_Network_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportResult.ProtoReflect.Descriptor instead.
+func (*ImportResult) Descriptor() ([]byte, []int) {
+	return file_Network_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ImportResult) GetSettings() *NetworkSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+func (x *ImportResult) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+func (x *ImportResult) GetApplied() bool {
+	if x != nil {
+		return x.Applied
+	}
+	return false
+}
+
+// Used for rendering and applying a NetworkSettings template.
+type TemplateRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Go text/template of a NetworkSettings document in the given format, e.g: {"Interfaces": [{"Label": "X1", "DHCP": "disabled",
+	// "Static": {"IPv4": "{{ cidrHost "192.168.0.0/24" .Station }}", "NetMask": "{{ cidrNetmask "192.168.0.0/24" }}"}}]}
+	// Functions: cidrHost CIDR N (N-th address of the network), cidrNetmask CIDR, cidrPrefix CIDR, ipAdd IP N, add, sub, mul.
+	Template      string            `protobuf:"bytes,1,opt,name=Template,proto3" json:"Template,omitempty"`
+	Format        DocumentFormat    `protobuf:"varint,2,opt,name=Format,proto3,enum=siemens.iedge.dmapi.network.v1.DocumentFormat" json:"Format,omitempty"`
+	Variables     map[string]string `protobuf:"bytes,3,rep,name=Variables,proto3" json:"Variables,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // Variables of the template, e.g: Station: "12". They override the variables of the facts file /etc/dm-network/facts.json.
+	ValidateOnly  bool              `protobuf:"varint,4,opt,name=ValidateOnly,proto3" json:"ValidateOnly,omitempty"`                                                                    // if true, the template is only rendered and validated, nothing is changed.
+	Options       *ApplyOptions     `protobuf:"bytes,5,opt,name=Options,proto3" json:"Options,omitempty"`                                                                               // Optional. Options used for applying the rendered settings.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TemplateRequest) Reset() {
+	*x = TemplateRequest{}
+	mi := &file_Network_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TemplateRequest) ProtoMessage() {}
+
+func (x *TemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_Network_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TemplateRequest.ProtoReflect.Descriptor instead.
+func (*TemplateRequest) Descriptor() ([]byte, []int) {
+	return file_Network_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *TemplateRequest) GetTemplate() string {
+	if x != nil {
+		return x.Template
+	}
+	return ""
+}
+
+func (x *TemplateRequest) GetFormat() DocumentFormat {
+	if x != nil {
+		return x.Format
+	}
+	return DocumentFormat_JSON
+}
+
+func (x *TemplateRequest) GetVariables() map[string]string {
+	if x != nil {
+		return x.Variables
+	}
+	return nil
+}
+
+func (x *TemplateRequest) GetValidateOnly() bool {
+	if x != nil {
+		return x.ValidateOnly
+	}
+	return false
+}
+
+func (x *TemplateRequest) GetOptions() *ApplyOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+// Result of ApplyTemplate.
+type TemplateResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Settings      *NetworkSettings       `protobuf:"bytes,1,opt,name=Settings,proto3" json:"Settings,omitempty"` // The rendered settings.
+	Applied       bool                   `protobuf:"varint,2,opt,name=Applied,proto3" json:"Applied,omitempty"`  // false for ValidateOnly.
+	Revision      *Revision              `protobuf:"bytes,3,opt,name=Revision,proto3" json:"Revision,omitempty"` // The revision created for the applied settings.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TemplateResult) Reset() {
+	*x = TemplateResult{}
+	mi := &file_Network_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TemplateResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TemplateResult) ProtoMessage() {}
+
+func (x *TemplateResult) ProtoReflect() protoreflect.Message {
+	mi := &file_Network_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TemplateResult.ProtoReflect.Descriptor instead.
+func (*TemplateResult) Descriptor() ([]byte, []int) {
+	return file_Network_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *TemplateResult) GetSettings() *NetworkSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+func (x *TemplateResult) GetApplied() bool {
+	if x != nil {
+		return x.Applied
+	}
+	return false
+}
+
+func (x *TemplateResult) GetRevision() *Revision {
+	if x != nil {
+		return x.Revision
+	}
+	return nil
+}
+
+// Used for resetting the device to the factory defaults.
+type FactoryResetRequest struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Comment               string                 `protobuf:"bytes,1,opt,name=Comment,proto3" json:"Comment,omitempty"`                              // Optional. Comment of the created revision, "factory reset" if empty.
+	AllowManagementChange bool                   `protobuf:"varint,2,opt,name=AllowManagementChange,proto3" json:"AllowManagementChange,omitempty"` // Like in ApplyOptions, the reset has to be confirmed if it changes the management interface.
+	ConfirmTimeout        uint32                 `protobuf:"varint,3,opt,name=ConfirmTimeout,proto3" json:"ConfirmTimeout,omitempty"`               // Seconds to confirm a change of the management interface, 120 if zero.
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *FactoryResetRequest) Reset() {
+	*x = FactoryResetRequest{}
+	mi := &file_Network_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FactoryResetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FactoryResetRequest) ProtoMessage() {}
+
+func (x *FactoryResetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_Network_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FactoryResetRequest.ProtoReflect.Descriptor instead.
+func (*FactoryResetRequest) Descriptor() ([]byte, []int) {
+	return file_Network_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *FactoryResetRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *FactoryResetRequest) GetAllowManagementChange() bool {
+	if x != nil {
+		return x.AllowManagementChange
+	}
+	return false
+}
+
+func (x *FactoryResetRequest) GetConfirmTimeout() uint32 {
+	if x != nil {
+		return x.ConfirmTimeout
+	}
+	return 0
+}
+
+// Result of ResetToFactoryDefaults.
+type FactoryResetResult struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Settings        *NetworkSettings       `protobuf:"bytes,1,opt,name=Settings,proto3" json:"Settings,omitempty"`               // The applied factory defaults.
+	RemovedProfiles []string               `protobuf:"bytes,2,rep,name=RemovedProfiles,proto3" json:"RemovedProfiles,omitempty"` // IDs of the deleted connection profiles of this service.
+	Revision        *Revision              `protobuf:"bytes,3,opt,name=Revision,proto3" json:"Revision,omitempty"`               // The revision created for the reset.
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *FactoryResetResult) Reset() {
+	*x = FactoryResetResult{}
+	mi := &file_Network_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FactoryResetResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FactoryResetResult) ProtoMessage() {}
+
+func (x *FactoryResetResult) ProtoReflect() protoreflect.Message {
+	mi := &file_Network_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FactoryResetResult.ProtoReflect.Descriptor instead.
+func (*FactoryResetResult) Descriptor() ([]byte, []int) {
+	return file_Network_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *FactoryResetResult) GetSettings() *NetworkSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+func (x *FactoryResetResult) GetRemovedProfiles() []string {
+	if x != nil {
+		return x.RemovedProfiles
+	}
+	return nil
+}
+
+func (x *FactoryResetResult) GetRevision() *Revision {
+	if x != nil {
+		return x.Revision
+	}
+	return nil
+}
+
+// Result of a provisioning file, written next to the file as network-provisioning.result.json.
+type ProvisioningReport struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	File          string                 `protobuf:"bytes,1,opt,name=File,proto3" json:"File,omitempty"`                // Path of the provisioning file.
+	Sha256        string                 `protobuf:"bytes,2,opt,name=Sha256,proto3" json:"Sha256,omitempty"`            // Checksum of the processed file. A file is processed once, it is processed again when it changes or Retry is set.
+	ProcessedAt   int64                  `protobuf:"varint,3,opt,name=ProcessedAt,proto3" json:"ProcessedAt,omitempty"` // Unix time.
+	Applied       bool                   `protobuf:"varint,4,opt,name=Applied,proto3" json:"Applied,omitempty"`         // true if the settings of the file were applied.
+	Error         string                 `protobuf:"bytes,5,opt,name=Error,proto3" json:"Error,omitempty"`              // Reason, why the file was rejected or could not be applied.
+	Revision      uint64                 `protobuf:"varint,6,opt,name=Revision,proto3" json:"Revision,omitempty"`       // Revision created for the applied settings.
+	Retry         bool                   `protobuf:"varint,7,opt,name=Retry,proto3" json:"Retry,omitempty"`             // true if the unchanged file is processed again, because its checksum file was wrong or the apply failed for a transient reason.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProvisioningReport) Reset() {
+	*x = ProvisioningReport{}
+	mi := &file_Network_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProvisioningReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProvisioningReport) ProtoMessage() {}
+
+func (x *ProvisioningReport) ProtoReflect() protoreflect.Message {
+	mi := &file_Network_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProvisioningReport.ProtoReflect.Descriptor instead.
+func (*ProvisioningReport) Descriptor() ([]byte, []int) {
+	return file_Network_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ProvisioningReport) GetFile() string {
+	if x != nil {
+		return x.File
+	}
+	return ""
+}
+
+func (x *ProvisioningReport) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
+
+func (x *ProvisioningReport) GetProcessedAt() int64 {
+	if x != nil {
+		return x.ProcessedAt
+	}
+	return 0
+}
+
+func (x *ProvisioningReport) GetApplied() bool {
+	if x != nil {
+		return x.Applied
+	}
+	return false
+}
+
+func (x *ProvisioningReport) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ProvisioningReport) GetRevision() uint64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *ProvisioningReport) GetRetry() bool {
+	if x != nil {
+		return x.Retry
+	}
+	return false
+}
+
+// Constraints of the device builder, which are enforced for every apply on top of the syntax checks.
+// Read from /etc/dm-network/policy.json at startup, interfaces are identified by their label.
+type NetworkPolicy struct {
+	state             protoimpl.MessageState         `protogen:"open.v1"`
+	Rules             []*NetworkPolicy_InterfaceRule `protobuf:"bytes,1,rep,name=Rules,proto3" json:"Rules,omitempty"`
+	GatewayInterfaces []string                       `protobuf:"bytes,2,rep,name=GatewayInterfaces,proto3" json:"GatewayInterfaces,omitempty"` // Optional. Labels of the interfaces, which may be the gateway interface. Every interface if empty.
+	L2Interfaces      []string                       `protobuf:"bytes,3,rep,name=L2Interfaces,proto3" json:"L2Interfaces,omitempty"`           // Optional. Labels of the interfaces, which may have an L2Conf, e.g. the field ports. Every interface if empty.
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *NetworkPolicy) Reset() {
+	*x = NetworkPolicy{}
+	mi := &file_Network_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NetworkPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetworkPolicy) ProtoMessage() {}
+
+func (x *NetworkPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_Network_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetworkPolicy.ProtoReflect.Descriptor instead.
+func (*NetworkPolicy) Descriptor() ([]byte, []int) {
+	return file_Network_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *NetworkPolicy) GetRules() []*NetworkPolicy_InterfaceRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+func (x *NetworkPolicy) GetGatewayInterfaces() []string {
+	if x != nil {
+		return x.GatewayInterfaces
+	}
+	return nil
+}
+
+func (x *NetworkPolicy) GetL2Interfaces() []string {
+	if x != nil {
+		return x.L2Interfaces
+	}
+	return nil
+}
+
+// Used for removing the connection profiles reported by AuditProfiles.
+type ProfileCleanupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DryRun        bool                   `protobuf:"varint,1,opt,name=DryRun,proto3" json:"DryRun,omitempty"` // if true, the profiles are only reported, nothing is removed.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProfileCleanupRequest) Reset() {
+	*x = ProfileCleanupRequest{}
+	mi := &file_Network_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProfileCleanupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProfileCleanupRequest) ProtoMessage() {}
+
+func (x *ProfileCleanupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_Network_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProfileCleanupRequest.ProtoReflect.Descriptor instead.
+func (*ProfileCleanupRequest) Descriptor() ([]byte, []int) {
+	return file_Network_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ProfileCleanupRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+// ProfileFinding describes a connection profile of this service, which is not needed anymore.
+type ProfileFinding struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          ProfileFinding_Reason  `protobuf:"varint,1,opt,name=Kind,proto3,enum=siemens.iedge.dmapi.network.v1.ProfileFinding_Reason" json:"Kind,omitempty"`
+	ID            string                 `protobuf:"bytes,2,opt,name=ID,proto3" json:"ID,omitempty"`                       // Connection ID of the profile, e.g: x1_static
+	UUID          string                 `protobuf:"bytes,3,opt,name=UUID,proto3" json:"UUID,omitempty"`                   // Connection UUID of the profile.
+	MacAddress    string                 `protobuf:"bytes,4,opt,name=MacAddress,proto3" json:"MacAddress,omitempty"`       // MAC address the profile is bound to, empty if it is bound by interface name only.
+	InterfaceName string                 `protobuf:"bytes,5,opt,name=InterfaceName,proto3" json:"InterfaceName,omitempty"` // Interface name the profile is bound to, e.g: eth0
+	Detail        string                 `protobuf:"bytes,6,opt,name=Detail,proto3" json:"Detail,omitempty"`               // Human readable description of the finding.
+	Active        bool                   `protobuf:"varint,7,opt,name=Active,proto3" json:"Active,omitempty"`              // if true, the profile is active on a device. Active profiles are never removed.
+	Removed       bool                   `protobuf:"varint,8,opt,name=Removed,proto3" json:"Removed,omitempty"`            // true if the profile was removed by CleanupProfiles.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProfileFinding) Reset() {
+	*x = ProfileFinding{}
+	mi := &file_Network_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProfileFinding) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProfileFinding) ProtoMessage() {}
+
+func (x *ProfileFinding) ProtoReflect() protoreflect.Message {
+	mi := &file_Network_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProfileFinding.ProtoReflect.Descriptor instead.
+func (*ProfileFinding) Descriptor() ([]byte, []int) {
+	return file_Network_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ProfileFinding) GetKind() ProfileFinding_Reason {
+	if x != nil {
+		return x.Kind
+	}
+	return ProfileFinding_DUPLICATE
+}
+
+func (x *ProfileFinding) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+func (x *ProfileFinding) GetUUID() string {
+	if x != nil {
+		return x.UUID
+	}
+	return ""
+}
+
+func (x *ProfileFinding) GetMacAddress() string {
+	if x != nil {
+		return x.MacAddress
+	}
+	return ""
+}
+
+func (x *ProfileFinding) GetInterfaceName() string {
+	if x != nil {
+		return x.InterfaceName
+	}
+	return ""
+}
+
+func (x *ProfileFinding) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+func (x *ProfileFinding) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *ProfileFinding) GetRemoved() bool {
+	if x != nil {
+		return x.Removed
+	}
+	return false
+}
+
+// Result of AuditProfiles and CleanupProfiles.
+type ProfileReport struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Findings      []*ProfileFinding      `protobuf:"bytes,1,rep,name=Findings,proto3" json:"Findings,omitempty"`
+	DryRun        bool                   `protobuf:"varint,2,opt,name=DryRun,proto3" json:"DryRun,omitempty"` // true if nothing was removed.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProfileReport) Reset() {
+	*x = ProfileReport{}
+	mi := &file_Network_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProfileReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProfileReport) ProtoMessage() {}
+
+func (x *ProfileReport) ProtoReflect() protoreflect.Message {
+	mi := &file_Network_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProfileReport.ProtoReflect.Descriptor instead.
+func (*ProfileReport) Descriptor() ([]byte, []int) {
+	return file_Network_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ProfileReport) GetFindings() []*ProfileFinding {
+	if x != nil {
+		return x.Findings
+	}
+	return nil
+}
+
+func (x *ProfileReport) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+// StaticConf type holds IP Netmask and Gateway information
+type Interface_StaticConf struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IPv4          string                 `protobuf:"bytes,1,opt,name=IPv4,proto3" json:"IPv4,omitempty"`       // e.g: 192.168.0.2
+	NetMask       string                 `protobuf:"bytes,2,opt,name=NetMask,proto3" json:"NetMask,omitempty"` // e.g: 255.255.255.0
+	Gateway       string                 `protobuf:"bytes,3,opt,name=Gateway,proto3" json:"Gateway,omitempty"` // e.g: 192.168.0.1
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Interface_StaticConf) Reset() {
+	*x = Interface_StaticConf{}
+	mi := &file_Network_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Interface_StaticConf) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Interface_StaticConf) ProtoMessage() {}
+
+func (x *Interface_StaticConf) ProtoReflect() protoreflect.Message {
+	mi := &file_Network_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Interface_StaticConf.ProtoReflect.Descriptor instead.
+func (*Interface_StaticConf) Descriptor() ([]byte, []int) {
+	return file_Network_proto_rawDescGZIP(), []int{3, 0}
+}
+
+func (x *Interface_StaticConf) GetIPv4() string {
+	if x != nil {
+		return x.IPv4
+	}
+	return ""
+}
+
+func (x *Interface_StaticConf) GetNetMask() string {
+	if x != nil {
+		return x.NetMask
+	}
+	return ""
+}
+
+func (x *Interface_StaticConf) GetGateway() string {
+	if x != nil {
+		return x.Gateway
+	}
+	return ""
+}
+
+// Type that contains the DNS servers, search domains and resolver options of the interface.
+type Interface_Dns struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PrimaryDNS    string                 `protobuf:"bytes,1,opt,name=PrimaryDNS,proto3" json:"PrimaryDNS,omitempty"`     // e.g:  "1.1.1.2"
+	SecondaryDNS  string                 `protobuf:"bytes,2,opt,name=SecondaryDNS,proto3" json:"SecondaryDNS,omitempty"` // e.g: "1.1.1.1"
+	Servers       []string               `protobuf:"bytes,3,rep,name=Servers,proto3" json:"Servers,omitempty"`           // All DNS servers in order, e.g: ["1.1.1.2", "1.1.1.1", "9.9.9.9"]. If set in ApplySettings, PrimaryDNS and SecondaryDNS are ignored. Read back with all servers, PrimaryDNS and SecondaryDNS hold the first two.
+	Searches      []string               `protobuf:"bytes,4,rep,name=Searches,proto3" json:"Searches,omitempty"`         // Search domains, e.g: ["plant.local"]
+	Priority      int32                  `protobuf:"varint,5,opt,name=Priority,proto3" json:"Priority,omitempty"`        // DNS priority of the interface, lower values are preferred, negative values exclude the servers of interfaces with higher values. NetworkManager's default if zero.
+	Timeout       uint32                 `protobuf:"varint,6,opt,name=Timeout,proto3" json:"Timeout,omitempty"`          // Seconds the resolver waits for an answer (resolv.conf option timeout, at most 30). The resolver's default if zero.
+	Attempts      uint32                 `protobuf:"varint,7,opt,name=Attempts,proto3" json:"Attempts,omitempty"`        // Number of queries sent to each server (resolv.conf option attempts, at most 5). The resolver's default if zero.
+	Rotate        bool                   `protobuf:"varint,8,opt,name=Rotate,proto3" json:"Rotate,omitempty"`            // if true, the servers are queried in turn (resolv.conf option rotate).
+	Auto          Interface_Dns_AutoMode `protobuf:"varint,9,opt,name=Auto,proto3,enum=siemens.iedge.dmapi.network.v1.Interface_Dns_AutoMode" json:"Auto,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Interface_Dns) Reset() {
+	*x = Interface_Dns{}
+	mi := &file_Network_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Interface_Dns) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Interface_Dns) ProtoMessage() {}
+
+func (x *Interface_Dns) ProtoReflect() protoreflect.Message {
+	mi := &file_Network_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Interface_Dns.ProtoReflect.Descriptor instead.
+func (*Interface_Dns) Descriptor() ([]byte, []int) {
+	return file_Network_proto_rawDescGZIP(), []int{3, 1}
+}
+
+func (x *Interface_Dns) GetPrimaryDNS() string {
+	if x != nil {
+		return x.PrimaryDNS
+	}
+	return ""
+}
+
+func (x *Interface_Dns) GetSecondaryDNS() string {
+	if x != nil {
+		return x.SecondaryDNS
+	}
+	return ""
+}
+
+func (x *Interface_Dns) GetServers() []string {
+	if x != nil {
+		return x.Servers
+	}
+	return nil
+}
+
+func (x *Interface_Dns) GetSearches() []string {
+	if x != nil {
+		return x.Searches
+	}
+	return nil
+}
+
+func (x *Interface_Dns) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *Interface_Dns) GetTimeout() uint32 {
+	if x != nil {
+		return x.Timeout
+	}
+	return 0
+}
+
+func (x *Interface_Dns) GetAttempts() uint32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *Interface_Dns) GetRotate() bool {
+	if x != nil {
+		return x.Rotate
+	}
+	return false
+}
+
+func (x *Interface_Dns) GetAuto() Interface_Dns_AutoMode {
+	if x != nil {
+		return x.Auto
+	}
+	return Interface_Dns_DEFAULT
+}
+
+type Interface_L2 struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	StartingAddressIPv4 string                 `protobuf:"bytes,1,opt,name=StartingAddressIPv4,proto3" json:"StartingAddressIPv4,omitempty"`                                                                         // e.g: 192.168.0.2
+	NetMask             string                 `protobuf:"bytes,2,opt,name=NetMask,proto3" json:"NetMask,omitempty"`                                                                                                 // e.g: 255.255.255.0
+	Range               string                 `protobuf:"bytes,3,opt,name=Range,proto3" json:"Range,omitempty"`                                                                                                     // e.g: 16
+	Gateway             string                 `protobuf:"bytes,4,opt,name=Gateway,proto3" json:"Gateway,omitempty"`                                                                                                 //e.g: 192.168.2.1
+	AuxiliaryAddresses  map[string]string      `protobuf:"bytes,5,rep,name=AuxiliaryAddresses,proto3" json:"AuxiliaryAddresses,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` //Preserved addresses for other devices. These addresses won't be assigned to containers. e.g  my_plc,  192.168.0.5
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *Interface_L2) Reset() {
+	*x = Interface_L2{}
+	mi := &file_Network_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Interface_L2) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Interface_L2) ProtoMessage() {}
+
+func (x *Interface_L2) ProtoReflect() protoreflect.Message {
+	mi := &file_Network_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Interface_L2.ProtoReflect.Descriptor instead.
+func (*Interface_L2) Descriptor() ([]byte, []int) {
+	return file_Network_proto_rawDescGZIP(), []int{3, 2}
+}
+
+func (x *Interface_L2) GetStartingAddressIPv4() string {
+	if x != nil {
+		return x.StartingAddressIPv4
+	}
+	return ""
+}
+
+func (x *Interface_L2) GetNetMask() string {
+	if x != nil {
+		return x.NetMask
+	}
+	return ""
+}
+
+func (x *Interface_L2) GetRange() string {
+	if x != nil {
+		return x.Range
+	}
+	return ""
+}
+
+func (x *Interface_L2) GetGateway() string {
+	if x != nil {
+		return x.Gateway
+	}
+	return ""
+}
+
+func (x *Interface_L2) GetAuxiliaryAddresses() map[string]string {
+	if x != nil {
+		return x.AuxiliaryAddresses
+	}
+	return nil
+}
+
+// Route is a static IPv4 route of the interface.
+type Interface_Route struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Destination   string                 `protobuf:"bytes,1,opt,name=Destination,proto3" json:"Destination,omitempty"` // Destination network in CIDR notation, e.g: 10.0.0.0/8
+	NextHop       string                 `protobuf:"bytes,2,opt,name=NextHop,proto3" json:"NextHop,omitempty"`         // e.g: 192.168.0.254. Empty for routes to directly connected networks.
+	Metric        uint32                 `protobuf:"varint,3,opt,name=Metric,proto3" json:"Metric,omitempty"`          // 0 uses the route metric of the interface.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Interface_Route) Reset() {
+	*x = Interface_Route{}
+	mi := &file_Network_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Interface_Route) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Interface_Route) ProtoMessage() {}
+
+func (x *Interface_Route) ProtoReflect() protoreflect.Message {
+	mi := &file_Network_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Interface_Route.ProtoReflect.Descriptor instead.
+func (*Interface_Route) Descriptor() ([]byte, []int) {
+	return file_Network_proto_rawDescGZIP(), []int{3, 3}
+}
+
+func (x *Interface_Route) GetDestination() string {
+	if x != nil {
+		return x.Destination
+	}
+	return ""
+}
+
+func (x *Interface_Route) GetNextHop() string {
+	if x != nil {
+		return x.NextHop
+	}
+	return ""
+}
+
+func (x *Interface_Route) GetMetric() uint32 {
+	if x != nil {
+		return x.Metric
+	}
+	return 0
+}
+
+// FallbackConf holds the address used by DHCP 'fallback', while no DHCP server answers.
+type Interface_FallbackConf struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DHCPTimeout   uint32                 `protobuf:"varint,1,opt,name=DHCPTimeout,proto3" json:"DHCPTimeout,omitempty"` // Seconds to wait for a DHCP lease before falling back. DHCPOptions.Timeout or 30 if zero.
+	LinkLocal     bool                   `protobuf:"varint,2,opt,name=LinkLocal,proto3" json:"LinkLocal,omitempty"`     // if true, an IPv4 link-local address (169.254.0.0/16) is used. Otherwise Static is used.
+	Static        *Interface_StaticConf  `protobuf:"bytes,3,opt,name=Static,proto3" json:"Static,omitempty"`            // Static address used while no DHCP server answers.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Interface_FallbackConf) Reset() {
+	*x = Interface_FallbackConf{}
+	mi := &file_Network_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Interface_FallbackConf) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Interface_FallbackConf) ProtoMessage() {}
+
+func (x *Interface_FallbackConf) ProtoReflect() protoreflect.Message {
+	mi := &file_Network_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Interface_FallbackConf.ProtoReflect.Descriptor instead.
+func (*Interface_FallbackConf) Descriptor() ([]byte, []int) {
+	return file_Network_proto_rawDescGZIP(), []int{3, 4}
+}
+
+func (x *Interface_FallbackConf) GetDHCPTimeout() uint32 {
+	if x != nil {
+		return x.DHCPTimeout
+	}
+	return 0
+}
+
+func (x *Interface_FallbackConf) GetLinkLocal() bool {
+	if x != nil {
+		return x.LinkLocal
+	}
+	return false
+}
+
+func (x *Interface_FallbackConf) GetStatic() *Interface_StaticConf {
+	if x != nil {
+		return x.Static
+	}
+	return nil
+}
+
+// DHCPConf holds the options of the DHCP client. Empty fields keep the value of the existing profile, or the default of NetworkManager.
+type Interface_DHCPConf struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hostname      string                 `protobuf:"bytes,1,opt,name=Hostname,proto3" json:"Hostname,omitempty"`         // Hostname sent to the DHCP server, e.g: ipc-station-12. The hostname of the device if empty.
+	SendHostname  string                 `protobuf:"bytes,2,opt,name=SendHostname,proto3" json:"SendHostname,omitempty"` // values can be 'enabled' or 'disabled'. 'enabled' if empty.
+	ClientID      string                 `protobuf:"bytes,3,opt,name=ClientID,proto3" json:"ClientID,omitempty"`         // e.g: 01:00:11:22:33:44:55, or one of 'mac', 'perm-mac', 'duid', 'stable', 'none'.
+	VendorClass   string                 `protobuf:"bytes,4,opt,name=VendorClass,proto3" json:"VendorClass,omitempty"`   // Vendor class identifier, option 60, e.g: SIEMENS-IPC
+	Timeout       uint32                 `protobuf:"varint,5,opt,name=Timeout,proto3" json:"Timeout,omitempty"`          // Seconds to wait for a DHCP lease. NetworkManager's default if zero.
+	MayFail       string                 `protobuf:"bytes,6,opt,name=MayFail,proto3" json:"MayFail,omitempty"`           // values can be 'enabled' or 'disabled'. If 'disabled' the connection fails without DHCP lease. 'enabled' if empty.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Interface_DHCPConf) Reset() {
+	*x = Interface_DHCPConf{}
+	mi := &file_Network_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Interface_DHCPConf) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Interface_DHCPConf) ProtoMessage() {}
+
+func (x *Interface_DHCPConf) ProtoReflect() protoreflect.Message {
+	mi := &file_Network_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Interface_DHCPConf.ProtoReflect.Descriptor instead.
+func (*Interface_DHCPConf) Descriptor() ([]byte, []int) {
+	return file_Network_proto_rawDescGZIP(), []int{3, 5}
+}
+
+func (x *Interface_DHCPConf) GetHostname() string {
+	if x != nil {
+		return x.Hostname
+	}
+	return ""
+}
+
+func (x *Interface_DHCPConf) GetSendHostname() string {
+	if x != nil {
+		return x.SendHostname
+	}
+	return ""
+}
+
+func (x *Interface_DHCPConf) GetClientID() string {
+	if x != nil {
+		return x.ClientID
+	}
+	return ""
+}
+
+func (x *Interface_DHCPConf) GetVendorClass() string {
+	if x != nil {
+		return x.VendorClass
+	}
+	return ""
+}
+
+func (x *Interface_DHCPConf) GetTimeout() uint32 {
+	if x != nil {
+		return x.Timeout
+	}
+	return 0
+}
+
+func (x *Interface_DHCPConf) GetMayFail() string {
+	if x != nil {
+		return x.MayFail
+	}
+	return ""
+}
+
+// Type that contains the Ethernet link settings of the interface. Settings which are not given keep the value of the existing profile.
+type Interface_LinkConf struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MTU           uint32                 `protobuf:"varint,1,opt,name=MTU,proto3" json:"MTU,omitempty"`                    // e.g: 9000
+	Speed         uint32                 `protobuf:"varint,2,opt,name=Speed,proto3" json:"Speed,omitempty"`                // Mbit/s, e.g: 100. Has to be given together with Duplex.
+	Duplex        string                 `protobuf:"bytes,3,opt,name=Duplex,proto3" json:"Duplex,omitempty"`               // values can be 'full' or 'half'
+	AutoNegotiate string                 `protobuf:"bytes,4,opt,name=AutoNegotiate,proto3" json:"AutoNegotiate,omitempty"` // values can be 'enabled' or 'disabled'. If 'disabled', Speed and Duplex are forced, if 'enabled', only they are advertised.
+	ClonedMAC     string                 `protobuf:"bytes,5,opt,name=ClonedMAC,proto3" json:"ClonedMAC,omitempty"`         // MAC address the interface uses instead of its own, e.g: "02:11:22:33:44:55", or one of 'preserve', 'permanent', 'random', 'stable'
+	WakeOnLan     []string               `protobuf:"bytes,6,rep,name=WakeOnLan,proto3" json:"WakeOnLan,omitempty"`         // values can be 'phy', 'unicast', 'multicast', 'broadcast', 'arp', 'magic', or one of 'default', 'ignore' alone
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Interface_LinkConf) Reset() {
+	*x = Interface_LinkConf{}
+	mi := &file_Network_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Interface_LinkConf) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Interface_LinkConf) ProtoMessage() {}
+
+func (x *Interface_LinkConf) ProtoReflect() protoreflect.Message {
+	mi := &file_Network_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Interface_LinkConf.ProtoReflect.Descriptor instead.
+func (*Interface_LinkConf) Descriptor() ([]byte, []int) {
+	return file_Network_proto_rawDescGZIP(), []int{3, 6}
+}
+
+func (x *Interface_LinkConf) GetMTU() uint32 {
+	if x != nil {
+		return x.MTU
+	}
+	return 0
+}
+
+func (x *Interface_LinkConf) GetSpeed() uint32 {
+	if x != nil {
+		return x.Speed
+	}
+	return 0
+}
+
+func (x *Interface_LinkConf) GetDuplex() string {
+	if x != nil {
+		return x.Duplex
+	}
+	return ""
+}
+
+func (x *Interface_LinkConf) GetAutoNegotiate() string {
+	if x != nil {
+		return x.AutoNegotiate
+	}
+	return ""
+}
+
+func (x *Interface_LinkConf) GetClonedMAC() string {
+	if x != nil {
+		return x.ClonedMAC
+	}
+	return ""
+}
+
+func (x *Interface_LinkConf) GetWakeOnLan() []string {
+	if x != nil {
+		return x.WakeOnLan
+	}
+	return nil
+}
+
+// Type that contains the live link values of the interface, as read from the device.
+type Interface_LinkState struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Carrier       bool                   `protobuf:"varint,1,opt,name=Carrier,proto3" json:"Carrier,omitempty"` // true if the link is up
+	Speed         uint32                 `protobuf:"varint,2,opt,name=Speed,proto3" json:"Speed,omitempty"`     // negotiated speed in Mbit/s, zero without link
+	Duplex        string                 `protobuf:"bytes,3,opt,name=Duplex,proto3" json:"Duplex,omitempty"`    // negotiated duplex: 'full', 'half' or 'unknown'
+	MTU           uint32                 `protobuf:"varint,4,opt,name=MTU,proto3" json:"MTU,omitempty"`
+	HwAddress     string                 `protobuf:"bytes,5,opt,name=HwAddress,proto3" json:"HwAddress,omitempty"` // MAC address in use, the cloned one if set
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Interface_LinkState) Reset() {
+	*x = Interface_LinkState{}
+	mi := &file_Network_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Interface_LinkState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Interface_LinkState) ProtoMessage() {}
 
-func (x *NetworkInterfaceRequestWithLabel) ProtoReflect() protoreflect.Message {
-	mi := &file_Network_proto_msgTypes[1]
+func (x *Interface_LinkState) ProtoReflect() protoreflect.Message {
+	mi := &file_Network_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -111,48 +3537,76 @@ func (x *NetworkInterfaceRequestWithLabel) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NetworkInterfaceRequestWithLabel.ProtoReflect.Descriptor instead.
-func (*NetworkInterfaceRequestWithLabel) Descriptor() ([]byte, []int) {
-	return file_Network_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use Interface_LinkState.ProtoReflect.Descriptor instead.
+func (*Interface_LinkState) Descriptor() ([]byte, []int) {
+	return file_Network_proto_rawDescGZIP(), []int{3, 7}
 }
 
-func (x *NetworkInterfaceRequestWithLabel) GetLabel() string {
+func (x *Interface_LinkState) GetCarrier() bool {
 	if x != nil {
-		return x.Label
+		return x.Carrier
+	}
+	return false
+}
+
+func (x *Interface_LinkState) GetSpeed() uint32 {
+	if x != nil {
+		return x.Speed
+	}
+	return 0
+}
+
+func (x *Interface_LinkState) GetDuplex() string {
+	if x != nil {
+		return x.Duplex
 	}
 	return ""
 }
 
-// Interface type holds settings for a Network Interface.
-type Interface struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	GatewayInterface bool                   `protobuf:"varint,1,opt,name=GatewayInterface,proto3" json:"GatewayInterface,omitempty"` // if true, route metric will be set to 1. Otherwise route metric is -1. Similarly, when the interface is requested,return value will be true if route metric is 1.
-	MacAddress       string                 `protobuf:"bytes,2,opt,name=MacAddress,proto3" json:"MacAddress,omitempty"`              // "20:87:56:b5:ed:e0"
-	DHCP             string                 `protobuf:"bytes,3,opt,name=DHCP,proto3" json:"DHCP,omitempty"`                          // values can be 'enabled' or 'disabled'. for compatiblity reasons it is not boolean.
-	Static           *Interface_StaticConf  `protobuf:"bytes,4,opt,name=Static,proto3" json:"Static,omitempty"`                      // Static field is StaticConf type instance.
-	DNSConfig        *Interface_Dns         `protobuf:"bytes,5,opt,name=DNSConfig,proto3" json:"DNSConfig,omitempty"`                // DNSConfig is dns type instance.
-	L2Conf           *Interface_L2          `protobuf:"bytes,6,opt,name=L2Conf,proto3" json:"L2Conf,omitempty"`
-	InterfaceName    string                 `protobuf:"bytes,7,opt,name=InterfaceName,proto3" json:"InterfaceName,omitempty"` // ens2p
-	Label            string                 `protobuf:"bytes,8,opt,name=Label,proto3" json:"Label,omitempty"`                 // x1
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+func (x *Interface_LinkState) GetMTU() uint32 {
+	if x != nil {
+		return x.MTU
+	}
+	return 0
 }
 
-func (x *Interface) Reset() {
-	*x = Interface{}
-	mi := &file_Network_proto_msgTypes[2]
+func (x *Interface_LinkState) GetHwAddress() string {
+	if x != nil {
+		return x.HwAddress
+	}
+	return ""
+}
+
+// Type that contains a VLAN sub-interface of the interface, e.g: {"ID": 100, "DHCP": "enabled"}.
+type Interface_VlanConf struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ID            uint32                 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`      // VLAN ID, 1 to 4094
+	Name          string                 `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`   // Interface name, at most 15 characters. "<parent>.<ID>" if empty, e.g: "enp2s0.100".
+	Label         string                 `protobuf:"bytes,3,opt,name=Label,proto3" json:"Label,omitempty"` // Optional label, e.g: "X1.100"
+	DHCP          string                 `protobuf:"bytes,4,opt,name=DHCP,proto3" json:"DHCP,omitempty"`   // values can be 'enabled' or 'disabled'
+	Static        *Interface_StaticConf  `protobuf:"bytes,5,opt,name=Static,proto3" json:"Static,omitempty"`
+	DNSConfig     *Interface_Dns         `protobuf:"bytes,6,opt,name=DNSConfig,proto3" json:"DNSConfig,omitempty"`
+	Parent        string                 `protobuf:"bytes,7,opt,name=Parent,proto3" json:"Parent,omitempty"`  // Read only. Interface name of the parent, e.g: "enp2s0".
+	Active        bool                   `protobuf:"varint,8,opt,name=Active,proto3" json:"Active,omitempty"` // Read only. true if the VLAN interface is up.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Interface_VlanConf) Reset() {
+	*x = Interface_VlanConf{}
+	mi := &file_Network_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Interface) String() string {
+func (x *Interface_VlanConf) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Interface) ProtoMessage() {}
+func (*Interface_VlanConf) ProtoMessage() {}
 
-func (x *Interface) ProtoReflect() protoreflect.Message {
-	mi := &file_Network_proto_msgTypes[2]
+func (x *Interface_VlanConf) ProtoReflect() protoreflect.Message {
+	mi := &file_Network_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -163,91 +3617,90 @@ func (x *Interface) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Interface.ProtoReflect.Descriptor instead.
-func (*Interface) Descriptor() ([]byte, []int) {
-	return file_Network_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use Interface_VlanConf.ProtoReflect.Descriptor instead.
+func (*Interface_VlanConf) Descriptor() ([]byte, []int) {
+	return file_Network_proto_rawDescGZIP(), []int{3, 8}
 }
 
-func (x *Interface) GetGatewayInterface() bool {
+func (x *Interface_VlanConf) GetID() uint32 {
 	if x != nil {
-		return x.GatewayInterface
+		return x.ID
 	}
-	return false
+	return 0
 }
 
-func (x *Interface) GetMacAddress() string {
+func (x *Interface_VlanConf) GetName() string {
 	if x != nil {
-		return x.MacAddress
+		return x.Name
 	}
 	return ""
 }
 
-func (x *Interface) GetDHCP() string {
+func (x *Interface_VlanConf) GetLabel() string {
 	if x != nil {
-		return x.DHCP
+		return x.Label
 	}
 	return ""
 }
 
-func (x *Interface) GetStatic() *Interface_StaticConf {
+func (x *Interface_VlanConf) GetDHCP() string {
 	if x != nil {
-		return x.Static
+		return x.DHCP
 	}
-	return nil
+	return ""
 }
 
-func (x *Interface) GetDNSConfig() *Interface_Dns {
+func (x *Interface_VlanConf) GetStatic() *Interface_StaticConf {
 	if x != nil {
-		return x.DNSConfig
+		return x.Static
 	}
 	return nil
 }
 
-func (x *Interface) GetL2Conf() *Interface_L2 {
+func (x *Interface_VlanConf) GetDNSConfig() *Interface_Dns {
 	if x != nil {
-		return x.L2Conf
+		return x.DNSConfig
 	}
 	return nil
 }
 
-func (x *Interface) GetInterfaceName() string {
+func (x *Interface_VlanConf) GetParent() string {
 	if x != nil {
-		return x.InterfaceName
+		return x.Parent
 	}
 	return ""
 }
 
-func (x *Interface) GetLabel() string {
+func (x *Interface_VlanConf) GetActive() bool {
 	if x != nil {
-		return x.Label
+		return x.Active
 	}
-	return ""
+	return false
 }
 
-// Contains multiple network interface settings. It can be used to apply or get the settings.
-type NetworkSettings struct {
+// Type that contains the VLAN sub-interfaces of the interface.
+type Interface_VlanList struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Interfaces    []*Interface           `protobuf:"bytes,1,rep,name=Interfaces,proto3" json:"Interfaces,omitempty"`                                                                       // Network settings contains an array of Interfaces.Applying new settings or receiving current settings is supported for multiple ethernet typed network interfaces supported.
-	LabelMap      map[string]string      `protobuf:"bytes,2,rep,name=LabelMap,proto3" json:"LabelMap,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // LabelMap contains port label and corresponding interface-name. e.g key : x1 value: enp2s0
+	Interfaces    []*Interface_VlanConf  `protobuf:"bytes,1,rep,name=Interfaces,proto3" json:"Interfaces,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *NetworkSettings) Reset() {
-	*x = NetworkSettings{}
-	mi := &file_Network_proto_msgTypes[3]
+func (x *Interface_VlanList) Reset() {
+	*x = Interface_VlanList{}
+	mi := &file_Network_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *NetworkSettings) String() string {
+func (x *Interface_VlanList) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NetworkSettings) ProtoMessage() {}
+func (*Interface_VlanList) ProtoMessage() {}
 
-func (x *NetworkSettings) ProtoReflect() protoreflect.Message {
-	mi := &file_Network_proto_msgTypes[3]
+func (x *Interface_VlanList) ProtoReflect() protoreflect.Message {
+	mi := &file_Network_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -258,50 +3711,44 @@ func (x *NetworkSettings) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NetworkSettings.ProtoReflect.Descriptor instead.
-func (*NetworkSettings) Descriptor() ([]byte, []int) {
-	return file_Network_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use Interface_VlanList.ProtoReflect.Descriptor instead.
+func (*Interface_VlanList) Descriptor() ([]byte, []int) {
+	return file_Network_proto_rawDescGZIP(), []int{3, 9}
 }
 
-func (x *NetworkSettings) GetInterfaces() []*Interface {
+func (x *Interface_VlanList) GetInterfaces() []*Interface_VlanConf {
 	if x != nil {
 		return x.Interfaces
 	}
 	return nil
 }
 
-func (x *NetworkSettings) GetLabelMap() map[string]string {
-	if x != nil {
-		return x.LabelMap
-	}
-	return nil
-}
-
-// StaticConf type holds IP Netmask and Gateway information
-type Interface_StaticConf struct {
+// Type that contains the live state of the bond, as read from the device.
+type Bond_BondState struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	IPv4          string                 `protobuf:"bytes,1,opt,name=IPv4,proto3" json:"IPv4,omitempty"`       // e.g: 192.168.0.2
-	NetMask       string                 `protobuf:"bytes,2,opt,name=NetMask,proto3" json:"NetMask,omitempty"` // e.g: 255.255.255.0
-	Gateway       string                 `protobuf:"bytes,3,opt,name=Gateway,proto3" json:"Gateway,omitempty"` // e.g: 192.168.0.1
+	Carrier       bool                   `protobuf:"varint,1,opt,name=Carrier,proto3" json:"Carrier,omitempty"`        // true if the bond is up
+	Mode          string                 `protobuf:"bytes,2,opt,name=Mode,proto3" json:"Mode,omitempty"`               // mode in effect, e.g: "active-backup"
+	ActiveSlave   string                 `protobuf:"bytes,3,opt,name=ActiveSlave,proto3" json:"ActiveSlave,omitempty"` // Interface name of the member which carries the traffic, e.g: "enp2s0". Empty in modes without one.
+	Slaves        []string               `protobuf:"bytes,4,rep,name=Slaves,proto3" json:"Slaves,omitempty"`           // Interface names of the members attached to the bond
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Interface_StaticConf) Reset() {
-	*x = Interface_StaticConf{}
-	mi := &file_Network_proto_msgTypes[4]
+func (x *Bond_BondState) Reset() {
+	*x = Bond_BondState{}
+	mi := &file_Network_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Interface_StaticConf) String() string {
+func (x *Bond_BondState) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Interface_StaticConf) ProtoMessage() {}
+func (*Bond_BondState) ProtoMessage() {}
 
-func (x *Interface_StaticConf) ProtoReflect() protoreflect.Message {
-	mi := &file_Network_proto_msgTypes[4]
+func (x *Bond_BondState) ProtoReflect() protoreflect.Message {
+	mi := &file_Network_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -312,56 +3759,65 @@ func (x *Interface_StaticConf) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Interface_StaticConf.ProtoReflect.Descriptor instead.
-func (*Interface_StaticConf) Descriptor() ([]byte, []int) {
-	return file_Network_proto_rawDescGZIP(), []int{2, 0}
+// Deprecated: Use Bond_BondState.ProtoReflect.Descriptor instead.
+func (*Bond_BondState) Descriptor() ([]byte, []int) {
+	return file_Network_proto_rawDescGZIP(), []int{4, 0}
 }
 
-func (x *Interface_StaticConf) GetIPv4() string {
+func (x *Bond_BondState) GetCarrier() bool {
 	if x != nil {
-		return x.IPv4
+		return x.Carrier
 	}
-	return ""
+	return false
 }
 
-func (x *Interface_StaticConf) GetNetMask() string {
+func (x *Bond_BondState) GetMode() string {
 	if x != nil {
-		return x.NetMask
+		return x.Mode
 	}
 	return ""
 }
 
-func (x *Interface_StaticConf) GetGateway() string {
+func (x *Bond_BondState) GetActiveSlave() string {
 	if x != nil {
-		return x.Gateway
+		return x.ActiveSlave
 	}
 	return ""
 }
 
-// Type that contains Primary and Secondary DNS.
-type Interface_Dns struct {
+func (x *Bond_BondState) GetSlaves() []string {
+	if x != nil {
+		return x.Slaves
+	}
+	return nil
+}
+
+// ResolverSource is a configuration NetworkManager passes to the resolver, e.g. the DNS servers of an interface.
+type ResolverStatus_ResolverSource struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PrimaryDNS    string                 `protobuf:"bytes,1,opt,name=PrimaryDNS,proto3" json:"PrimaryDNS,omitempty"`     // e.g:  "1.1.1.2"
-	SecondaryDNS  string                 `protobuf:"bytes,2,opt,name=SecondaryDNS,proto3" json:"SecondaryDNS,omitempty"` // e.g: "1.1.1.1"
+	Interface     string                 `protobuf:"bytes,1,opt,name=Interface,proto3" json:"Interface,omitempty"` // e.g: "ens18", empty for the global DNS configuration
+	Servers       []string               `protobuf:"bytes,2,rep,name=Servers,proto3" json:"Servers,omitempty"`
+	Priority      int32                  `protobuf:"varint,3,opt,name=Priority,proto3" json:"Priority,omitempty"`
+	Vpn           bool                   `protobuf:"varint,4,opt,name=Vpn,proto3" json:"Vpn,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Interface_Dns) Reset() {
-	*x = Interface_Dns{}
-	mi := &file_Network_proto_msgTypes[5]
+func (x *ResolverStatus_ResolverSource) Reset() {
+	*x = ResolverStatus_ResolverSource{}
+	mi := &file_Network_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Interface_Dns) String() string {
+func (x *ResolverStatus_ResolverSource) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Interface_Dns) ProtoMessage() {}
+func (*ResolverStatus_ResolverSource) ProtoMessage() {}
 
-func (x *Interface_Dns) ProtoReflect() protoreflect.Message {
-	mi := &file_Network_proto_msgTypes[5]
+func (x *ResolverStatus_ResolverSource) ProtoReflect() protoreflect.Message {
+	mi := &file_Network_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -372,51 +3828,64 @@ func (x *Interface_Dns) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Interface_Dns.ProtoReflect.Descriptor instead.
-func (*Interface_Dns) Descriptor() ([]byte, []int) {
-	return file_Network_proto_rawDescGZIP(), []int{2, 1}
+// Deprecated: Use ResolverStatus_ResolverSource.ProtoReflect.Descriptor instead.
+func (*ResolverStatus_ResolverSource) Descriptor() ([]byte, []int) {
+	return file_Network_proto_rawDescGZIP(), []int{11, 0}
 }
 
-func (x *Interface_Dns) GetPrimaryDNS() string {
+func (x *ResolverStatus_ResolverSource) GetInterface() string {
 	if x != nil {
-		return x.PrimaryDNS
+		return x.Interface
 	}
 	return ""
 }
 
-func (x *Interface_Dns) GetSecondaryDNS() string {
+func (x *ResolverStatus_ResolverSource) GetServers() []string {
 	if x != nil {
-		return x.SecondaryDNS
+		return x.Servers
 	}
-	return ""
+	return nil
 }
 
-type Interface_L2 struct {
-	state               protoimpl.MessageState `protogen:"open.v1"`
-	StartingAddressIPv4 string                 `protobuf:"bytes,1,opt,name=StartingAddressIPv4,proto3" json:"StartingAddressIPv4,omitempty"`                                                                         // e.g: 192.168.0.2
-	NetMask             string                 `protobuf:"bytes,2,opt,name=NetMask,proto3" json:"NetMask,omitempty"`                                                                                                 // e.g: 255.255.255.0
-	Range               string                 `protobuf:"bytes,3,opt,name=Range,proto3" json:"Range,omitempty"`                                                                                                     // e.g: 16
-	Gateway             string                 `protobuf:"bytes,4,opt,name=Gateway,proto3" json:"Gateway,omitempty"`                                                                                                 //e.g: 192.168.2.1
-	AuxiliaryAddresses  map[string]string      `protobuf:"bytes,5,rep,name=AuxiliaryAddresses,proto3" json:"AuxiliaryAddresses,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` //Preserved addresses for other devices. These addresses won't be assigned to containers. e.g  my_plc,  192.168.0.5
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+func (x *ResolverStatus_ResolverSource) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
 }
 
-func (x *Interface_L2) Reset() {
-	*x = Interface_L2{}
-	mi := &file_Network_proto_msgTypes[6]
+func (x *ResolverStatus_ResolverSource) GetVpn() bool {
+	if x != nil {
+		return x.Vpn
+	}
+	return false
+}
+
+// Constraints of one interface.
+type NetworkPolicy_InterfaceRule struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Label           string                 `protobuf:"bytes,1,opt,name=Label,proto3" json:"Label,omitempty"`                     // Label of the interface, e.g: X1
+	DHCP            string                 `protobuf:"bytes,2,opt,name=DHCP,proto3" json:"DHCP,omitempty"`                       // Optional. The interface must always use this DHCP setting, "enabled" or "disabled".
+	AllowedNetworks []string               `protobuf:"bytes,3,rep,name=AllowedNetworks,proto3" json:"AllowedNetworks,omitempty"` // Optional. The static address and gateway of the interface must be in one of these IPv4 networks, e.g: 192.168.0.0/16
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *NetworkPolicy_InterfaceRule) Reset() {
+	*x = NetworkPolicy_InterfaceRule{}
+	mi := &file_Network_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Interface_L2) String() string {
+func (x *NetworkPolicy_InterfaceRule) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Interface_L2) ProtoMessage() {}
+func (*NetworkPolicy_InterfaceRule) ProtoMessage() {}
 
-func (x *Interface_L2) ProtoReflect() protoreflect.Message {
-	mi := &file_Network_proto_msgTypes[6]
+func (x *NetworkPolicy_InterfaceRule) ProtoReflect() protoreflect.Message {
+	mi := &file_Network_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -427,42 +3896,28 @@ func (x *Interface_L2) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Interface_L2.ProtoReflect.Descriptor instead.
-func (*Interface_L2) Descriptor() ([]byte, []int) {
-	return file_Network_proto_rawDescGZIP(), []int{2, 2}
-}
-
-func (x *Interface_L2) GetStartingAddressIPv4() string {
-	if x != nil {
-		return x.StartingAddressIPv4
-	}
-	return ""
-}
-
-func (x *Interface_L2) GetNetMask() string {
-	if x != nil {
-		return x.NetMask
-	}
-	return ""
+// Deprecated: Use NetworkPolicy_InterfaceRule.ProtoReflect.Descriptor instead.
+func (*NetworkPolicy_InterfaceRule) Descriptor() ([]byte, []int) {
+	return file_Network_proto_rawDescGZIP(), []int{33, 0}
 }
 
-func (x *Interface_L2) GetRange() string {
+func (x *NetworkPolicy_InterfaceRule) GetLabel() string {
 	if x != nil {
-		return x.Range
+		return x.Label
 	}
 	return ""
 }
 
-func (x *Interface_L2) GetGateway() string {
+func (x *NetworkPolicy_InterfaceRule) GetDHCP() string {
 	if x != nil {
-		return x.Gateway
+		return x.DHCP
 	}
 	return ""
 }
 
-func (x *Interface_L2) GetAuxiliaryAddresses() map[string]string {
+func (x *NetworkPolicy_InterfaceRule) GetAllowedNetworks() []string {
 	if x != nil {
-		return x.AuxiliaryAddresses
+		return x.AllowedNetworks
 	}
 	return nil
 }
@@ -474,115 +3929,809 @@ var file_Network_proto_rawDesc = string([]byte{
 	0x1e, 0x73, 0x69, 0x65, 0x6d, 0x65, 0x6e, 0x73, 0x2e, 0x69, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x64,
 	0x6d, 0x61, 0x70, 0x69, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x1a,
 	0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x2b, 0x0a, 0x17,
-	0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x61, 0x63, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x61, 0x63, 0x22, 0x38, 0x0a, 0x20, 0x4e, 0x65, 0x74,
-	0x77, 0x6f, 0x72, 0x6b, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x57, 0x69, 0x74, 0x68, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x14, 0x0a,
-	0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61,
-	0x62, 0x65, 0x6c, 0x22, 0xe9, 0x06, 0x0a, 0x09, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
-	0x65, 0x12, 0x2a, 0x0a, 0x10, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x49, 0x6e, 0x74, 0x65,
-	0x72, 0x66, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x47, 0x61, 0x74,
-	0x65, 0x77, 0x61, 0x79, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x1e, 0x0a,
-	0x0a, 0x4d, 0x61, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0a, 0x4d, 0x61, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a,
-	0x04, 0x44, 0x48, 0x43, 0x50, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x44, 0x48, 0x43,
-	0x50, 0x12, 0x4c, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x66,
+	0x0a, 0x17, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61,
+	0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x61, 0x63,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x61, 0x63, 0x12, 0x39, 0x0a, 0x0a, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x09, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x22, 0x73, 0x0a, 0x20, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x57, 0x69, 0x74, 0x68, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c,
+	0x12, 0x39, 0x0a, 0x0a, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b,
+	0x52, 0x09, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x22, 0x53, 0x0a, 0x16, 0x4e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6d,
+	0x61, 0x73, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x09, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b,
+	0x22, 0xc1, 0x18, 0x0a, 0x09, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x2a,
+	0x0a, 0x10, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61,
+	0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61,
+	0x79, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x4d, 0x61,
+	0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x4d, 0x61, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x44, 0x48,
+	0x43, 0x50, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x44, 0x48, 0x43, 0x50, 0x12, 0x4c,
+	0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x34,
+	0x2e, 0x73, 0x69, 0x65, 0x6d, 0x65, 0x6e, 0x73, 0x2e, 0x69, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x64,
+	0x6d, 0x61, 0x70, 0x69, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e,
+	0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63,
+	0x43, 0x6f, 0x6e, 0x66, 0x52, 0x06, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x12, 0x4b, 0x0a, 0x09,
+	0x44, 0x4e, 0x53, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2d, 0x2e, 0x73, 0x69, 0x65, 0x6d, 0x65, 0x6e, 0x73, 0x2e, 0x69, 0x65, 0x64, 0x67, 0x65, 0x2e,
+	0x64, 0x6d, 0x61, 0x70, 0x69, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31,
+	0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x2e, 0x44, 0x6e, 0x73, 0x52, 0x09,
+	0x44, 0x4e, 0x53, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x44, 0x0a, 0x06, 0x4c, 0x32, 0x43,
+	0x6f, 0x6e, 0x66, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x73, 0x69, 0x65, 0x6d,
+	0x65, 0x6e, 0x73, 0x2e, 0x69, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x64, 0x6d, 0x61, 0x70, 0x69, 0x2e,
+	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x66, 0x61, 0x63, 0x65, 0x2e, 0x4c, 0x32, 0x52, 0x06, 0x4c, 0x32, 0x43, 0x6f, 0x6e, 0x66, 0x12,
+	0x24, 0x0a, 0x0d, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x47,
+	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0a, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x0f, 0x52,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x4f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x50, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x55, 0x0a, 0x09, 0x52, 0x65, 0x63,
+	0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x37, 0x2e, 0x73,
+	0x69, 0x65, 0x6d, 0x65, 0x6e, 0x73, 0x2e, 0x69, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x64, 0x6d, 0x61,
+	0x70, 0x69, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e,
+	0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c,
+	0x65, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x09, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65,
+	0x12, 0x47, 0x0a, 0x06, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x2f, 0x2e, 0x73, 0x69, 0x65, 0x6d, 0x65, 0x6e, 0x73, 0x2e, 0x69, 0x65, 0x64, 0x67, 0x65,
+	0x2e, 0x64, 0x6d, 0x61, 0x70, 0x69, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76,
+	0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x2e, 0x52, 0x6f, 0x75, 0x74,
+	0x65, 0x52, 0x06, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x12, 0x52, 0x0a, 0x08, 0x46, 0x61, 0x6c,
+	0x6c, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x73, 0x69,
+	0x65, 0x6d, 0x65, 0x6e, 0x73, 0x2e, 0x69, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x64, 0x6d, 0x61, 0x70,
+	0x69, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74,
+	0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x2e, 0x46, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x43,
+	0x6f, 0x6e, 0x66, 0x52, 0x08, 0x46, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x24, 0x0a,
+	0x0d, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x0f,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x12, 0x54, 0x0a, 0x0b, 0x44, 0x48, 0x43, 0x50, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x73, 0x69, 0x65, 0x6d, 0x65,
+	0x6e, 0x73, 0x2e, 0x69, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x64, 0x6d, 0x61, 0x70, 0x69, 0x2e, 0x6e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66,
+	0x61, 0x63, 0x65, 0x2e, 0x44, 0x48, 0x43, 0x50, 0x43, 0x6f, 0x6e, 0x66, 0x52, 0x0b, 0x44, 0x48,
+	0x43, 0x50, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3f, 0x0a, 0x05, 0x4c, 0x65, 0x61,
+	0x73, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x73, 0x69, 0x65, 0x6d, 0x65,
+	0x6e, 0x73, 0x2e, 0x69, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x64, 0x6d, 0x61, 0x70, 0x69, 0x2e, 0x6e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x48, 0x43, 0x50, 0x4c, 0x65,
+	0x61, 0x73, 0x65, 0x52, 0x05, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x04, 0x4c, 0x69,
+	0x6e, 0x6b, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x73, 0x69, 0x65, 0x6d, 0x65,
+	0x6e, 0x73, 0x2e, 0x69, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x64, 0x6d, 0x61, 0x70, 0x69, 0x2e, 0x6e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66,
+	0x61, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x52, 0x04, 0x4c, 0x69,
+	0x6e, 0x6b, 0x12, 0x53, 0x0a, 0x0a, 0x4c, 0x69, 0x6e, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x73, 0x69, 0x65, 0x6d, 0x65, 0x6e, 0x73,
+	0x2e, 0x69, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x64, 0x6d, 0x61, 0x70, 0x69, 0x2e, 0x6e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
+	0x65, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x0a, 0x4c, 0x69, 0x6e,
+	0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x48, 0x0a, 0x05, 0x56, 0x6c, 0x61, 0x6e, 0x73,
+	0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x73, 0x69, 0x65, 0x6d, 0x65, 0x6e, 0x73,
+	0x2e, 0x69, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x64, 0x6d, 0x61, 0x70, 0x69, 0x2e, 0x6e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
+	0x65, 0x2e, 0x56, 0x6c, 0x61, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x05, 0x56, 0x6c, 0x61, 0x6e,
+	0x73, 0x12, 0x12, 0x0a, 0x04, 0x42, 0x6f, 0x6e, 0x64, 0x18, 0x15, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x42, 0x6f, 0x6e, 0x64, 0x1a, 0x54, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x43,
+	0x6f, 0x6e, 0x66, 0x12, 0x12, 0x0a, 0x04, 0x49, 0x50, 0x76, 0x34, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x49, 0x50, 0x76, 0x34, 0x12, 0x18, 0x0a, 0x07, 0x4e, 0x65, 0x74, 0x4d, 0x61,
+	0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4e, 0x65, 0x74, 0x4d, 0x61, 0x73,
+	0x6b, 0x12, 0x18, 0x0a, 0x07, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x1a, 0xe5, 0x02, 0x0a, 0x03,
+	0x44, 0x6e, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x44, 0x4e,
+	0x53, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79,
+	0x44, 0x4e, 0x53, 0x12, 0x22, 0x0a, 0x0c, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x61, 0x72, 0x79,
+	0x44, 0x4e, 0x53, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x53, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x61, 0x72, 0x79, 0x44, 0x4e, 0x53, 0x12, 0x18, 0x0a, 0x07, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x73, 0x12, 0x1a, 0x0a, 0x08, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x08, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x73, 0x12, 0x1a, 0x0a,
+	0x08, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x54, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x54, 0x69, 0x6d, 0x65,
+	0x6f, 0x75, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x12, 0x4a, 0x0a, 0x04, 0x41, 0x75, 0x74, 0x6f, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x36, 0x2e, 0x73, 0x69, 0x65, 0x6d, 0x65, 0x6e, 0x73, 0x2e,
+	0x69, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x64, 0x6d, 0x61, 0x70, 0x69, 0x2e, 0x6e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65,
+	0x2e, 0x44, 0x6e, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x6f, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x41,
+	0x75, 0x74, 0x6f, 0x22, 0x2e, 0x0a, 0x08, 0x41, 0x75, 0x74, 0x6f, 0x4d, 0x6f, 0x64, 0x65, 0x12,
+	0x0b, 0x0a, 0x07, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06,
+	0x49, 0x47, 0x4e, 0x4f, 0x52, 0x45, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x4d, 0x45, 0x52, 0x47,
+	0x45, 0x10, 0x02, 0x1a, 0xbd, 0x02, 0x0a, 0x02, 0x4c, 0x32, 0x12, 0x30, 0x0a, 0x13, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x49, 0x50, 0x76,
+	0x34, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x53, 0x74, 0x61, 0x72, 0x74, 0x69, 0x6e,
+	0x67, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x49, 0x50, 0x76, 0x34, 0x12, 0x18, 0x0a, 0x07,
+	0x4e, 0x65, 0x74, 0x4d, 0x61, 0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4e,
+	0x65, 0x74, 0x4d, 0x61, 0x73, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x47,
+	0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x12, 0x74, 0x0a, 0x12, 0x41, 0x75, 0x78, 0x69, 0x6c, 0x69,
+	0x61, 0x72, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x44, 0x2e, 0x73, 0x69, 0x65, 0x6d, 0x65, 0x6e, 0x73, 0x2e, 0x69, 0x65, 0x64,
+	0x67, 0x65, 0x2e, 0x64, 0x6d, 0x61, 0x70, 0x69, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x2e, 0x4c, 0x32,
+	0x2e, 0x41, 0x75, 0x78, 0x69, 0x6c, 0x69, 0x61, 0x72, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x12, 0x41, 0x75, 0x78, 0x69, 0x6c, 0x69,
+	0x61, 0x72, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x1a, 0x45, 0x0a, 0x17,
+	0x41, 0x75, 0x78, 0x69, 0x6c, 0x69, 0x61, 0x72, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x1a, 0x5b, 0x0a, 0x05, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x0b,
+	0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18,
+	0x0a, 0x07, 0x4e, 0x65, 0x78, 0x74, 0x48, 0x6f, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x4e, 0x65, 0x78, 0x74, 0x48, 0x6f, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x4d, 0x65, 0x74, 0x72,
+	0x69, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63,
+	0x1a, 0x9c, 0x01, 0x0a, 0x0c, 0x46, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x43, 0x6f, 0x6e,
+	0x66, 0x12, 0x20, 0x0a, 0x0b, 0x44, 0x48, 0x43, 0x50, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x44, 0x48, 0x43, 0x50, 0x54, 0x69, 0x6d, 0x65,
+	0x6f, 0x75, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x4c, 0x69, 0x6e, 0x6b, 0x4c, 0x6f, 0x63, 0x61, 0x6c,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x4c, 0x69, 0x6e, 0x6b, 0x4c, 0x6f, 0x63, 0x61,
+	0x6c, 0x12, 0x4c, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x34, 0x2e, 0x73, 0x69, 0x65, 0x6d, 0x65, 0x6e, 0x73, 0x2e, 0x69, 0x65, 0x64, 0x67,
 	0x65, 0x2e, 0x64, 0x6d, 0x61, 0x70, 0x69, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e,
 	0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x2e, 0x53, 0x74, 0x61,
-	0x74, 0x69, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x52, 0x06, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x12,
-	0x4b, 0x0a, 0x09, 0x44, 0x4e, 0x53, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x73, 0x69, 0x65, 0x6d, 0x65, 0x6e, 0x73, 0x2e, 0x69, 0x65, 0x64,
-	0x67, 0x65, 0x2e, 0x64, 0x6d, 0x61, 0x70, 0x69, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
-	0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x2e, 0x44, 0x6e,
-	0x73, 0x52, 0x09, 0x44, 0x4e, 0x53, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x44, 0x0a, 0x06,
-	0x4c, 0x32, 0x43, 0x6f, 0x6e, 0x66, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x73,
+	0x74, 0x69, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x52, 0x06, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x1a,
+	0xbc, 0x01, 0x0a, 0x08, 0x44, 0x48, 0x43, 0x50, 0x43, 0x6f, 0x6e, 0x66, 0x12, 0x1a, 0x0a, 0x08,
+	0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x53, 0x65, 0x6e, 0x64,
+	0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x53, 0x65, 0x6e, 0x64, 0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x56, 0x65, 0x6e, 0x64,
+	0x6f, 0x72, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x56,
+	0x65, 0x6e, 0x64, 0x6f, 0x72, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x54, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x54, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x4d, 0x61, 0x79, 0x46, 0x61, 0x69, 0x6c, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4d, 0x61, 0x79, 0x46, 0x61, 0x69, 0x6c, 0x1a, 0xac,
+	0x01, 0x0a, 0x08, 0x4c, 0x69, 0x6e, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x12, 0x10, 0x0a, 0x03, 0x4d,
+	0x54, 0x55, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x4d, 0x54, 0x55, 0x12, 0x14, 0x0a,
+	0x05, 0x53, 0x70, 0x65, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x53, 0x70,
+	0x65, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x44, 0x75, 0x70, 0x6c, 0x65, 0x78, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x44, 0x75, 0x70, 0x6c, 0x65, 0x78, 0x12, 0x24, 0x0a, 0x0d, 0x41,
+	0x75, 0x74, 0x6f, 0x4e, 0x65, 0x67, 0x6f, 0x74, 0x69, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x41, 0x75, 0x74, 0x6f, 0x4e, 0x65, 0x67, 0x6f, 0x74, 0x69, 0x61, 0x74,
+	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x64, 0x4d, 0x41, 0x43, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x64, 0x4d, 0x41, 0x43, 0x12,
+	0x1c, 0x0a, 0x09, 0x57, 0x61, 0x6b, 0x65, 0x4f, 0x6e, 0x4c, 0x61, 0x6e, 0x18, 0x06, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x09, 0x57, 0x61, 0x6b, 0x65, 0x4f, 0x6e, 0x4c, 0x61, 0x6e, 0x1a, 0x83, 0x01,
+	0x0a, 0x09, 0x4c, 0x69, 0x6e, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x43,
+	0x61, 0x72, 0x72, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x43, 0x61,
+	0x72, 0x72, 0x69, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x53, 0x70, 0x65, 0x65, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x53, 0x70, 0x65, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x44,
+	0x75, 0x70, 0x6c, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x44, 0x75, 0x70,
+	0x6c, 0x65, 0x78, 0x12, 0x10, 0x0a, 0x03, 0x4d, 0x54, 0x55, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x03, 0x4d, 0x54, 0x55, 0x12, 0x1c, 0x0a, 0x09, 0x48, 0x77, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x48, 0x77, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x1a, 0xa3, 0x02, 0x0a, 0x08, 0x56, 0x6c, 0x61, 0x6e, 0x43, 0x6f, 0x6e, 0x66,
+	0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x49, 0x44,
+	0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x44, 0x48,
+	0x43, 0x50, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x44, 0x48, 0x43, 0x50, 0x12, 0x4c,
+	0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x34,
+	0x2e, 0x73, 0x69, 0x65, 0x6d, 0x65, 0x6e, 0x73, 0x2e, 0x69, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x64,
+	0x6d, 0x61, 0x70, 0x69, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e,
+	0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63,
+	0x43, 0x6f, 0x6e, 0x66, 0x52, 0x06, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x12, 0x4b, 0x0a, 0x09,
+	0x44, 0x4e, 0x53, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2d, 0x2e, 0x73, 0x69, 0x65, 0x6d, 0x65, 0x6e, 0x73, 0x2e, 0x69, 0x65, 0x64, 0x67, 0x65, 0x2e,
+	0x64, 0x6d, 0x61, 0x70, 0x69, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31,
+	0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x2e, 0x44, 0x6e, 0x73, 0x52, 0x09,
+	0x44, 0x4e, 0x53, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x50, 0x61, 0x72,
+	0x65, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x50, 0x61, 0x72, 0x65, 0x6e,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x06, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x1a, 0x5e, 0x0a, 0x08, 0x56, 0x6c, 0x61,
+	0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x52, 0x0a, 0x0a, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61,
+	0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x73, 0x69, 0x65, 0x6d,
+	0x65, 0x6e, 0x73, 0x2e, 0x69, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x64, 0x6d, 0x61, 0x70, 0x69, 0x2e,
+	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x66, 0x61, 0x63, 0x65, 0x2e, 0x56, 0x6c, 0x61, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x52, 0x0a, 0x49,
+	0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x22, 0x29, 0x0a, 0x0d, 0x52, 0x65, 0x63,
+	0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x4f, 0x42,
+	0x53, 0x45, 0x52, 0x56, 0x45, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x45, 0x4e, 0x46, 0x4f, 0x52,
+	0x43, 0x45, 0x10, 0x01, 0x22, 0xe6, 0x03, 0x0a, 0x04, 0x42, 0x6f, 0x6e, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x4d, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x4d, 0x69, 0x69, 0x4d, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x06, 0x4d, 0x69, 0x69, 0x4d, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x50, 0x72, 0x69, 0x6d, 0x61,
+	0x72, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72,
+	0x79, 0x12, 0x12, 0x0a, 0x04, 0x44, 0x48, 0x43, 0x50, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x44, 0x48, 0x43, 0x50, 0x12, 0x4c, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x73, 0x69, 0x65, 0x6d, 0x65, 0x6e, 0x73, 0x2e,
+	0x69, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x64, 0x6d, 0x61, 0x70, 0x69, 0x2e, 0x6e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65,
+	0x2e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x52, 0x06, 0x53, 0x74, 0x61,
+	0x74, 0x69, 0x63, 0x12, 0x4b, 0x0a, 0x09, 0x44, 0x4e, 0x53, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x73, 0x69, 0x65, 0x6d, 0x65, 0x6e, 0x73,
+	0x2e, 0x69, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x64, 0x6d, 0x61, 0x70, 0x69, 0x2e, 0x6e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
+	0x65, 0x2e, 0x44, 0x6e, 0x73, 0x52, 0x09, 0x44, 0x4e, 0x53, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x12, 0x46, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2e, 0x2e, 0x73, 0x69, 0x65, 0x6d, 0x65, 0x6e, 0x73, 0x2e, 0x69, 0x65, 0x64, 0x67, 0x65,
+	0x2e, 0x64, 0x6d, 0x61, 0x70, 0x69, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76,
+	0x31, 0x2e, 0x42, 0x6f, 0x6e, 0x64, 0x2e, 0x42, 0x6f, 0x6e, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x52, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x1a, 0x73, 0x0a, 0x09, 0x42, 0x6f, 0x6e, 0x64,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x43, 0x61, 0x72, 0x72, 0x69, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x43, 0x61, 0x72, 0x72, 0x69, 0x65, 0x72, 0x12,
+	0x12, 0x0a, 0x04, 0x4d, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4d,
+	0x6f, 0x64, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x53, 0x6c, 0x61,
+	0x76, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x53, 0x6c, 0x61, 0x76, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x6c, 0x61, 0x76, 0x65, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x53, 0x6c, 0x61, 0x76, 0x65, 0x73, 0x22, 0x50, 0x0a,
+	0x08, 0x42, 0x6f, 0x6e, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x44, 0x0a, 0x0a, 0x49, 0x6e, 0x74,
+	0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e,
+	0x73, 0x69, 0x65, 0x6d, 0x65, 0x6e, 0x73, 0x2e, 0x69, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x64, 0x6d,
+	0x61, 0x70, 0x69, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x42,
+	0x6f, 0x6e, 0x64, 0x52, 0x0a, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x22,
+	0xe7, 0x01, 0x0a, 0x09, 0x44, 0x48, 0x43, 0x50, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x54, 0x69,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x12, 0x50, 0x0a, 0x07, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x73,
 	0x69, 0x65, 0x6d, 0x65, 0x6e, 0x73, 0x2e, 0x69, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x64, 0x6d, 0x61,
-	0x70, 0x69, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e,
-	0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x2e, 0x4c, 0x32, 0x52, 0x06, 0x4c, 0x32, 0x43, 0x6f,
-	0x6e, 0x66, 0x12, 0x24, 0x0a, 0x0d, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x4e,
-	0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x49, 0x6e, 0x74, 0x65, 0x72,
-	0x66, 0x61, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x4c, 0x61, 0x62, 0x65,
-	0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x1a, 0x54,
-	0x0a, 0x0a, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x12, 0x12, 0x0a, 0x04,
-	0x49, 0x50, 0x76, 0x34, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x49, 0x50, 0x76, 0x34,
-	0x12, 0x18, 0x0a, 0x07, 0x4e, 0x65, 0x74, 0x4d, 0x61, 0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x4e, 0x65, 0x74, 0x4d, 0x61, 0x73, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x47, 0x61,
-	0x74, 0x65, 0x77, 0x61, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x47, 0x61, 0x74,
-	0x65, 0x77, 0x61, 0x79, 0x1a, 0x49, 0x0a, 0x03, 0x44, 0x6e, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x50,
-	0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x44, 0x4e, 0x53, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0a, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x44, 0x4e, 0x53, 0x12, 0x22, 0x0a, 0x0c, 0x53,
-	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x61, 0x72, 0x79, 0x44, 0x4e, 0x53, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0c, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x61, 0x72, 0x79, 0x44, 0x4e, 0x53, 0x1a,
-	0xbd, 0x02, 0x0a, 0x02, 0x4c, 0x32, 0x12, 0x30, 0x0a, 0x13, 0x53, 0x74, 0x61, 0x72, 0x74, 0x69,
-	0x6e, 0x67, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x49, 0x50, 0x76, 0x34, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x13, 0x53, 0x74, 0x61, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x49, 0x50, 0x76, 0x34, 0x12, 0x18, 0x0a, 0x07, 0x4e, 0x65, 0x74, 0x4d,
-	0x61, 0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4e, 0x65, 0x74, 0x4d, 0x61,
-	0x73, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x47, 0x61, 0x74, 0x65,
-	0x77, 0x61, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x47, 0x61, 0x74, 0x65, 0x77,
-	0x61, 0x79, 0x12, 0x74, 0x0a, 0x12, 0x41, 0x75, 0x78, 0x69, 0x6c, 0x69, 0x61, 0x72, 0x79, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x44,
+	0x70, 0x69, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x48,
+	0x43, 0x50, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x3a, 0x0a,
+	0x0c, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x70, 0x0a, 0x10, 0x44, 0x48, 0x43,
+	0x50, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a,
+	0x03, 0x6d, 0x61, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x61, 0x63, 0x12,
+	0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x34, 0x0a, 0x15, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x4d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x4d, 0x61, 0x6e, 0x61, 0x67,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x22, 0x31, 0x0a, 0x09, 0x48,
+	0x6f, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x50, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x50, 0x12, 0x14, 0x0a, 0x05, 0x4e, 0x61, 0x6d, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x52,
+	0x0a, 0x0b, 0x48, 0x6f, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x43, 0x0a,
+	0x07, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29,
 	0x2e, 0x73, 0x69, 0x65, 0x6d, 0x65, 0x6e, 0x73, 0x2e, 0x69, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x64,
 	0x6d, 0x61, 0x70, 0x69, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e,
-	0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x2e, 0x4c, 0x32, 0x2e, 0x41, 0x75, 0x78,
-	0x69, 0x6c, 0x69, 0x61, 0x72, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x52, 0x12, 0x41, 0x75, 0x78, 0x69, 0x6c, 0x69, 0x61, 0x72, 0x79, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x1a, 0x45, 0x0a, 0x17, 0x41, 0x75, 0x78, 0x69,
-	0x6c, 0x69, 0x61, 0x72, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
-	0xf4, 0x01, 0x0a, 0x0f, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x53, 0x65, 0x74, 0x74, 0x69,
-	0x6e, 0x67, 0x73, 0x12, 0x49, 0x0a, 0x0a, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x73, 0x69, 0x65, 0x6d, 0x65, 0x6e,
-	0x73, 0x2e, 0x69, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x64, 0x6d, 0x61, 0x70, 0x69, 0x2e, 0x6e, 0x65,
-	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61,
-	0x63, 0x65, 0x52, 0x0a, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x12, 0x59,
-	0x0a, 0x08, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x4d, 0x61, 0x70, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x3d, 0x2e, 0x73, 0x69, 0x65, 0x6d, 0x65, 0x6e, 0x73, 0x2e, 0x69, 0x65, 0x64, 0x67, 0x65,
+	0x48, 0x6f, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x45, 0x6e, 0x74, 0x72, 0x69,
+	0x65, 0x73, 0x22, 0x8f, 0x01, 0x0a, 0x09, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x44, 0x6e, 0x73,
+	0x12, 0x18, 0x0a, 0x07, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x07, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x53, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x53, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
+	0x12, 0x1a, 0x0a, 0x08, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x08, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06,
+	0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x52, 0x6f,
+	0x74, 0x61, 0x74, 0x65, 0x22, 0xf3, 0x03, 0x0a, 0x0e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65,
+	0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x4d, 0x6f, 0x64, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x52,
+	0x63, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x52, 0x63, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x12, 0x41, 0x0a, 0x06, 0x47, 0x6c, 0x6f,
+	0x62, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x73, 0x69, 0x65, 0x6d,
+	0x65, 0x6e, 0x73, 0x2e, 0x69, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x64, 0x6d, 0x61, 0x70, 0x69, 0x2e,
+	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x6c, 0x6f, 0x62, 0x61,
+	0x6c, 0x44, 0x6e, 0x73, 0x52, 0x06, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x12, 0x57, 0x0a, 0x07,
+	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3d, 0x2e,
+	0x73, 0x69, 0x65, 0x6d, 0x65, 0x6e, 0x73, 0x2e, 0x69, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x64, 0x6d,
+	0x61, 0x70, 0x69, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x52, 0x65,
+	0x73, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x07, 0x53, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x12,
+	0x1a, 0x0a, 0x08, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x08, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x4f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x4b, 0x0a, 0x0b, 0x48, 0x6f, 0x73, 0x74, 0x45, 0x6e, 0x74,
+	0x72, 0x69, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x73, 0x69, 0x65,
+	0x6d, 0x65, 0x6e, 0x73, 0x2e, 0x69, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x64, 0x6d, 0x61, 0x70, 0x69,
+	0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x48, 0x6f, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69,
+	0x65, 0x73, 0x1a, 0x76, 0x0a, 0x0e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x53, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61,
+	0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x07, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x12, 0x1a, 0x0a, 0x08,
+	0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x56, 0x70, 0x6e, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x56, 0x70, 0x6e, 0x22, 0xfa, 0x02, 0x0a, 0x0c, 0x41,
+	0x70, 0x70, 0x6c, 0x79, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x6b, 0x0a, 0x0f, 0x46,
+	0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x41, 0x2e, 0x73, 0x69, 0x65, 0x6d, 0x65, 0x6e, 0x73, 0x2e, 0x69,
+	0x65, 0x64, 0x67, 0x65, 0x2e, 0x64, 0x6d, 0x61, 0x70, 0x69, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x2e, 0x46, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c,
+	0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0f, 0x46, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e,
+	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x43, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x34, 0x0a, 0x15, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x4d, 0x61, 0x6e, 0x61, 0x67,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x15, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x72, 0x6d, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
+	0x12, 0x49, 0x0a, 0x06, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x31, 0x2e, 0x73, 0x69, 0x65, 0x6d, 0x65, 0x6e, 0x73, 0x2e, 0x69, 0x65, 0x64, 0x67, 0x65,
 	0x2e, 0x64, 0x6d, 0x61, 0x70, 0x69, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76,
-	0x31, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
-	0x73, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
-	0x08, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x4d, 0x61, 0x70, 0x1a, 0x3b, 0x0a, 0x0d, 0x4c, 0x61, 0x62,
-	0x65, 0x6c, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x32, 0xc9, 0x03, 0x0a, 0x0e, 0x4e, 0x65, 0x74, 0x77, 0x6f,
-	0x72, 0x6b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x5b, 0x0a, 0x10, 0x47, 0x65, 0x74,
-	0x41, 0x6c, 0x6c, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x12, 0x16, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x2f, 0x2e, 0x73, 0x69, 0x65, 0x6d, 0x65, 0x6e, 0x73, 0x2e,
-	0x69, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x64, 0x6d, 0x61, 0x70, 0x69, 0x2e, 0x6e, 0x65, 0x74, 0x77,
-	0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x53, 0x65,
-	0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x79, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x74,
-	0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x57, 0x69, 0x74, 0x68, 0x4d, 0x61, 0x63, 0x12, 0x37, 0x2e,
+	0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x52, 0x06, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x22, 0x3a, 0x0a, 0x14, 0x46,
+	0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x12, 0x0a, 0x0a, 0x06, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x00, 0x12,
+	0x09, 0x0a, 0x05, 0x4c, 0x45, 0x41, 0x56, 0x45, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x44, 0x49,
+	0x53, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x02, 0x22, 0xd2, 0x01, 0x0a, 0x11, 0x43, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x4f, 0x0a,
+	0x04, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x3b, 0x2e, 0x73, 0x69,
+	0x65, 0x6d, 0x65, 0x6e, 0x73, 0x2e, 0x69, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x64, 0x6d, 0x61, 0x70,
+	0x69, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x2e, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
+	0x22, 0x3a, 0x0a, 0x09, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a,
+	0x07, 0x47, 0x41, 0x54, 0x45, 0x57, 0x41, 0x59, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x44, 0x4e,
+	0x53, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x54, 0x43, 0x50, 0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a,
+	0x44, 0x48, 0x43, 0x50, 0x5f, 0x4c, 0x45, 0x41, 0x53, 0x45, 0x10, 0x03, 0x22, 0xc6, 0x03, 0x0a,
+	0x0f, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73,
+	0x12, 0x49, 0x0a, 0x0a, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x73, 0x69, 0x65, 0x6d, 0x65, 0x6e, 0x73, 0x2e, 0x69,
+	0x65, 0x64, 0x67, 0x65, 0x2e, 0x64, 0x6d, 0x61, 0x70, 0x69, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x52,
+	0x0a, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x12, 0x59, 0x0a, 0x08, 0x4c,
+	0x61, 0x62, 0x65, 0x6c, 0x4d, 0x61, 0x70, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3d, 0x2e,
 	0x73, 0x69, 0x65, 0x6d, 0x65, 0x6e, 0x73, 0x2e, 0x69, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x64, 0x6d,
 	0x61, 0x70, 0x69, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x4e,
-	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x73, 0x69, 0x65, 0x6d, 0x65, 0x6e, 0x73,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x2e, 0x4c,
+	0x61, 0x62, 0x65, 0x6c, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x4c, 0x61,
+	0x62, 0x65, 0x6c, 0x4d, 0x61, 0x70, 0x12, 0x1e, 0x0a, 0x0a, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x47, 0x65, 0x6e, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x0f, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0f, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x46, 0x0a, 0x07, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2c, 0x2e, 0x73, 0x69, 0x65, 0x6d, 0x65, 0x6e, 0x73, 0x2e, 0x69, 0x65, 0x64, 0x67,
+	0x65, 0x2e, 0x64, 0x6d, 0x61, 0x70, 0x69, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e,
+	0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x07, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3e, 0x0a, 0x05, 0x42, 0x6f, 0x6e, 0x64,
+	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x73, 0x69, 0x65, 0x6d, 0x65, 0x6e,
+	0x73, 0x2e, 0x69, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x64, 0x6d, 0x61, 0x70, 0x69, 0x2e, 0x6e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6f, 0x6e, 0x64, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x05, 0x42, 0x6f, 0x6e, 0x64, 0x73, 0x1a, 0x3b, 0x0a, 0x0d, 0x4c, 0x61, 0x62, 0x65,
+	0x6c, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xa7, 0x03, 0x0a, 0x0e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66,
+	0x61, 0x63, 0x65, 0x44, 0x72, 0x69, 0x66, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x4d, 0x61, 0x63, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x4d, 0x61,
+	0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x4c, 0x61, 0x62, 0x65,
+	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x4b,
+	0x0a, 0x04, 0x4d, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x37, 0x2e, 0x73,
+	0x69, 0x65, 0x6d, 0x65, 0x6e, 0x73, 0x2e, 0x69, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x64, 0x6d, 0x61,
+	0x70, 0x69, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e,
+	0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c,
+	0x65, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x12, 0x43, 0x0a,
+	0x07, 0x44, 0x65, 0x73, 0x69, 0x72, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29,
+	0x2e, 0x73, 0x69, 0x65, 0x6d, 0x65, 0x6e, 0x73, 0x2e, 0x69, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x64,
+	0x6d, 0x61, 0x70, 0x69, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e,
+	0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x52, 0x07, 0x44, 0x65, 0x73, 0x69, 0x72,
+	0x65, 0x64, 0x12, 0x41, 0x0a, 0x06, 0x41, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x29, 0x2e, 0x73, 0x69, 0x65, 0x6d, 0x65, 0x6e, 0x73, 0x2e, 0x69, 0x65, 0x64,
+	0x67, 0x65, 0x2e, 0x64, 0x6d, 0x61, 0x70, 0x69, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x52, 0x06, 0x41,
+	0x63, 0x74, 0x75, 0x61, 0x6c, 0x12, 0x26, 0x0a, 0x0e, 0x4c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x72,
+	0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x4c,
+	0x61, 0x73, 0x74, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x0a,
+	0x13, 0x4c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x4c, 0x61, 0x73, 0x74,
+	0x43, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22,
+	0x7b, 0x0a, 0x0b, 0x44, 0x72, 0x69, 0x66, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x4e,
+	0x0a, 0x0a, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x73, 0x69, 0x65, 0x6d, 0x65, 0x6e, 0x73, 0x2e, 0x69, 0x65, 0x64,
+	0x67, 0x65, 0x2e, 0x64, 0x6d, 0x61, 0x70, 0x69, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x44, 0x72, 0x69,
+	0x66, 0x74, 0x52, 0x0a, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x12, 0x1c,
+	0x0a, 0x09, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x41, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x41, 0x74, 0x22, 0xdf, 0x01, 0x0a,
+	0x08, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x4e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x4e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x12, 0x1c, 0x0a, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12,
+	0x16, 0x0a, 0x06, 0x43, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x43, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x12, 0x4b, 0x0a, 0x08, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x73, 0x69, 0x65, 0x6d, 0x65, 0x6e, 0x73, 0x2e, 0x69, 0x65,
+	0x64, 0x67, 0x65, 0x2e, 0x64, 0x6d, 0x61, 0x70, 0x69, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x53, 0x65, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x73, 0x52, 0x08, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x1e,
+	0x0a, 0x0a, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x4f, 0x66, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0a, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x4f, 0x66, 0x22, 0x56,
+	0x0a, 0x0c, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x46,
+	0x0a, 0x09, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x28, 0x2e, 0x73, 0x69, 0x65, 0x6d, 0x65, 0x6e, 0x73, 0x2e, 0x69, 0x65, 0x64, 0x67,
+	0x65, 0x2e, 0x64, 0x6d, 0x61, 0x70, 0x69, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x52, 0x65, 0x76,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xa1, 0x01, 0x0a, 0x0f, 0x52, 0x65, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x4e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x4e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x34, 0x0a, 0x15,
+	0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x41, 0x6c, 0x6c,
+	0x6f, 0x77, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x54, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x72, 0x6d, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x22, 0x3a, 0x0a, 0x14, 0x44, 0x69,
+	0x66, 0x66, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x46, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x04, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x54, 0x6f, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x02, 0x54, 0x6f, 0x22, 0x68, 0x0a, 0x0e, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x49, 0x6e, 0x74, 0x65,
+	0x72, 0x66, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x49, 0x6e, 0x74,
+	0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x46, 0x72, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x46, 0x72, 0x6f, 0x6d,
+	0x12, 0x0e, 0x0a, 0x02, 0x54, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x54, 0x6f,
+	0x22, 0x7c, 0x0a, 0x0c, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x69, 0x66, 0x66,
+	0x12, 0x12, 0x0a, 0x04, 0x46, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04,
+	0x46, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x54, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x02, 0x54, 0x6f, 0x12, 0x48, 0x0a, 0x07, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x73, 0x69, 0x65, 0x6d, 0x65, 0x6e, 0x73, 0x2e,
+	0x69, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x64, 0x6d, 0x61, 0x70, 0x69, 0x2e, 0x6e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x07, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x22, 0xd4,
+	0x01, 0x0a, 0x15, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x41, 0x70, 0x69, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x41, 0x70,
+	0x69, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x4b, 0x69, 0x6e, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x1e, 0x0a, 0x0a,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0a, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x4b, 0x0a, 0x08, 0x53, 0x65, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x73, 0x69, 0x65,
+	0x6d, 0x65, 0x6e, 0x73, 0x2e, 0x69, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x64, 0x6d, 0x61, 0x70, 0x69,
+	0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x08, 0x53, 0x65, 0x74,
+	0x74, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x71, 0x0a, 0x0d, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x46, 0x0a, 0x06, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2e, 0x2e, 0x73, 0x69, 0x65, 0x6d, 0x65, 0x6e, 0x73,
 	0x2e, 0x69, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x64, 0x6d, 0x61, 0x70, 0x69, 0x2e, 0x6e, 0x65, 0x74,
-	0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
-	0x65, 0x12, 0x84, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61,
-	0x63, 0x65, 0x57, 0x69, 0x74, 0x68, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x40, 0x2e, 0x73, 0x69,
-	0x65, 0x6d, 0x65, 0x6e, 0x73, 0x2e, 0x69, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x64, 0x6d, 0x61, 0x70,
-	0x69, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x65, 0x74,
-	0x77, 0x6f, 0x72, 0x6b, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x57, 0x69, 0x74, 0x68, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x1a, 0x29, 0x2e,
+	0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74,
+	0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x18,
+	0x0a, 0x07, 0x42, 0x79, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x42, 0x79, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x22, 0x79, 0x0a, 0x13, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x12,
+	0x46, 0x0a, 0x06, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x2e, 0x2e, 0x73, 0x69, 0x65, 0x6d, 0x65, 0x6e, 0x73, 0x2e, 0x69, 0x65, 0x64, 0x67, 0x65, 0x2e,
+	0x64, 0x6d, 0x61, 0x70, 0x69, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31,
+	0x2e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52,
+	0x06, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x44, 0x6f, 0x63, 0x75, 0x6d,
+	0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x44, 0x6f, 0x63, 0x75, 0x6d,
+	0x65, 0x6e, 0x74, 0x22, 0x83, 0x02, 0x0a, 0x0d, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x46, 0x0a, 0x06, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2e, 0x2e, 0x73, 0x69, 0x65, 0x6d, 0x65, 0x6e, 0x73, 0x2e,
+	0x69, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x64, 0x6d, 0x61, 0x70, 0x69, 0x2e, 0x6e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x46,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x1a, 0x0a,
+	0x08, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x4d, 0x61, 0x74,
+	0x63, 0x68, 0x42, 0x79, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0c, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x42, 0x79, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x22, 0x0a,
+	0x0c, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x6e, 0x6c, 0x79, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0c, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x6e, 0x6c,
+	0x79, 0x12, 0x46, 0x0a, 0x07, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x73, 0x69, 0x65, 0x6d, 0x65, 0x6e, 0x73, 0x2e, 0x69, 0x65, 0x64,
+	0x67, 0x65, 0x2e, 0x64, 0x6d, 0x61, 0x70, 0x69, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x07, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x91, 0x01, 0x0a, 0x0c, 0x49, 0x6d,
+	0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x4b, 0x0a, 0x08, 0x53, 0x65,
+	0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x73,
+	0x69, 0x65, 0x6d, 0x65, 0x6e, 0x73, 0x2e, 0x69, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x64, 0x6d, 0x61,
+	0x70, 0x69, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x08, 0x53,
+	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x57, 0x61, 0x72, 0x6e, 0x69,
+	0x6e, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x57, 0x61, 0x72, 0x6e, 0x69,
+	0x6e, 0x67, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x22, 0xfd, 0x02,
+	0x0a, 0x0f, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x46, 0x0a,
+	0x06, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2e, 0x2e,
+	0x73, 0x69, 0x65, 0x6d, 0x65, 0x6e, 0x73, 0x2e, 0x69, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x64, 0x6d,
+	0x61, 0x70, 0x69, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x44,
+	0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x46,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x5c, 0x0a, 0x09, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c,
+	0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x73, 0x69, 0x65, 0x6d, 0x65,
+	0x6e, 0x73, 0x2e, 0x69, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x64, 0x6d, 0x61, 0x70, 0x69, 0x2e, 0x6e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62,
+	0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62,
+	0x6c, 0x65, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x4f,
+	0x6e, 0x6c, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x65, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x46, 0x0a, 0x07, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x73, 0x69, 0x65, 0x6d, 0x65,
+	0x6e, 0x73, 0x2e, 0x69, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x64, 0x6d, 0x61, 0x70, 0x69, 0x2e, 0x6e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x07, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a,
+	0x3c, 0x0a, 0x0e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xbd, 0x01,
+	0x0a, 0x0e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x12, 0x4b, 0x0a, 0x08, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x73, 0x69, 0x65, 0x6d, 0x65, 0x6e, 0x73, 0x2e, 0x69, 0x65, 0x64,
+	0x67, 0x65, 0x2e, 0x64, 0x6d, 0x61, 0x70, 0x69, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x53, 0x65, 0x74, 0x74, 0x69,
+	0x6e, 0x67, 0x73, 0x52, 0x08, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x18, 0x0a,
+	0x07, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x41, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x12, 0x44, 0x0a, 0x08, 0x52, 0x65, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x73, 0x69, 0x65, 0x6d,
+	0x65, 0x6e, 0x73, 0x2e, 0x69, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x64, 0x6d, 0x61, 0x70, 0x69, 0x2e,
+	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x08, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x8d, 0x01,
+	0x0a, 0x13, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x34, 0x0a, 0x15, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15,
+	0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d,
+	0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x22, 0xd1, 0x01,
+	0x0a, 0x12, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x12, 0x4b, 0x0a, 0x08, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x73, 0x69, 0x65, 0x6d, 0x65, 0x6e, 0x73,
+	0x2e, 0x69, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x64, 0x6d, 0x61, 0x70, 0x69, 0x2e, 0x6e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x53,
+	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x08, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
+	0x73, 0x12, 0x28, 0x0a, 0x0f, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x52, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x44, 0x0a, 0x08, 0x52,
+	0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e,
 	0x73, 0x69, 0x65, 0x6d, 0x65, 0x6e, 0x73, 0x2e, 0x69, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x64, 0x6d,
-	0x61, 0x70, 0x69, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x49,
-	0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x58, 0x0a, 0x0d, 0x41, 0x70, 0x70, 0x6c,
-	0x79, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x2f, 0x2e, 0x73, 0x69, 0x65, 0x6d,
+	0x61, 0x70, 0x69, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x22, 0xc4, 0x01, 0x0a, 0x12, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x69,
+	0x6e, 0x67, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x46, 0x69, 0x6c, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x53, 0x68, 0x61, 0x32, 0x35, 0x36, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x53, 0x68,
+	0x61, 0x32, 0x35, 0x36, 0x12, 0x20, 0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65,
+	0x64, 0x41, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x50, 0x72, 0x6f, 0x63, 0x65,
+	0x73, 0x73, 0x65, 0x64, 0x41, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x65,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x52, 0x65, 0x74, 0x72, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x05, 0x52, 0x65, 0x74, 0x72, 0x79, 0x22, 0x99, 0x02, 0x0a, 0x0d, 0x4e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x51, 0x0a, 0x05, 0x52, 0x75,
+	0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x73, 0x69, 0x65, 0x6d,
 	0x65, 0x6e, 0x73, 0x2e, 0x69, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x64, 0x6d, 0x61, 0x70, 0x69, 0x2e,
 	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f,
-	0x72, 0x6b, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x42, 0x1a, 0x5a, 0x18, 0x2e, 0x3b, 0x73, 0x69, 0x65, 0x6d, 0x65, 0x6e, 0x73, 0x5f,
-	0x69, 0x65, 0x64, 0x67, 0x65, 0x5f, 0x64, 0x6d, 0x61, 0x70, 0x69, 0x5f, 0x76, 0x31, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x72, 0x6b, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61,
+	0x63, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x05, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x2c, 0x0a,
+	0x11, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61,
+	0x79, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x4c,
+	0x32, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0c, 0x4c, 0x32, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x1a,
+	0x63, 0x0a, 0x0d, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x52, 0x75, 0x6c, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x44, 0x48, 0x43, 0x50, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x44, 0x48, 0x43, 0x50, 0x12, 0x28, 0x0a, 0x0f, 0x41, 0x6c,
+	0x6c, 0x6f, 0x77, 0x65, 0x64, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0f, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x4e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x73, 0x22, 0x2f, 0x0a, 0x15, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x43,
+	0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x44, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x44,
+	0x72, 0x79, 0x52, 0x75, 0x6e, 0x22, 0xc8, 0x02, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c,
+	0x65, 0x46, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x49, 0x0a, 0x04, 0x4b, 0x69, 0x6e, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x35, 0x2e, 0x73, 0x69, 0x65, 0x6d, 0x65, 0x6e, 0x73,
+	0x2e, 0x69, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x64, 0x6d, 0x61, 0x70, 0x69, 0x2e, 0x6e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x46,
+	0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x2e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x04, 0x4b,
+	0x69, 0x6e, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x55, 0x55, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x55, 0x55, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x4d, 0x61, 0x63, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x4d, 0x61, 0x63,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x66, 0x61, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x44,
+	0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x22, 0x37, 0x0a, 0x06, 0x52, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x12, 0x0d, 0x0a, 0x09, 0x44, 0x55, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x45, 0x10, 0x00,
+	0x12, 0x0a, 0x0a, 0x06, 0x4f, 0x52, 0x50, 0x48, 0x41, 0x4e, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e,
+	0x4c, 0x41, 0x42, 0x45, 0x4c, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x4c, 0x49, 0x43, 0x54, 0x10, 0x02,
+	0x22, 0x73, 0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x12, 0x4a, 0x0a, 0x08, 0x46, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x73, 0x69, 0x65, 0x6d, 0x65, 0x6e, 0x73, 0x2e, 0x69, 0x65,
+	0x64, 0x67, 0x65, 0x2e, 0x64, 0x6d, 0x61, 0x70, 0x69, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x46, 0x69, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x52, 0x08, 0x46, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x16, 0x0a,
+	0x06, 0x44, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x44,
+	0x72, 0x79, 0x52, 0x75, 0x6e, 0x2a, 0x24, 0x0a, 0x0e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e,
+	0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x08, 0x0a, 0x04, 0x4a, 0x53, 0x4f, 0x4e, 0x10,
+	0x00, 0x12, 0x08, 0x0a, 0x04, 0x59, 0x41, 0x4d, 0x4c, 0x10, 0x01, 0x32, 0xfa, 0x12, 0x0a, 0x0e,
+	0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x5b,
+	0x0a, 0x10, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
+	0x65, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x2f, 0x2e, 0x73, 0x69, 0x65,
+	0x6d, 0x65, 0x6e, 0x73, 0x2e, 0x69, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x64, 0x6d, 0x61, 0x70, 0x69,
+	0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x83, 0x01, 0x0a, 0x18,
+	0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73,
+	0x57, 0x69, 0x74, 0x68, 0x4d, 0x61, 0x73, 0x6b, 0x12, 0x36, 0x2e, 0x73, 0x69, 0x65, 0x6d, 0x65,
+	0x6e, 0x73, 0x2e, 0x69, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x64, 0x6d, 0x61, 0x70, 0x69, 0x2e, 0x6e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2f, 0x2e, 0x73, 0x69, 0x65, 0x6d, 0x65, 0x6e, 0x73, 0x2e, 0x69, 0x65, 0x64, 0x67, 0x65,
+	0x2e, 0x64, 0x6d, 0x61, 0x70, 0x69, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76,
+	0x31, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
+	0x73, 0x12, 0x79, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
+	0x65, 0x57, 0x69, 0x74, 0x68, 0x4d, 0x61, 0x63, 0x12, 0x37, 0x2e, 0x73, 0x69, 0x65, 0x6d, 0x65,
+	0x6e, 0x73, 0x2e, 0x69, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x64, 0x6d, 0x61, 0x70, 0x69, 0x2e, 0x6e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x29, 0x2e, 0x73, 0x69, 0x65, 0x6d, 0x65, 0x6e, 0x73, 0x2e, 0x69, 0x65, 0x64, 0x67,
+	0x65, 0x2e, 0x64, 0x6d, 0x61, 0x70, 0x69, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e,
+	0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x84, 0x01, 0x0a,
+	0x15, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x57, 0x69, 0x74,
+	0x68, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x40, 0x2e, 0x73, 0x69, 0x65, 0x6d, 0x65, 0x6e, 0x73,
+	0x2e, 0x69, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x64, 0x6d, 0x61, 0x70, 0x69, 0x2e, 0x6e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49,
+	0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x57,
+	0x69, 0x74, 0x68, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x1a, 0x29, 0x2e, 0x73, 0x69, 0x65, 0x6d, 0x65,
+	0x6e, 0x73, 0x2e, 0x69, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x64, 0x6d, 0x61, 0x70, 0x69, 0x2e, 0x6e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66,
+	0x61, 0x63, 0x65, 0x12, 0x58, 0x0a, 0x0d, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x53, 0x65, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x73, 0x12, 0x2f, 0x2e, 0x73, 0x69, 0x65, 0x6d, 0x65, 0x6e, 0x73, 0x2e, 0x69,
+	0x65, 0x64, 0x67, 0x65, 0x2e, 0x64, 0x6d, 0x61, 0x70, 0x69, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x53, 0x65, 0x74,
+	0x74, 0x69, 0x6e, 0x67, 0x73, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x4f, 0x0a,
+	0x08, 0x47, 0x65, 0x74, 0x44, 0x72, 0x69, 0x66, 0x74, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x1a, 0x2b, 0x2e, 0x73, 0x69, 0x65, 0x6d, 0x65, 0x6e, 0x73, 0x2e, 0x69, 0x65, 0x64, 0x67,
+	0x65, 0x2e, 0x64, 0x6d, 0x61, 0x70, 0x69, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e,
+	0x76, 0x31, 0x2e, 0x44, 0x72, 0x69, 0x66, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x55,
+	0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x2c, 0x2e, 0x73, 0x69, 0x65, 0x6d, 0x65, 0x6e,
+	0x73, 0x2e, 0x69, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x64, 0x6d, 0x61, 0x70, 0x69, 0x2e, 0x6e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x68, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x52, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x2e, 0x73, 0x69, 0x65, 0x6d, 0x65, 0x6e, 0x73, 0x2e, 0x69,
+	0x65, 0x64, 0x67, 0x65, 0x2e, 0x64, 0x6d, 0x61, 0x70, 0x69, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x73, 0x69, 0x65, 0x6d, 0x65, 0x6e, 0x73, 0x2e,
+	0x69, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x64, 0x6d, 0x61, 0x70, 0x69, 0x2e, 0x6e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x73, 0x0a, 0x0d, 0x44, 0x69, 0x66, 0x66, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x34, 0x2e, 0x73, 0x69, 0x65, 0x6d, 0x65, 0x6e, 0x73, 0x2e, 0x69, 0x65, 0x64, 0x67, 0x65,
+	0x2e, 0x64, 0x6d, 0x61, 0x70, 0x69, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76,
+	0x31, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x73, 0x69, 0x65, 0x6d, 0x65, 0x6e, 0x73,
+	0x2e, 0x69, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x64, 0x6d, 0x61, 0x70, 0x69, 0x2e, 0x6e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x44, 0x69, 0x66, 0x66, 0x12, 0x6f, 0x0a, 0x12, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b,
+	0x54, 0x6f, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x2e, 0x73, 0x69, 0x65,
+	0x6d, 0x65, 0x6e, 0x73, 0x2e, 0x69, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x64, 0x6d, 0x61, 0x70, 0x69,
+	0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x73, 0x69,
+	0x65, 0x6d, 0x65, 0x6e, 0x73, 0x2e, 0x69, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x64, 0x6d, 0x61, 0x70,
+	0x69, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x76,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x79, 0x0a, 0x13, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x2e, 0x73,
+	0x69, 0x65, 0x6d, 0x65, 0x6e, 0x73, 0x2e, 0x69, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x64, 0x6d, 0x61,
+	0x70, 0x69, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x73, 0x69,
+	0x65, 0x6d, 0x65, 0x6e, 0x73, 0x2e, 0x69, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x64, 0x6d, 0x61, 0x70,
+	0x69, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x12, 0x72, 0x0a, 0x13, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x2e, 0x73, 0x69, 0x65, 0x6d, 0x65, 0x6e,
+	0x73, 0x2e, 0x69, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x64, 0x6d, 0x61, 0x70, 0x69, 0x2e, 0x6e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x73, 0x69, 0x65, 0x6d, 0x65, 0x6e, 0x73,
+	0x2e, 0x69, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x64, 0x6d, 0x61, 0x70, 0x69, 0x2e, 0x6e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x12, 0x81, 0x01, 0x0a, 0x16, 0x52, 0x65, 0x73, 0x65, 0x74, 0x54, 0x6f,
+	0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x12,
+	0x33, 0x2e, 0x73, 0x69, 0x65, 0x6d, 0x65, 0x6e, 0x73, 0x2e, 0x69, 0x65, 0x64, 0x67, 0x65, 0x2e,
+	0x64, 0x6d, 0x61, 0x70, 0x69, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31,
+	0x2e, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x73, 0x69, 0x65, 0x6d, 0x65, 0x6e, 0x73, 0x2e, 0x69,
+	0x65, 0x64, 0x67, 0x65, 0x2e, 0x64, 0x6d, 0x61, 0x70, 0x69, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73,
+	0x65, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x70, 0x0a, 0x0d, 0x41, 0x70, 0x70, 0x6c,
+	0x79, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x2f, 0x2e, 0x73, 0x69, 0x65, 0x6d,
+	0x65, 0x6e, 0x73, 0x2e, 0x69, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x64, 0x6d, 0x61, 0x70, 0x69, 0x2e,
+	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x73, 0x69, 0x65,
+	0x6d, 0x65, 0x6e, 0x73, 0x2e, 0x69, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x64, 0x6d, 0x61, 0x70, 0x69,
+	0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x52, 0x0a, 0x09, 0x47, 0x65,
+	0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
+	0x2d, 0x2e, 0x73, 0x69, 0x65, 0x6d, 0x65, 0x6e, 0x73, 0x2e, 0x69, 0x65, 0x64, 0x67, 0x65, 0x2e,
+	0x64, 0x6d, 0x61, 0x70, 0x69, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31,
+	0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x53,
+	0x0a, 0x0f, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
+	0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x28, 0x2e, 0x73, 0x69, 0x65, 0x6d,
+	0x65, 0x6e, 0x73, 0x2e, 0x69, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x64, 0x6d, 0x61, 0x70, 0x69, 0x2e,
+	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x6d, 0x0a, 0x0e, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x44, 0x68, 0x63, 0x70,
+	0x4c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x30, 0x2e, 0x73, 0x69, 0x65, 0x6d, 0x65, 0x6e, 0x73, 0x2e,
+	0x69, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x64, 0x6d, 0x61, 0x70, 0x69, 0x2e, 0x6e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x48, 0x43, 0x50, 0x4c, 0x65, 0x61, 0x73, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x73, 0x69, 0x65, 0x6d, 0x65, 0x6e,
+	0x73, 0x2e, 0x69, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x64, 0x6d, 0x61, 0x70, 0x69, 0x2e, 0x6e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x48, 0x43, 0x50, 0x4c, 0x65, 0x61,
+	0x73, 0x65, 0x12, 0x5c, 0x0a, 0x10, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x68, 0x63,
+	0x70, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x30, 0x2e, 0x73, 0x69, 0x65, 0x6d, 0x65, 0x6e, 0x73,
+	0x2e, 0x69, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x64, 0x6d, 0x61, 0x70, 0x69, 0x2e, 0x6e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x48, 0x43, 0x50, 0x4c, 0x65, 0x61, 0x73,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x12, 0x55, 0x0a, 0x0e, 0x53, 0x65, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69,
+	0x65, 0x73, 0x12, 0x2b, 0x2e, 0x73, 0x69, 0x65, 0x6d, 0x65, 0x6e, 0x73, 0x2e, 0x69, 0x65, 0x64,
+	0x67, 0x65, 0x2e, 0x64, 0x6d, 0x61, 0x70, 0x69, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x1a,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x51, 0x0a, 0x0c, 0x53, 0x65, 0x74, 0x47, 0x6c,
+	0x6f, 0x62, 0x61, 0x6c, 0x44, 0x6e, 0x73, 0x12, 0x29, 0x2e, 0x73, 0x69, 0x65, 0x6d, 0x65, 0x6e,
+	0x73, 0x2e, 0x69, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x64, 0x6d, 0x61, 0x70, 0x69, 0x2e, 0x6e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x44,
+	0x6e, 0x73, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x5b, 0x0a, 0x11, 0x47, 0x65,
+	0x74, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x2e, 0x2e, 0x73, 0x69, 0x65, 0x6d, 0x65, 0x6e,
+	0x73, 0x2e, 0x69, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x64, 0x6d, 0x61, 0x70, 0x69, 0x2e, 0x6e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65,
+	0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x56, 0x0a, 0x0d, 0x41, 0x75, 0x64, 0x69, 0x74,
+	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x1a, 0x2d, 0x2e, 0x73, 0x69, 0x65, 0x6d, 0x65, 0x6e, 0x73, 0x2e, 0x69, 0x65, 0x64, 0x67, 0x65,
+	0x2e, 0x64, 0x6d, 0x61, 0x70, 0x69, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12,
+	0x77, 0x0a, 0x0f, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c,
+	0x65, 0x73, 0x12, 0x35, 0x2e, 0x73, 0x69, 0x65, 0x6d, 0x65, 0x6e, 0x73, 0x2e, 0x69, 0x65, 0x64,
+	0x67, 0x65, 0x2e, 0x64, 0x6d, 0x61, 0x70, 0x69, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x43, 0x6c, 0x65, 0x61, 0x6e,
+	0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x73, 0x69, 0x65, 0x6d,
+	0x65, 0x6e, 0x73, 0x2e, 0x69, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x64, 0x6d, 0x61, 0x70, 0x69, 0x2e,
+	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x1a, 0x5a, 0x18, 0x2e, 0x3b, 0x73, 0x69,
+	0x65, 0x6d, 0x65, 0x6e, 0x73, 0x5f, 0x69, 0x65, 0x64, 0x67, 0x65, 0x5f, 0x64, 0x6d, 0x61, 0x70,
+	0x69, 0x5f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 })
 
 var (
@@ -597,39 +4746,183 @@ func file_Network_proto_rawDescGZIP() []byte {
 	return file_Network_proto_rawDescData
 }
 
-var file_Network_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_Network_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_Network_proto_msgTypes = make([]protoimpl.MessageInfo, 54)
 var file_Network_proto_goTypes = []any{
-	(*NetworkInterfaceRequest)(nil),          // 0: siemens.iedge.dmapi.network.v1.NetworkInterfaceRequest
-	(*NetworkInterfaceRequestWithLabel)(nil), // 1: siemens.iedge.dmapi.network.v1.NetworkInterfaceRequestWithLabel
-	(*Interface)(nil),                        // 2: siemens.iedge.dmapi.network.v1.Interface
-	(*NetworkSettings)(nil),                  // 3: siemens.iedge.dmapi.network.v1.NetworkSettings
-	(*Interface_StaticConf)(nil),             // 4: siemens.iedge.dmapi.network.v1.Interface.StaticConf
-	(*Interface_Dns)(nil),                    // 5: siemens.iedge.dmapi.network.v1.Interface.Dns
-	(*Interface_L2)(nil),                     // 6: siemens.iedge.dmapi.network.v1.Interface.L2
-	nil,                                      // 7: siemens.iedge.dmapi.network.v1.Interface.L2.AuxiliaryAddressesEntry
-	nil,                                      // 8: siemens.iedge.dmapi.network.v1.NetworkSettings.LabelMapEntry
-	(*emptypb.Empty)(nil),                    // 9: google.protobuf.Empty
+	(DocumentFormat)(0),                      // 0: siemens.iedge.dmapi.network.v1.DocumentFormat
+	(Interface_ReconcileMode)(0),             // 1: siemens.iedge.dmapi.network.v1.Interface.ReconcileMode
+	(Interface_Dns_AutoMode)(0),              // 2: siemens.iedge.dmapi.network.v1.Interface.Dns.AutoMode
+	(ApplyOptions_ForeignProfilePolicy)(0),   // 3: siemens.iedge.dmapi.network.v1.ApplyOptions.ForeignProfilePolicy
+	(ConnectivityCheck_CheckType)(0),         // 4: siemens.iedge.dmapi.network.v1.ConnectivityCheck.CheckType
+	(ProfileFinding_Reason)(0),               // 5: siemens.iedge.dmapi.network.v1.ProfileFinding.Reason
+	(*NetworkInterfaceRequest)(nil),          // 6: siemens.iedge.dmapi.network.v1.NetworkInterfaceRequest
+	(*NetworkInterfaceRequestWithLabel)(nil), // 7: siemens.iedge.dmapi.network.v1.NetworkInterfaceRequestWithLabel
+	(*NetworkSettingsRequest)(nil),           // 8: siemens.iedge.dmapi.network.v1.NetworkSettingsRequest
+	(*Interface)(nil),                        // 9: siemens.iedge.dmapi.network.v1.Interface
+	(*Bond)(nil),                             // 10: siemens.iedge.dmapi.network.v1.Bond
+	(*BondList)(nil),                         // 11: siemens.iedge.dmapi.network.v1.BondList
+	(*DHCPLease)(nil),                        // 12: siemens.iedge.dmapi.network.v1.DHCPLease
+	(*DHCPLeaseRequest)(nil),                 // 13: siemens.iedge.dmapi.network.v1.DHCPLeaseRequest
+	(*HostEntry)(nil),                        // 14: siemens.iedge.dmapi.network.v1.HostEntry
+	(*HostEntries)(nil),                      // 15: siemens.iedge.dmapi.network.v1.HostEntries
+	(*GlobalDns)(nil),                        // 16: siemens.iedge.dmapi.network.v1.GlobalDns
+	(*ResolverStatus)(nil),                   // 17: siemens.iedge.dmapi.network.v1.ResolverStatus
+	(*ApplyOptions)(nil),                     // 18: siemens.iedge.dmapi.network.v1.ApplyOptions
+	(*ConnectivityCheck)(nil),                // 19: siemens.iedge.dmapi.network.v1.ConnectivityCheck
+	(*NetworkSettings)(nil),                  // 20: siemens.iedge.dmapi.network.v1.NetworkSettings
+	(*InterfaceDrift)(nil),                   // 21: siemens.iedge.dmapi.network.v1.InterfaceDrift
+	(*DriftReport)(nil),                      // 22: siemens.iedge.dmapi.network.v1.DriftReport
+	(*Revision)(nil),                         // 23: siemens.iedge.dmapi.network.v1.Revision
+	(*RevisionList)(nil),                     // 24: siemens.iedge.dmapi.network.v1.RevisionList
+	(*RevisionRequest)(nil),                  // 25: siemens.iedge.dmapi.network.v1.RevisionRequest
+	(*DiffRevisionsRequest)(nil),             // 26: siemens.iedge.dmapi.network.v1.DiffRevisionsRequest
+	(*RevisionChange)(nil),                   // 27: siemens.iedge.dmapi.network.v1.RevisionChange
+	(*RevisionDiff)(nil),                     // 28: siemens.iedge.dmapi.network.v1.RevisionDiff
+	(*ConfigurationDocument)(nil),            // 29: siemens.iedge.dmapi.network.v1.ConfigurationDocument
+	(*ExportRequest)(nil),                    // 30: siemens.iedge.dmapi.network.v1.ExportRequest
+	(*ConfigurationExport)(nil),              // 31: siemens.iedge.dmapi.network.v1.ConfigurationExport
+	(*ImportRequest)(nil),                    // 32: siemens.iedge.dmapi.network.v1.ImportRequest
+	(*ImportResult)(nil),                     // 33: siemens.iedge.dmapi.network.v1.ImportResult
+	(*TemplateRequest)(nil),                  // 34: siemens.iedge.dmapi.network.v1.TemplateRequest
+	(*TemplateResult)(nil),                   // 35: siemens.iedge.dmapi.network.v1.TemplateResult
+	(*FactoryResetRequest)(nil),              // 36: siemens.iedge.dmapi.network.v1.FactoryResetRequest
+	(*FactoryResetResult)(nil),               // 37: siemens.iedge.dmapi.network.v1.FactoryResetResult
+	(*ProvisioningReport)(nil),               // 38: siemens.iedge.dmapi.network.v1.ProvisioningReport
+	(*NetworkPolicy)(nil),                    // 39: siemens.iedge.dmapi.network.v1.NetworkPolicy
+	(*ProfileCleanupRequest)(nil),            // 40: siemens.iedge.dmapi.network.v1.ProfileCleanupRequest
+	(*ProfileFinding)(nil),                   // 41: siemens.iedge.dmapi.network.v1.ProfileFinding
+	(*ProfileReport)(nil),                    // 42: siemens.iedge.dmapi.network.v1.ProfileReport
+	(*Interface_StaticConf)(nil),             // 43: siemens.iedge.dmapi.network.v1.Interface.StaticConf
+	(*Interface_Dns)(nil),                    // 44: siemens.iedge.dmapi.network.v1.Interface.Dns
+	(*Interface_L2)(nil),                     // 45: siemens.iedge.dmapi.network.v1.Interface.L2
+	(*Interface_Route)(nil),                  // 46: siemens.iedge.dmapi.network.v1.Interface.Route
+	(*Interface_FallbackConf)(nil),           // 47: siemens.iedge.dmapi.network.v1.Interface.FallbackConf
+	(*Interface_DHCPConf)(nil),               // 48: siemens.iedge.dmapi.network.v1.Interface.DHCPConf
+	(*Interface_LinkConf)(nil),               // 49: siemens.iedge.dmapi.network.v1.Interface.LinkConf
+	(*Interface_LinkState)(nil),              // 50: siemens.iedge.dmapi.network.v1.Interface.LinkState
+	(*Interface_VlanConf)(nil),               // 51: siemens.iedge.dmapi.network.v1.Interface.VlanConf
+	(*Interface_VlanList)(nil),               // 52: siemens.iedge.dmapi.network.v1.Interface.VlanList
+	nil,                                      // 53: siemens.iedge.dmapi.network.v1.Interface.L2.AuxiliaryAddressesEntry
+	(*Bond_BondState)(nil),                   // 54: siemens.iedge.dmapi.network.v1.Bond.BondState
+	nil,                                      // 55: siemens.iedge.dmapi.network.v1.DHCPLease.OptionsEntry
+	(*ResolverStatus_ResolverSource)(nil),    // 56: siemens.iedge.dmapi.network.v1.ResolverStatus.ResolverSource
+	nil,                                      // 57: siemens.iedge.dmapi.network.v1.NetworkSettings.LabelMapEntry
+	nil,                                      // 58: siemens.iedge.dmapi.network.v1.TemplateRequest.VariablesEntry
+	(*NetworkPolicy_InterfaceRule)(nil),      // 59: siemens.iedge.dmapi.network.v1.NetworkPolicy.InterfaceRule
+	(*fieldmaskpb.FieldMask)(nil),            // 60: google.protobuf.FieldMask
+	(*emptypb.Empty)(nil),                    // 61: google.protobuf.Empty
 }
 var file_Network_proto_depIdxs = []int32{
-	4,  // 0: siemens.iedge.dmapi.network.v1.Interface.Static:type_name -> siemens.iedge.dmapi.network.v1.Interface.StaticConf
-	5,  // 1: siemens.iedge.dmapi.network.v1.Interface.DNSConfig:type_name -> siemens.iedge.dmapi.network.v1.Interface.Dns
-	6,  // 2: siemens.iedge.dmapi.network.v1.Interface.L2Conf:type_name -> siemens.iedge.dmapi.network.v1.Interface.L2
-	2,  // 3: siemens.iedge.dmapi.network.v1.NetworkSettings.Interfaces:type_name -> siemens.iedge.dmapi.network.v1.Interface
-	8,  // 4: siemens.iedge.dmapi.network.v1.NetworkSettings.LabelMap:type_name -> siemens.iedge.dmapi.network.v1.NetworkSettings.LabelMapEntry
-	7,  // 5: siemens.iedge.dmapi.network.v1.Interface.L2.AuxiliaryAddresses:type_name -> siemens.iedge.dmapi.network.v1.Interface.L2.AuxiliaryAddressesEntry
-	9,  // 6: siemens.iedge.dmapi.network.v1.NetworkService.GetAllInterfaces:input_type -> google.protobuf.Empty
-	0,  // 7: siemens.iedge.dmapi.network.v1.NetworkService.GetInterfaceWithMac:input_type -> siemens.iedge.dmapi.network.v1.NetworkInterfaceRequest
-	1,  // 8: siemens.iedge.dmapi.network.v1.NetworkService.GetInterfaceWithLabel:input_type -> siemens.iedge.dmapi.network.v1.NetworkInterfaceRequestWithLabel
-	3,  // 9: siemens.iedge.dmapi.network.v1.NetworkService.ApplySettings:input_type -> siemens.iedge.dmapi.network.v1.NetworkSettings
-	3,  // 10: siemens.iedge.dmapi.network.v1.NetworkService.GetAllInterfaces:output_type -> siemens.iedge.dmapi.network.v1.NetworkSettings
-	2,  // 11: siemens.iedge.dmapi.network.v1.NetworkService.GetInterfaceWithMac:output_type -> siemens.iedge.dmapi.network.v1.Interface
-	2,  // 12: siemens.iedge.dmapi.network.v1.NetworkService.GetInterfaceWithLabel:output_type -> siemens.iedge.dmapi.network.v1.Interface
-	9,  // 13: siemens.iedge.dmapi.network.v1.NetworkService.ApplySettings:output_type -> google.protobuf.Empty
-	10, // [10:14] is the sub-list for method output_type
-	6,  // [6:10] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+	60, // 0: siemens.iedge.dmapi.network.v1.NetworkInterfaceRequest.field_mask:type_name -> google.protobuf.FieldMask
+	60, // 1: siemens.iedge.dmapi.network.v1.NetworkInterfaceRequestWithLabel.field_mask:type_name -> google.protobuf.FieldMask
+	60, // 2: siemens.iedge.dmapi.network.v1.NetworkSettingsRequest.field_mask:type_name -> google.protobuf.FieldMask
+	43, // 3: siemens.iedge.dmapi.network.v1.Interface.Static:type_name -> siemens.iedge.dmapi.network.v1.Interface.StaticConf
+	44, // 4: siemens.iedge.dmapi.network.v1.Interface.DNSConfig:type_name -> siemens.iedge.dmapi.network.v1.Interface.Dns
+	45, // 5: siemens.iedge.dmapi.network.v1.Interface.L2Conf:type_name -> siemens.iedge.dmapi.network.v1.Interface.L2
+	1,  // 6: siemens.iedge.dmapi.network.v1.Interface.Reconcile:type_name -> siemens.iedge.dmapi.network.v1.Interface.ReconcileMode
+	46, // 7: siemens.iedge.dmapi.network.v1.Interface.Routes:type_name -> siemens.iedge.dmapi.network.v1.Interface.Route
+	47, // 8: siemens.iedge.dmapi.network.v1.Interface.Fallback:type_name -> siemens.iedge.dmapi.network.v1.Interface.FallbackConf
+	48, // 9: siemens.iedge.dmapi.network.v1.Interface.DHCPOptions:type_name -> siemens.iedge.dmapi.network.v1.Interface.DHCPConf
+	12, // 10: siemens.iedge.dmapi.network.v1.Interface.Lease:type_name -> siemens.iedge.dmapi.network.v1.DHCPLease
+	49, // 11: siemens.iedge.dmapi.network.v1.Interface.Link:type_name -> siemens.iedge.dmapi.network.v1.Interface.LinkConf
+	50, // 12: siemens.iedge.dmapi.network.v1.Interface.LinkStatus:type_name -> siemens.iedge.dmapi.network.v1.Interface.LinkState
+	52, // 13: siemens.iedge.dmapi.network.v1.Interface.Vlans:type_name -> siemens.iedge.dmapi.network.v1.Interface.VlanList
+	43, // 14: siemens.iedge.dmapi.network.v1.Bond.Static:type_name -> siemens.iedge.dmapi.network.v1.Interface.StaticConf
+	44, // 15: siemens.iedge.dmapi.network.v1.Bond.DNSConfig:type_name -> siemens.iedge.dmapi.network.v1.Interface.Dns
+	54, // 16: siemens.iedge.dmapi.network.v1.Bond.Status:type_name -> siemens.iedge.dmapi.network.v1.Bond.BondState
+	10, // 17: siemens.iedge.dmapi.network.v1.BondList.Interfaces:type_name -> siemens.iedge.dmapi.network.v1.Bond
+	55, // 18: siemens.iedge.dmapi.network.v1.DHCPLease.Options:type_name -> siemens.iedge.dmapi.network.v1.DHCPLease.OptionsEntry
+	14, // 19: siemens.iedge.dmapi.network.v1.HostEntries.Entries:type_name -> siemens.iedge.dmapi.network.v1.HostEntry
+	16, // 20: siemens.iedge.dmapi.network.v1.ResolverStatus.Global:type_name -> siemens.iedge.dmapi.network.v1.GlobalDns
+	56, // 21: siemens.iedge.dmapi.network.v1.ResolverStatus.Sources:type_name -> siemens.iedge.dmapi.network.v1.ResolverStatus.ResolverSource
+	14, // 22: siemens.iedge.dmapi.network.v1.ResolverStatus.HostEntries:type_name -> siemens.iedge.dmapi.network.v1.HostEntry
+	3,  // 23: siemens.iedge.dmapi.network.v1.ApplyOptions.ForeignProfiles:type_name -> siemens.iedge.dmapi.network.v1.ApplyOptions.ForeignProfilePolicy
+	19, // 24: siemens.iedge.dmapi.network.v1.ApplyOptions.Checks:type_name -> siemens.iedge.dmapi.network.v1.ConnectivityCheck
+	4,  // 25: siemens.iedge.dmapi.network.v1.ConnectivityCheck.Type:type_name -> siemens.iedge.dmapi.network.v1.ConnectivityCheck.CheckType
+	9,  // 26: siemens.iedge.dmapi.network.v1.NetworkSettings.Interfaces:type_name -> siemens.iedge.dmapi.network.v1.Interface
+	57, // 27: siemens.iedge.dmapi.network.v1.NetworkSettings.LabelMap:type_name -> siemens.iedge.dmapi.network.v1.NetworkSettings.LabelMapEntry
+	18, // 28: siemens.iedge.dmapi.network.v1.NetworkSettings.Options:type_name -> siemens.iedge.dmapi.network.v1.ApplyOptions
+	11, // 29: siemens.iedge.dmapi.network.v1.NetworkSettings.Bonds:type_name -> siemens.iedge.dmapi.network.v1.BondList
+	1,  // 30: siemens.iedge.dmapi.network.v1.InterfaceDrift.Mode:type_name -> siemens.iedge.dmapi.network.v1.Interface.ReconcileMode
+	9,  // 31: siemens.iedge.dmapi.network.v1.InterfaceDrift.Desired:type_name -> siemens.iedge.dmapi.network.v1.Interface
+	9,  // 32: siemens.iedge.dmapi.network.v1.InterfaceDrift.Actual:type_name -> siemens.iedge.dmapi.network.v1.Interface
+	21, // 33: siemens.iedge.dmapi.network.v1.DriftReport.Interfaces:type_name -> siemens.iedge.dmapi.network.v1.InterfaceDrift
+	20, // 34: siemens.iedge.dmapi.network.v1.Revision.Settings:type_name -> siemens.iedge.dmapi.network.v1.NetworkSettings
+	23, // 35: siemens.iedge.dmapi.network.v1.RevisionList.Revisions:type_name -> siemens.iedge.dmapi.network.v1.Revision
+	27, // 36: siemens.iedge.dmapi.network.v1.RevisionDiff.Changes:type_name -> siemens.iedge.dmapi.network.v1.RevisionChange
+	20, // 37: siemens.iedge.dmapi.network.v1.ConfigurationDocument.Settings:type_name -> siemens.iedge.dmapi.network.v1.NetworkSettings
+	0,  // 38: siemens.iedge.dmapi.network.v1.ExportRequest.Format:type_name -> siemens.iedge.dmapi.network.v1.DocumentFormat
+	0,  // 39: siemens.iedge.dmapi.network.v1.ConfigurationExport.Format:type_name -> siemens.iedge.dmapi.network.v1.DocumentFormat
+	0,  // 40: siemens.iedge.dmapi.network.v1.ImportRequest.Format:type_name -> siemens.iedge.dmapi.network.v1.DocumentFormat
+	18, // 41: siemens.iedge.dmapi.network.v1.ImportRequest.Options:type_name -> siemens.iedge.dmapi.network.v1.ApplyOptions
+	20, // 42: siemens.iedge.dmapi.network.v1.ImportResult.Settings:type_name -> siemens.iedge.dmapi.network.v1.NetworkSettings
+	0,  // 43: siemens.iedge.dmapi.network.v1.TemplateRequest.Format:type_name -> siemens.iedge.dmapi.network.v1.DocumentFormat
+	58, // 44: siemens.iedge.dmapi.network.v1.TemplateRequest.Variables:type_name -> siemens.iedge.dmapi.network.v1.TemplateRequest.VariablesEntry
+	18, // 45: siemens.iedge.dmapi.network.v1.TemplateRequest.Options:type_name -> siemens.iedge.dmapi.network.v1.ApplyOptions
+	20, // 46: siemens.iedge.dmapi.network.v1.TemplateResult.Settings:type_name -> siemens.iedge.dmapi.network.v1.NetworkSettings
+	23, // 47: siemens.iedge.dmapi.network.v1.TemplateResult.Revision:type_name -> siemens.iedge.dmapi.network.v1.Revision
+	20, // 48: siemens.iedge.dmapi.network.v1.FactoryResetResult.Settings:type_name -> siemens.iedge.dmapi.network.v1.NetworkSettings
+	23, // 49: siemens.iedge.dmapi.network.v1.FactoryResetResult.Revision:type_name -> siemens.iedge.dmapi.network.v1.Revision
+	59, // 50: siemens.iedge.dmapi.network.v1.NetworkPolicy.Rules:type_name -> siemens.iedge.dmapi.network.v1.NetworkPolicy.InterfaceRule
+	5,  // 51: siemens.iedge.dmapi.network.v1.ProfileFinding.Kind:type_name -> siemens.iedge.dmapi.network.v1.ProfileFinding.Reason
+	41, // 52: siemens.iedge.dmapi.network.v1.ProfileReport.Findings:type_name -> siemens.iedge.dmapi.network.v1.ProfileFinding
+	2,  // 53: siemens.iedge.dmapi.network.v1.Interface.Dns.Auto:type_name -> siemens.iedge.dmapi.network.v1.Interface.Dns.AutoMode
+	53, // 54: siemens.iedge.dmapi.network.v1.Interface.L2.AuxiliaryAddresses:type_name -> siemens.iedge.dmapi.network.v1.Interface.L2.AuxiliaryAddressesEntry
+	43, // 55: siemens.iedge.dmapi.network.v1.Interface.FallbackConf.Static:type_name -> siemens.iedge.dmapi.network.v1.Interface.StaticConf
+	43, // 56: siemens.iedge.dmapi.network.v1.Interface.VlanConf.Static:type_name -> siemens.iedge.dmapi.network.v1.Interface.StaticConf
+	44, // 57: siemens.iedge.dmapi.network.v1.Interface.VlanConf.DNSConfig:type_name -> siemens.iedge.dmapi.network.v1.Interface.Dns
+	51, // 58: siemens.iedge.dmapi.network.v1.Interface.VlanList.Interfaces:type_name -> siemens.iedge.dmapi.network.v1.Interface.VlanConf
+	61, // 59: siemens.iedge.dmapi.network.v1.NetworkService.GetAllInterfaces:input_type -> google.protobuf.Empty
+	8,  // 60: siemens.iedge.dmapi.network.v1.NetworkService.GetAllInterfacesWithMask:input_type -> siemens.iedge.dmapi.network.v1.NetworkSettingsRequest
+	6,  // 61: siemens.iedge.dmapi.network.v1.NetworkService.GetInterfaceWithMac:input_type -> siemens.iedge.dmapi.network.v1.NetworkInterfaceRequest
+	7,  // 62: siemens.iedge.dmapi.network.v1.NetworkService.GetInterfaceWithLabel:input_type -> siemens.iedge.dmapi.network.v1.NetworkInterfaceRequestWithLabel
+	20, // 63: siemens.iedge.dmapi.network.v1.NetworkService.ApplySettings:input_type -> siemens.iedge.dmapi.network.v1.NetworkSettings
+	61, // 64: siemens.iedge.dmapi.network.v1.NetworkService.GetDrift:input_type -> google.protobuf.Empty
+	61, // 65: siemens.iedge.dmapi.network.v1.NetworkService.ListRevisions:input_type -> google.protobuf.Empty
+	25, // 66: siemens.iedge.dmapi.network.v1.NetworkService.GetRevision:input_type -> siemens.iedge.dmapi.network.v1.RevisionRequest
+	26, // 67: siemens.iedge.dmapi.network.v1.NetworkService.DiffRevisions:input_type -> siemens.iedge.dmapi.network.v1.DiffRevisionsRequest
+	25, // 68: siemens.iedge.dmapi.network.v1.NetworkService.RollbackToRevision:input_type -> siemens.iedge.dmapi.network.v1.RevisionRequest
+	30, // 69: siemens.iedge.dmapi.network.v1.NetworkService.ExportConfiguration:input_type -> siemens.iedge.dmapi.network.v1.ExportRequest
+	32, // 70: siemens.iedge.dmapi.network.v1.NetworkService.ImportConfiguration:input_type -> siemens.iedge.dmapi.network.v1.ImportRequest
+	36, // 71: siemens.iedge.dmapi.network.v1.NetworkService.ResetToFactoryDefaults:input_type -> siemens.iedge.dmapi.network.v1.FactoryResetRequest
+	34, // 72: siemens.iedge.dmapi.network.v1.NetworkService.ApplyTemplate:input_type -> siemens.iedge.dmapi.network.v1.TemplateRequest
+	61, // 73: siemens.iedge.dmapi.network.v1.NetworkService.GetPolicy:input_type -> google.protobuf.Empty
+	61, // 74: siemens.iedge.dmapi.network.v1.NetworkService.ConfirmSettings:input_type -> google.protobuf.Empty
+	13, // 75: siemens.iedge.dmapi.network.v1.NetworkService.RenewDhcpLease:input_type -> siemens.iedge.dmapi.network.v1.DHCPLeaseRequest
+	13, // 76: siemens.iedge.dmapi.network.v1.NetworkService.ReleaseDhcpLease:input_type -> siemens.iedge.dmapi.network.v1.DHCPLeaseRequest
+	15, // 77: siemens.iedge.dmapi.network.v1.NetworkService.SetHostEntries:input_type -> siemens.iedge.dmapi.network.v1.HostEntries
+	16, // 78: siemens.iedge.dmapi.network.v1.NetworkService.SetGlobalDns:input_type -> siemens.iedge.dmapi.network.v1.GlobalDns
+	61, // 79: siemens.iedge.dmapi.network.v1.NetworkService.GetResolverStatus:input_type -> google.protobuf.Empty
+	61, // 80: siemens.iedge.dmapi.network.v1.NetworkService.AuditProfiles:input_type -> google.protobuf.Empty
+	40, // 81: siemens.iedge.dmapi.network.v1.NetworkService.CleanupProfiles:input_type -> siemens.iedge.dmapi.network.v1.ProfileCleanupRequest
+	20, // 82: siemens.iedge.dmapi.network.v1.NetworkService.GetAllInterfaces:output_type -> siemens.iedge.dmapi.network.v1.NetworkSettings
+	20, // 83: siemens.iedge.dmapi.network.v1.NetworkService.GetAllInterfacesWithMask:output_type -> siemens.iedge.dmapi.network.v1.NetworkSettings
+	9,  // 84: siemens.iedge.dmapi.network.v1.NetworkService.GetInterfaceWithMac:output_type -> siemens.iedge.dmapi.network.v1.Interface
+	9,  // 85: siemens.iedge.dmapi.network.v1.NetworkService.GetInterfaceWithLabel:output_type -> siemens.iedge.dmapi.network.v1.Interface
+	61, // 86: siemens.iedge.dmapi.network.v1.NetworkService.ApplySettings:output_type -> google.protobuf.Empty
+	22, // 87: siemens.iedge.dmapi.network.v1.NetworkService.GetDrift:output_type -> siemens.iedge.dmapi.network.v1.DriftReport
+	24, // 88: siemens.iedge.dmapi.network.v1.NetworkService.ListRevisions:output_type -> siemens.iedge.dmapi.network.v1.RevisionList
+	23, // 89: siemens.iedge.dmapi.network.v1.NetworkService.GetRevision:output_type -> siemens.iedge.dmapi.network.v1.Revision
+	28, // 90: siemens.iedge.dmapi.network.v1.NetworkService.DiffRevisions:output_type -> siemens.iedge.dmapi.network.v1.RevisionDiff
+	23, // 91: siemens.iedge.dmapi.network.v1.NetworkService.RollbackToRevision:output_type -> siemens.iedge.dmapi.network.v1.Revision
+	31, // 92: siemens.iedge.dmapi.network.v1.NetworkService.ExportConfiguration:output_type -> siemens.iedge.dmapi.network.v1.ConfigurationExport
+	33, // 93: siemens.iedge.dmapi.network.v1.NetworkService.ImportConfiguration:output_type -> siemens.iedge.dmapi.network.v1.ImportResult
+	37, // 94: siemens.iedge.dmapi.network.v1.NetworkService.ResetToFactoryDefaults:output_type -> siemens.iedge.dmapi.network.v1.FactoryResetResult
+	35, // 95: siemens.iedge.dmapi.network.v1.NetworkService.ApplyTemplate:output_type -> siemens.iedge.dmapi.network.v1.TemplateResult
+	39, // 96: siemens.iedge.dmapi.network.v1.NetworkService.GetPolicy:output_type -> siemens.iedge.dmapi.network.v1.NetworkPolicy
+	23, // 97: siemens.iedge.dmapi.network.v1.NetworkService.ConfirmSettings:output_type -> siemens.iedge.dmapi.network.v1.Revision
+	12, // 98: siemens.iedge.dmapi.network.v1.NetworkService.RenewDhcpLease:output_type -> siemens.iedge.dmapi.network.v1.DHCPLease
+	61, // 99: siemens.iedge.dmapi.network.v1.NetworkService.ReleaseDhcpLease:output_type -> google.protobuf.Empty
+	61, // 100: siemens.iedge.dmapi.network.v1.NetworkService.SetHostEntries:output_type -> google.protobuf.Empty
+	61, // 101: siemens.iedge.dmapi.network.v1.NetworkService.SetGlobalDns:output_type -> google.protobuf.Empty
+	17, // 102: siemens.iedge.dmapi.network.v1.NetworkService.GetResolverStatus:output_type -> siemens.iedge.dmapi.network.v1.ResolverStatus
+	42, // 103: siemens.iedge.dmapi.network.v1.NetworkService.AuditProfiles:output_type -> siemens.iedge.dmapi.network.v1.ProfileReport
+	42, // 104: siemens.iedge.dmapi.network.v1.NetworkService.CleanupProfiles:output_type -> siemens.iedge.dmapi.network.v1.ProfileReport
+	82, // [82:105] is the sub-list for method output_type
+	59, // [59:82] is the sub-list for method input_type
+	59, // [59:59] is the sub-list for extension type_name
+	59, // [59:59] is the sub-list for extension extendee
+	0,  // [0:59] is the sub-list for field type_name
 }
 
 func init() { file_Network_proto_init() }
@@ -642,13 +4935,14 @@ func file_Network_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_Network_proto_rawDesc), len(file_Network_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   9,
+			NumEnums:      6,
+			NumMessages:   54,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_Network_proto_goTypes,
 		DependencyIndexes: file_Network_proto_depIdxs,
+		EnumInfos:         file_Network_proto_enumTypes,
 		MessageInfos:      file_Network_proto_msgTypes,
 	}.Build()
 	File_Network_proto = out.File
@@ -31,10 +31,29 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	NetworkService_GetAllInterfaces_FullMethodName      = "/siemens.iedge.dmapi.network.v1.NetworkService/GetAllInterfaces"
-	NetworkService_GetInterfaceWithMac_FullMethodName   = "/siemens.iedge.dmapi.network.v1.NetworkService/GetInterfaceWithMac"
-	NetworkService_GetInterfaceWithLabel_FullMethodName = "/siemens.iedge.dmapi.network.v1.NetworkService/GetInterfaceWithLabel"
-	NetworkService_ApplySettings_FullMethodName         = "/siemens.iedge.dmapi.network.v1.NetworkService/ApplySettings"
+	NetworkService_GetAllInterfaces_FullMethodName         = "/siemens.iedge.dmapi.network.v1.NetworkService/GetAllInterfaces"
+	NetworkService_GetAllInterfacesWithMask_FullMethodName = "/siemens.iedge.dmapi.network.v1.NetworkService/GetAllInterfacesWithMask"
+	NetworkService_GetInterfaceWithMac_FullMethodName      = "/siemens.iedge.dmapi.network.v1.NetworkService/GetInterfaceWithMac"
+	NetworkService_GetInterfaceWithLabel_FullMethodName    = "/siemens.iedge.dmapi.network.v1.NetworkService/GetInterfaceWithLabel"
+	NetworkService_ApplySettings_FullMethodName            = "/siemens.iedge.dmapi.network.v1.NetworkService/ApplySettings"
+	NetworkService_GetDrift_FullMethodName                 = "/siemens.iedge.dmapi.network.v1.NetworkService/GetDrift"
+	NetworkService_ListRevisions_FullMethodName            = "/siemens.iedge.dmapi.network.v1.NetworkService/ListRevisions"
+	NetworkService_GetRevision_FullMethodName              = "/siemens.iedge.dmapi.network.v1.NetworkService/GetRevision"
+	NetworkService_DiffRevisions_FullMethodName            = "/siemens.iedge.dmapi.network.v1.NetworkService/DiffRevisions"
+	NetworkService_RollbackToRevision_FullMethodName       = "/siemens.iedge.dmapi.network.v1.NetworkService/RollbackToRevision"
+	NetworkService_ExportConfiguration_FullMethodName      = "/siemens.iedge.dmapi.network.v1.NetworkService/ExportConfiguration"
+	NetworkService_ImportConfiguration_FullMethodName      = "/siemens.iedge.dmapi.network.v1.NetworkService/ImportConfiguration"
+	NetworkService_ResetToFactoryDefaults_FullMethodName   = "/siemens.iedge.dmapi.network.v1.NetworkService/ResetToFactoryDefaults"
+	NetworkService_ApplyTemplate_FullMethodName            = "/siemens.iedge.dmapi.network.v1.NetworkService/ApplyTemplate"
+	NetworkService_GetPolicy_FullMethodName                = "/siemens.iedge.dmapi.network.v1.NetworkService/GetPolicy"
+	NetworkService_ConfirmSettings_FullMethodName          = "/siemens.iedge.dmapi.network.v1.NetworkService/ConfirmSettings"
+	NetworkService_RenewDhcpLease_FullMethodName           = "/siemens.iedge.dmapi.network.v1.NetworkService/RenewDhcpLease"
+	NetworkService_ReleaseDhcpLease_FullMethodName         = "/siemens.iedge.dmapi.network.v1.NetworkService/ReleaseDhcpLease"
+	NetworkService_SetHostEntries_FullMethodName           = "/siemens.iedge.dmapi.network.v1.NetworkService/SetHostEntries"
+	NetworkService_SetGlobalDns_FullMethodName             = "/siemens.iedge.dmapi.network.v1.NetworkService/SetGlobalDns"
+	NetworkService_GetResolverStatus_FullMethodName        = "/siemens.iedge.dmapi.network.v1.NetworkService/GetResolverStatus"
+	NetworkService_AuditProfiles_FullMethodName            = "/siemens.iedge.dmapi.network.v1.NetworkService/AuditProfiles"
+	NetworkService_CleanupProfiles_FullMethodName          = "/siemens.iedge.dmapi.network.v1.NetworkService/CleanupProfiles"
 )
 
 // NetworkServiceClient is the client API for NetworkService service.
@@ -47,12 +66,50 @@ const (
 type NetworkServiceClient interface {
 	// Returns the settings of all ethernet typed network interfaces
 	GetAllInterfaces(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*NetworkSettings, error)
+	// Returns the settings of all ethernet typed network interfaces, resolving only the fields given in the field mask.
+	GetAllInterfacesWithMask(ctx context.Context, in *NetworkSettingsRequest, opts ...grpc.CallOption) (*NetworkSettings, error)
 	// Returns the current setting for the interface, with given MAC address.
 	GetInterfaceWithMac(ctx context.Context, in *NetworkInterfaceRequest, opts ...grpc.CallOption) (*Interface, error)
 	// Returns the current setting for the interface,  with given Label.
 	GetInterfaceWithLabel(ctx context.Context, in *NetworkInterfaceRequestWithLabel, opts ...grpc.CallOption) (*Interface, error)
 	// Applies given configurations to Network Interfaces.
 	ApplySettings(ctx context.Context, in *NetworkSettings, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Returns the interfaces whose settings differ from the settings applied last, e.g. after changes with nmcli.
+	GetDrift(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*DriftReport, error)
+	// Returns the stored revisions of applied settings, without their settings.
+	ListRevisions(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*RevisionList, error)
+	// Returns the revision with the given number, including its settings.
+	GetRevision(ctx context.Context, in *RevisionRequest, opts ...grpc.CallOption) (*Revision, error)
+	// Returns the differences between two revisions.
+	DiffRevisions(ctx context.Context, in *DiffRevisionsRequest, opts ...grpc.CallOption) (*RevisionDiff, error)
+	// Applies the settings of the given revision again, like ApplySettings. A new revision is created for the rollback.
+	RollbackToRevision(ctx context.Context, in *RevisionRequest, opts ...grpc.CallOption) (*Revision, error)
+	// Exports the complete network configuration of the device as a versioned JSON or YAML document.
+	ExportConfiguration(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (*ConfigurationExport, error)
+	// Validates and applies a document created by ExportConfiguration, like ApplySettings. L2 networks are created if they do not exist.
+	ImportConfiguration(ctx context.Context, in *ImportRequest, opts ...grpc.CallOption) (*ImportResult, error)
+	// Deletes the connection profiles of this service on all devices and applies the factory defaults of the device builder, like ApplySettings.
+	ResetToFactoryDefaults(ctx context.Context, in *FactoryResetRequest, opts ...grpc.CallOption) (*FactoryResetResult, error)
+	// Renders a NetworkSettings template with the variables of the device and the request, validates and applies it like ApplySettings.
+	ApplyTemplate(ctx context.Context, in *TemplateRequest, opts ...grpc.CallOption) (*TemplateResult, error)
+	// Returns the policy of the device builder, which is enforced for every apply. Empty if the device has no policy.
+	GetPolicy(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*NetworkPolicy, error)
+	// Confirms a change of the management interface, which was applied with AllowManagementChange. Unconfirmed changes are rolled back after their ConfirmTimeout.
+	ConfirmSettings(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*Revision, error)
+	// Restarts the DHCP client of an interface and returns the new lease.
+	RenewDhcpLease(ctx context.Context, in *DHCPLeaseRequest, opts ...grpc.CallOption) (*DHCPLease, error)
+	// Releases the DHCP lease of an interface. It stays without address until the lease is renewed or settings are applied.
+	ReleaseDhcpLease(ctx context.Context, in *DHCPLeaseRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Replaces the static host entries of this service in the hosts file. Entries of other tools are kept. Without entries, all entries of this service are removed.
+	SetHostEntries(ctx context.Context, in *HostEntries, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Sets the global DNS configuration of NetworkManager, which is used instead of the DNS settings of the interfaces. An empty configuration removes it.
+	SetGlobalDns(ctx context.Context, in *GlobalDns, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Returns the effective resolver configuration: the DNS configuration of NetworkManager, the resolver configuration file and the static host entries.
+	GetResolverStatus(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ResolverStatus, error)
+	// Returns duplicate, orphaned and label conflicting connection profiles of this service, without changing them.
+	AuditProfiles(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ProfileReport, error)
+	// Removes the connection profiles reported by AuditProfiles, except active ones. With DryRun the profiles are only reported.
+	CleanupProfiles(ctx context.Context, in *ProfileCleanupRequest, opts ...grpc.CallOption) (*ProfileReport, error)
 }
 
 type networkServiceClient struct {
@@ -73,6 +130,16 @@ func (c *networkServiceClient) GetAllInterfaces(ctx context.Context, in *emptypb
 	return out, nil
 }
 
+func (c *networkServiceClient) GetAllInterfacesWithMask(ctx context.Context, in *NetworkSettingsRequest, opts ...grpc.CallOption) (*NetworkSettings, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NetworkSettings)
+	err := c.cc.Invoke(ctx, NetworkService_GetAllInterfacesWithMask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *networkServiceClient) GetInterfaceWithMac(ctx context.Context, in *NetworkInterfaceRequest, opts ...grpc.CallOption) (*Interface, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(Interface)
@@ -103,6 +170,186 @@ func (c *networkServiceClient) ApplySettings(ctx context.Context, in *NetworkSet
 	return out, nil
 }
 
+func (c *networkServiceClient) GetDrift(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*DriftReport, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DriftReport)
+	err := c.cc.Invoke(ctx, NetworkService_GetDrift_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *networkServiceClient) ListRevisions(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*RevisionList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevisionList)
+	err := c.cc.Invoke(ctx, NetworkService_ListRevisions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *networkServiceClient) GetRevision(ctx context.Context, in *RevisionRequest, opts ...grpc.CallOption) (*Revision, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Revision)
+	err := c.cc.Invoke(ctx, NetworkService_GetRevision_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *networkServiceClient) DiffRevisions(ctx context.Context, in *DiffRevisionsRequest, opts ...grpc.CallOption) (*RevisionDiff, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevisionDiff)
+	err := c.cc.Invoke(ctx, NetworkService_DiffRevisions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *networkServiceClient) RollbackToRevision(ctx context.Context, in *RevisionRequest, opts ...grpc.CallOption) (*Revision, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Revision)
+	err := c.cc.Invoke(ctx, NetworkService_RollbackToRevision_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *networkServiceClient) ExportConfiguration(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (*ConfigurationExport, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConfigurationExport)
+	err := c.cc.Invoke(ctx, NetworkService_ExportConfiguration_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *networkServiceClient) ImportConfiguration(ctx context.Context, in *ImportRequest, opts ...grpc.CallOption) (*ImportResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportResult)
+	err := c.cc.Invoke(ctx, NetworkService_ImportConfiguration_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *networkServiceClient) ResetToFactoryDefaults(ctx context.Context, in *FactoryResetRequest, opts ...grpc.CallOption) (*FactoryResetResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FactoryResetResult)
+	err := c.cc.Invoke(ctx, NetworkService_ResetToFactoryDefaults_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *networkServiceClient) ApplyTemplate(ctx context.Context, in *TemplateRequest, opts ...grpc.CallOption) (*TemplateResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TemplateResult)
+	err := c.cc.Invoke(ctx, NetworkService_ApplyTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *networkServiceClient) GetPolicy(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*NetworkPolicy, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NetworkPolicy)
+	err := c.cc.Invoke(ctx, NetworkService_GetPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *networkServiceClient) ConfirmSettings(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*Revision, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Revision)
+	err := c.cc.Invoke(ctx, NetworkService_ConfirmSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *networkServiceClient) RenewDhcpLease(ctx context.Context, in *DHCPLeaseRequest, opts ...grpc.CallOption) (*DHCPLease, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DHCPLease)
+	err := c.cc.Invoke(ctx, NetworkService_RenewDhcpLease_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *networkServiceClient) ReleaseDhcpLease(ctx context.Context, in *DHCPLeaseRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, NetworkService_ReleaseDhcpLease_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *networkServiceClient) SetHostEntries(ctx context.Context, in *HostEntries, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, NetworkService_SetHostEntries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *networkServiceClient) SetGlobalDns(ctx context.Context, in *GlobalDns, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, NetworkService_SetGlobalDns_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *networkServiceClient) GetResolverStatus(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ResolverStatus, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResolverStatus)
+	err := c.cc.Invoke(ctx, NetworkService_GetResolverStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *networkServiceClient) AuditProfiles(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ProfileReport, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProfileReport)
+	err := c.cc.Invoke(ctx, NetworkService_AuditProfiles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *networkServiceClient) CleanupProfiles(ctx context.Context, in *ProfileCleanupRequest, opts ...grpc.CallOption) (*ProfileReport, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProfileReport)
+	err := c.cc.Invoke(ctx, NetworkService_CleanupProfiles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // NetworkServiceServer is the server API for NetworkService service.
 // All implementations must embed UnimplementedNetworkServiceServer
 // for forward compatibility.
@@ -113,12 +360,50 @@ func (c *networkServiceClient) ApplySettings(ctx context.Context, in *NetworkSet
 type NetworkServiceServer interface {
 	// Returns the settings of all ethernet typed network interfaces
 	GetAllInterfaces(context.Context, *emptypb.Empty) (*NetworkSettings, error)
+	// Returns the settings of all ethernet typed network interfaces, resolving only the fields given in the field mask.
+	GetAllInterfacesWithMask(context.Context, *NetworkSettingsRequest) (*NetworkSettings, error)
 	// Returns the current setting for the interface, with given MAC address.
 	GetInterfaceWithMac(context.Context, *NetworkInterfaceRequest) (*Interface, error)
 	// Returns the current setting for the interface,  with given Label.
 	GetInterfaceWithLabel(context.Context, *NetworkInterfaceRequestWithLabel) (*Interface, error)
 	// Applies given configurations to Network Interfaces.
 	ApplySettings(context.Context, *NetworkSettings) (*emptypb.Empty, error)
+	// Returns the interfaces whose settings differ from the settings applied last, e.g. after changes with nmcli.
+	GetDrift(context.Context, *emptypb.Empty) (*DriftReport, error)
+	// Returns the stored revisions of applied settings, without their settings.
+	ListRevisions(context.Context, *emptypb.Empty) (*RevisionList, error)
+	// Returns the revision with the given number, including its settings.
+	GetRevision(context.Context, *RevisionRequest) (*Revision, error)
+	// Returns the differences between two revisions.
+	DiffRevisions(context.Context, *DiffRevisionsRequest) (*RevisionDiff, error)
+	// Applies the settings of the given revision again, like ApplySettings. A new revision is created for the rollback.
+	RollbackToRevision(context.Context, *RevisionRequest) (*Revision, error)
+	// Exports the complete network configuration of the device as a versioned JSON or YAML document.
+	ExportConfiguration(context.Context, *ExportRequest) (*ConfigurationExport, error)
+	// Validates and applies a document created by ExportConfiguration, like ApplySettings. L2 networks are created if they do not exist.
+	ImportConfiguration(context.Context, *ImportRequest) (*ImportResult, error)
+	// Deletes the connection profiles of this service on all devices and applies the factory defaults of the device builder, like ApplySettings.
+	ResetToFactoryDefaults(context.Context, *FactoryResetRequest) (*FactoryResetResult, error)
+	// Renders a NetworkSettings template with the variables of the device and the request, validates and applies it like ApplySettings.
+	ApplyTemplate(context.Context, *TemplateRequest) (*TemplateResult, error)
+	// Returns the policy of the device builder, which is enforced for every apply. Empty if the device has no policy.
+	GetPolicy(context.Context, *emptypb.Empty) (*NetworkPolicy, error)
+	// Confirms a change of the management interface, which was applied with AllowManagementChange. Unconfirmed changes are rolled back after their ConfirmTimeout.
+	ConfirmSettings(context.Context, *emptypb.Empty) (*Revision, error)
+	// Restarts the DHCP client of an interface and returns the new lease.
+	RenewDhcpLease(context.Context, *DHCPLeaseRequest) (*DHCPLease, error)
+	// Releases the DHCP lease of an interface. It stays without address until the lease is renewed or settings are applied.
+	ReleaseDhcpLease(context.Context, *DHCPLeaseRequest) (*emptypb.Empty, error)
+	// Replaces the static host entries of this service in the hosts file. Entries of other tools are kept. Without entries, all entries of this service are removed.
+	SetHostEntries(context.Context, *HostEntries) (*emptypb.Empty, error)
+	// Sets the global DNS configuration of NetworkManager, which is used instead of the DNS settings of the interfaces. An empty configuration removes it.
+	SetGlobalDns(context.Context, *GlobalDns) (*emptypb.Empty, error)
+	// Returns the effective resolver configuration: the DNS configuration of NetworkManager, the resolver configuration file and the static host entries.
+	GetResolverStatus(context.Context, *emptypb.Empty) (*ResolverStatus, error)
+	// Returns duplicate, orphaned and label conflicting connection profiles of this service, without changing them.
+	AuditProfiles(context.Context, *emptypb.Empty) (*ProfileReport, error)
+	// Removes the connection profiles reported by AuditProfiles, except active ones. With DryRun the profiles are only reported.
+	CleanupProfiles(context.Context, *ProfileCleanupRequest) (*ProfileReport, error)
 	mustEmbedUnimplementedNetworkServiceServer()
 }
 
@@ -132,6 +417,9 @@ type UnimplementedNetworkServiceServer struct{}
 func (UnimplementedNetworkServiceServer) GetAllInterfaces(context.Context, *emptypb.Empty) (*NetworkSettings, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAllInterfaces not implemented")
 }
+func (UnimplementedNetworkServiceServer) GetAllInterfacesWithMask(context.Context, *NetworkSettingsRequest) (*NetworkSettings, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllInterfacesWithMask not implemented")
+}
 func (UnimplementedNetworkServiceServer) GetInterfaceWithMac(context.Context, *NetworkInterfaceRequest) (*Interface, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetInterfaceWithMac not implemented")
 }
@@ -141,6 +429,60 @@ func (UnimplementedNetworkServiceServer) GetInterfaceWithLabel(context.Context,
 func (UnimplementedNetworkServiceServer) ApplySettings(context.Context, *NetworkSettings) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ApplySettings not implemented")
 }
+func (UnimplementedNetworkServiceServer) GetDrift(context.Context, *emptypb.Empty) (*DriftReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDrift not implemented")
+}
+func (UnimplementedNetworkServiceServer) ListRevisions(context.Context, *emptypb.Empty) (*RevisionList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRevisions not implemented")
+}
+func (UnimplementedNetworkServiceServer) GetRevision(context.Context, *RevisionRequest) (*Revision, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRevision not implemented")
+}
+func (UnimplementedNetworkServiceServer) DiffRevisions(context.Context, *DiffRevisionsRequest) (*RevisionDiff, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffRevisions not implemented")
+}
+func (UnimplementedNetworkServiceServer) RollbackToRevision(context.Context, *RevisionRequest) (*Revision, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackToRevision not implemented")
+}
+func (UnimplementedNetworkServiceServer) ExportConfiguration(context.Context, *ExportRequest) (*ConfigurationExport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportConfiguration not implemented")
+}
+func (UnimplementedNetworkServiceServer) ImportConfiguration(context.Context, *ImportRequest) (*ImportResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportConfiguration not implemented")
+}
+func (UnimplementedNetworkServiceServer) ResetToFactoryDefaults(context.Context, *FactoryResetRequest) (*FactoryResetResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetToFactoryDefaults not implemented")
+}
+func (UnimplementedNetworkServiceServer) ApplyTemplate(context.Context, *TemplateRequest) (*TemplateResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyTemplate not implemented")
+}
+func (UnimplementedNetworkServiceServer) GetPolicy(context.Context, *emptypb.Empty) (*NetworkPolicy, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPolicy not implemented")
+}
+func (UnimplementedNetworkServiceServer) ConfirmSettings(context.Context, *emptypb.Empty) (*Revision, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmSettings not implemented")
+}
+func (UnimplementedNetworkServiceServer) RenewDhcpLease(context.Context, *DHCPLeaseRequest) (*DHCPLease, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenewDhcpLease not implemented")
+}
+func (UnimplementedNetworkServiceServer) ReleaseDhcpLease(context.Context, *DHCPLeaseRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseDhcpLease not implemented")
+}
+func (UnimplementedNetworkServiceServer) SetHostEntries(context.Context, *HostEntries) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetHostEntries not implemented")
+}
+func (UnimplementedNetworkServiceServer) SetGlobalDns(context.Context, *GlobalDns) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetGlobalDns not implemented")
+}
+func (UnimplementedNetworkServiceServer) GetResolverStatus(context.Context, *emptypb.Empty) (*ResolverStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetResolverStatus not implemented")
+}
+func (UnimplementedNetworkServiceServer) AuditProfiles(context.Context, *emptypb.Empty) (*ProfileReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuditProfiles not implemented")
+}
+func (UnimplementedNetworkServiceServer) CleanupProfiles(context.Context, *ProfileCleanupRequest) (*ProfileReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CleanupProfiles not implemented")
+}
 func (UnimplementedNetworkServiceServer) mustEmbedUnimplementedNetworkServiceServer() {}
 func (UnimplementedNetworkServiceServer) testEmbeddedByValue()                        {}
 
@@ -180,6 +522,24 @@ func _NetworkService_GetAllInterfaces_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NetworkService_GetAllInterfacesWithMask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NetworkSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetworkServiceServer).GetAllInterfacesWithMask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NetworkService_GetAllInterfacesWithMask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetworkServiceServer).GetAllInterfacesWithMask(ctx, req.(*NetworkSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _NetworkService_GetInterfaceWithMac_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(NetworkInterfaceRequest)
 	if err := dec(in); err != nil {
@@ -234,6 +594,330 @@ func _NetworkService_ApplySettings_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NetworkService_GetDrift_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetworkServiceServer).GetDrift(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NetworkService_GetDrift_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetworkServiceServer).GetDrift(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NetworkService_ListRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetworkServiceServer).ListRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NetworkService_ListRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetworkServiceServer).ListRevisions(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NetworkService_GetRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetworkServiceServer).GetRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NetworkService_GetRevision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetworkServiceServer).GetRevision(ctx, req.(*RevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NetworkService_DiffRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetworkServiceServer).DiffRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NetworkService_DiffRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetworkServiceServer).DiffRevisions(ctx, req.(*DiffRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NetworkService_RollbackToRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetworkServiceServer).RollbackToRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NetworkService_RollbackToRevision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetworkServiceServer).RollbackToRevision(ctx, req.(*RevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NetworkService_ExportConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetworkServiceServer).ExportConfiguration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NetworkService_ExportConfiguration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetworkServiceServer).ExportConfiguration(ctx, req.(*ExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NetworkService_ImportConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetworkServiceServer).ImportConfiguration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NetworkService_ImportConfiguration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetworkServiceServer).ImportConfiguration(ctx, req.(*ImportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NetworkService_ResetToFactoryDefaults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FactoryResetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetworkServiceServer).ResetToFactoryDefaults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NetworkService_ResetToFactoryDefaults_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetworkServiceServer).ResetToFactoryDefaults(ctx, req.(*FactoryResetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NetworkService_ApplyTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetworkServiceServer).ApplyTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NetworkService_ApplyTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetworkServiceServer).ApplyTemplate(ctx, req.(*TemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NetworkService_GetPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetworkServiceServer).GetPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NetworkService_GetPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetworkServiceServer).GetPolicy(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NetworkService_ConfirmSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetworkServiceServer).ConfirmSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NetworkService_ConfirmSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetworkServiceServer).ConfirmSettings(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NetworkService_RenewDhcpLease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DHCPLeaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetworkServiceServer).RenewDhcpLease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NetworkService_RenewDhcpLease_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetworkServiceServer).RenewDhcpLease(ctx, req.(*DHCPLeaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NetworkService_ReleaseDhcpLease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DHCPLeaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetworkServiceServer).ReleaseDhcpLease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NetworkService_ReleaseDhcpLease_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetworkServiceServer).ReleaseDhcpLease(ctx, req.(*DHCPLeaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NetworkService_SetHostEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HostEntries)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetworkServiceServer).SetHostEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NetworkService_SetHostEntries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetworkServiceServer).SetHostEntries(ctx, req.(*HostEntries))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NetworkService_SetGlobalDns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GlobalDns)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetworkServiceServer).SetGlobalDns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NetworkService_SetGlobalDns_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetworkServiceServer).SetGlobalDns(ctx, req.(*GlobalDns))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NetworkService_GetResolverStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetworkServiceServer).GetResolverStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NetworkService_GetResolverStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetworkServiceServer).GetResolverStatus(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NetworkService_AuditProfiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetworkServiceServer).AuditProfiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NetworkService_AuditProfiles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetworkServiceServer).AuditProfiles(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NetworkService_CleanupProfiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProfileCleanupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetworkServiceServer).CleanupProfiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NetworkService_CleanupProfiles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetworkServiceServer).CleanupProfiles(ctx, req.(*ProfileCleanupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // NetworkService_ServiceDesc is the grpc.ServiceDesc for NetworkService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -245,6 +929,10 @@ var NetworkService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetAllInterfaces",
 			Handler:    _NetworkService_GetAllInterfaces_Handler,
 		},
+		{
+			MethodName: "GetAllInterfacesWithMask",
+			Handler:    _NetworkService_GetAllInterfacesWithMask_Handler,
+		},
 		{
 			MethodName: "GetInterfaceWithMac",
 			Handler:    _NetworkService_GetInterfaceWithMac_Handler,
@@ -257,6 +945,78 @@ var NetworkService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ApplySettings",
 			Handler:    _NetworkService_ApplySettings_Handler,
 		},
+		{
+			MethodName: "GetDrift",
+			Handler:    _NetworkService_GetDrift_Handler,
+		},
+		{
+			MethodName: "ListRevisions",
+			Handler:    _NetworkService_ListRevisions_Handler,
+		},
+		{
+			MethodName: "GetRevision",
+			Handler:    _NetworkService_GetRevision_Handler,
+		},
+		{
+			MethodName: "DiffRevisions",
+			Handler:    _NetworkService_DiffRevisions_Handler,
+		},
+		{
+			MethodName: "RollbackToRevision",
+			Handler:    _NetworkService_RollbackToRevision_Handler,
+		},
+		{
+			MethodName: "ExportConfiguration",
+			Handler:    _NetworkService_ExportConfiguration_Handler,
+		},
+		{
+			MethodName: "ImportConfiguration",
+			Handler:    _NetworkService_ImportConfiguration_Handler,
+		},
+		{
+			MethodName: "ResetToFactoryDefaults",
+			Handler:    _NetworkService_ResetToFactoryDefaults_Handler,
+		},
+		{
+			MethodName: "ApplyTemplate",
+			Handler:    _NetworkService_ApplyTemplate_Handler,
+		},
+		{
+			MethodName: "GetPolicy",
+			Handler:    _NetworkService_GetPolicy_Handler,
+		},
+		{
+			MethodName: "ConfirmSettings",
+			Handler:    _NetworkService_ConfirmSettings_Handler,
+		},
+		{
+			MethodName: "RenewDhcpLease",
+			Handler:    _NetworkService_RenewDhcpLease_Handler,
+		},
+		{
+			MethodName: "ReleaseDhcpLease",
+			Handler:    _NetworkService_ReleaseDhcpLease_Handler,
+		},
+		{
+			MethodName: "SetHostEntries",
+			Handler:    _NetworkService_SetHostEntries_Handler,
+		},
+		{
+			MethodName: "SetGlobalDns",
+			Handler:    _NetworkService_SetGlobalDns_Handler,
+		},
+		{
+			MethodName: "GetResolverStatus",
+			Handler:    _NetworkService_GetResolverStatus_Handler,
+		},
+		{
+			MethodName: "AuditProfiles",
+			Handler:    _NetworkService_AuditProfiles_Handler,
+		},
+		{
+			MethodName: "CleanupProfiles",
+			Handler:    _NetworkService_CleanupProfiles_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "Network.proto",
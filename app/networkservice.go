@@ -15,11 +15,13 @@ import (
 	v1 "networkservice/api/siemens_iedge_dmapi_v1"
 	"networkservice/internal/networking"
 	"os"
-	"sync"
+	"strings"
 	"time"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
@@ -40,7 +42,6 @@ func CreateServiceApp() *MainApp {
 type networkServer struct {
 	v1.UnimplementedNetworkServiceServer
 	configurator *networking.NetworkConfigurator
-	sync.Mutex
 }
 
 // MainApp type for Network
@@ -103,39 +104,76 @@ func (app *MainApp) StartGRPC(args []string) error {
 
 // StartApp starts additional tasks during start stage.
 func (app *MainApp) StartApp() {
-	// No need for additional start up .
+	// Reads are served from the interface snapshot, if it can not be started they are read from NetworkManager directly.
+	if err := app.serverInstance.configurator.StartSnapshotCache(context.Background()); err != nil {
+		log.Println("Snapshot cache could not be started: ", err)
+	}
+	// Drift is detected on snapshot changes, without the snapshot only periodically.
+	app.serverInstance.configurator.StartReconciler(context.Background())
+	// Duplicate, orphaned and label conflicting profiles are logged, CleanupProfiles removes them.
+	app.serverInstance.configurator.StartHousekeeping(context.Background())
+	// Interfaces in DHCP fallback mode switch between DHCP and their fallback address.
+	app.serverInstance.configurator.StartFallbackSupervisor(context.Background())
+	// Every apply is checked against the policy of the device builder, a broken policy rejects every apply.
+	if err := app.serverInstance.configurator.LoadPolicy(); err != nil {
+		log.Println("Policy can not be enforced: ", err)
+	}
+	// The factory defaults are only needed for a reset, a missing or broken file is reported early.
+	if _, err := app.serverInstance.configurator.FactoryDefaults(); err != nil {
+		log.Println("Factory defaults can not be used for a reset: ", err)
+	}
+	// Provisioning files on removable media are applied once, if provisioning directories are configured.
+	app.serverInstance.startProvisioning(context.Background(), networking.ProvisioningDirs())
 }
 
 // GetAllInterfaces Returns all ETHERNET Typed network interface settings.
 func (n *networkServer) GetAllInterfaces(ctx context.Context, e *emptypb.Empty) (*v1.NetworkSettings, error) {
 
 	log.Println("GetAllInterfaces() called")
-	n.Lock()
 
-	retVal := &v1.NetworkSettings{Interfaces: n.configurator.GetEthernetInterfaces()}
+	retVal := n.configurator.GetNetworkSettings(nil)
 
-	n.Unlock()
 	log.Println("GetAllInterfaces() done")
 
 	return retVal, status.New(codes.OK, "Get All Interfaces Done!").Err()
 }
 
+// GetAllInterfacesWithMask Returns all ETHERNET Typed network interface settings, resolving only the fields in the field mask.
+func (n *networkServer) GetAllInterfacesWithMask(ctx context.Context, request *v1.NetworkSettingsRequest) (*v1.NetworkSettings, error) {
+
+	log.Println("GetAllInterfacesWithMask() called")
+	mask, err := networking.NewInterfaceMask(request.FieldMask)
+	if err != nil {
+		log.Println(err)
+		return nil, status.New(codes.InvalidArgument, err.Error()).Err()
+	}
+
+	retVal := n.configurator.GetNetworkSettings(mask)
+
+	log.Println("GetAllInterfacesWithMask() done")
+
+	return retVal, status.New(codes.OK, "Get All Interfaces Done!").Err()
+}
+
 // GetInterfaceWithMac returns the device mathcing with given mac, else returns error.
 func (n *networkServer) GetInterfaceWithMac(ctx context.Context,
 	request *v1.NetworkInterfaceRequest) (*v1.Interface, error) {
 
 	log.Println("GetInterfaceWithMac() called")
-	n.Lock()
-	defer n.Unlock()
+	mask, err := networking.NewInterfaceMask(request.FieldMask)
+	if err != nil {
+		log.Println(err)
+		return nil, status.New(codes.InvalidArgument, err.Error()).Err()
+	}
 
-	retVal := n.configurator.GetInterfaceWithMac(request.Mac)
+	retVal := n.configurator.GetInterfaceWithMac(request.Mac, mask)
 	if retVal == nil {
 		log.Println(errMsgInterfaceNotFound)
 		return nil, status.New(codes.NotFound, errMsgInterfaceNotFound).Err()
 	}
 
 	// Check if it is the gateway interface.
-	if n.configurator.IsGatewayInterface(request.Mac) {
+	if mask.Includes(networking.FieldGatewayInterface) && n.configurator.IsGatewayInterface(request.Mac) {
 		retVal.GatewayInterface = true
 	}
 	
@@ -146,50 +184,597 @@ func (n *networkServer) GetInterfaceWithMac(ctx context.Context,
 
 // ApplySettings applies given network configurations via NetworkManager
 func (n *networkServer) ApplySettings(ctx context.Context, newSettings *v1.NetworkSettings) (*emptypb.Empty, error) {
-	result := status.New(codes.OK, "Apply Settings Done!").Err()
+	_, err := n.applySettings(ctx, newSettings, 0)
+	if err == nil {
+		err = status.New(codes.OK, "Apply Settings Done!").Err()
+	}
+	return &emptypb.Empty{}, err
+}
 
+// applySettings validates and applies the given settings. On success they are stored as desired state and
+// recorded as a new revision. It is shared by ApplySettings, RollbackToRevision and ImportConfiguration.
+func (n *networkServer) applySettings(ctx context.Context, newSettings *v1.NetworkSettings, rollbackOf uint64) (*v1.Revision, error) {
 	_, err := n.configurator.ArePreconditionsOk(newSettings)
+	if err != nil {
+		return nil, status.New(codes.FailedPrecondition, fmt.Sprintf("Wrong input for this method, %v", err)).Err()
+	}
 
+	confirm, err := n.guardManagement(ctx, newSettings, newSettings.GetOptions().GetAllowManagementChange())
 	if err != nil {
-		result = status.New(codes.FailedPrecondition, fmt.Sprintf("Wrong input for this method, %v", err)).Err()
+		return nil, err
+	}
 
+	//APPLY THE NEW SETTINGS
+	// Only the devices changed by the new settings are locked, reads are served from the snapshot meanwhile.
+	// A change, which has to be confirmed, locks all devices: its checkpoint would also roll back concurrent applies.
+	var unlock func()
+	if confirm {
+		log.Println("Acquiring exclusive apply lock for a change, which has to be confirmed")
+		unlock = n.configurator.LockAll()
 	} else {
-		//APPLY THE NEW SETTINGS
-		n.Lock()
+		unlock = n.configurator.LockForApply(newSettings)
+	}
+	defer unlock()
+	if err := n.rejectWhilePending(); err != nil {
+		return nil, err
+	}
+
+	if confirm {
+		return n.applyConfirmable(newSettings.GetOptions().GetConfirmTimeout(), func() (*v1.Revision, error) {
+			return n.applyLocked(ctx, newSettings, rollbackOf, false)
+		})
+	}
+	return n.applyLocked(ctx, newSettings, rollbackOf, false)
+}
+
+// guardManagement checks the settings against the interface, the calling TCP connection uses. Changes which would
+// cut off the caller are rejected, unless the caller allows them. It returns true if the changes have to be confirmed.
+func (n *networkServer) guardManagement(ctx context.Context, newSettings *v1.NetworkSettings, allow bool) (bool, error) {
+	if err := n.rejectWhilePending(); err != nil {
+		return false, err
+	}
+
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return false, nil
+	}
+	changes := n.configurator.ManagementChanges(newSettings, n.configurator.ManagementInterface(p.LocalAddr), p.Addr)
+	if len(changes) == 0 {
+		return false, nil
+	}
+	if !allow {
+		return false, status.New(codes.FailedPrecondition, fmt.Sprintf("%v: %s, set AllowManagementChange to apply them",
+			networking.ErrManagementChange, strings.Join(changes, ", "))).Err()
+	}
+	log.Println("Changes of the management interface allowed by the caller, they have to be confirmed: ", changes)
+	return true, nil
+}
 
-		if (nil != newSettings.LabelMap) && (0 != len(newSettings.LabelMap)) {
-			err = networking.WriteMapToFile(newSettings.LabelMap, networking.LabelMapFileName)
+// rejectWhilePending returns an error while a change waits for its confirmation. A rollback of the pending change
+// would also undo other changes. It is checked again once the apply locks are held, a change to be confirmed may
+// have been applied while the caller waited for them.
+func (n *networkServer) rejectWhilePending() error {
+	if n.configurator.ConfirmationPending() {
+		return status.New(codes.FailedPrecondition,
+			networking.ErrConfirmationPending.Error()+", it has to be confirmed with ConfirmSettings first").Err()
+	}
+	return nil
+}
+
+// applyConfirmable runs apply after a checkpoint is created. The applied change is rolled back, unless it is
+// confirmed with ConfirmSettings within the timeout.
+func (n *networkServer) applyConfirmable(timeoutSeconds uint32, apply func() (*v1.Revision, error)) (*v1.Revision, error) {
+	timeout := networking.DefaultConfirmTimeout
+	if timeoutSeconds > 0 {
+		timeout = time.Duration(timeoutSeconds) * time.Second
+	}
+	if err := n.configurator.BeginConfirmation(timeout); err != nil {
+		log.Println(err)
+		if errors.Is(err, networking.ErrConfirmationPending) {
+			return nil, status.New(codes.FailedPrecondition, err.Error()).Err()
 		}
+		return nil, status.New(codes.Internal, err.Error()).Err()
+	}
 
-		if err == nil {
-			err = n.configurator.Apply(newSettings)
+	revision, err := apply()
+	if err != nil {
+		n.configurator.CancelConfirmation()
+		return nil, err
+	}
+	n.configurator.AwaitConfirmation(revision, timeout)
+	return revision, nil
+}
+
+// applyLocked applies the validated settings while the caller holds their apply locks. With replace the settings
+// replace the desired state instead of being merged into it, e.g. for a factory reset.
+func (n *networkServer) applyLocked(ctx context.Context, newSettings *v1.NetworkSettings, rollbackOf uint64, replace bool) (*v1.Revision, error) {
+	var err error
+	// Reject the settings if the interfaces were changed since the caller read them.
+	if err = n.configurator.CheckResourceVersions(newSettings); err != nil {
+		log.Println(err)
+		if errors.Is(err, networking.ErrResourceVersionConflict) {
+			return nil, status.New(codes.Aborted, err.Error()).Err()
 		}
+		return nil, status.New(codes.Internal, err.Error()).Err()
+	}
 
-		defer n.Unlock()
-		if err != nil {
-			result = status.New(codes.Internal,
-				fmt.Sprintf("Errors occured while applying new settings,  %v", err)).Err()
+	// The label map is restored if a connectivity check fails, like the devices.
+	previousLabelMap, labelMapErr := networking.ReadLabelMap()
+	if (nil != newSettings.LabelMap) && (0 != len(newSettings.LabelMap)) {
+		err = networking.WriteMapToFile(newSettings.LabelMap, networking.LabelMapFileName)
+	}
+
+	if err == nil {
+		err = n.configurator.ApplyChecked(newSettings)
+	}
+	if errors.Is(err, networking.ErrCheckFailed) {
+		log.Println(err)
+		if labelMapErr == nil && len(newSettings.LabelMap) != 0 {
+			if restoreErr := networking.WriteMapToFile(previousLabelMap, networking.LabelMapFileName); restoreErr != nil {
+				log.Println("label map could not be restored: ", restoreErr)
+			}
 		}
-		// After the device's network configurations are set, a 5 second sleep is set because it takes time to apply to the device.
-		time.Sleep(5 * time.Second)
+		return nil, status.New(codes.Aborted, fmt.Sprintf("%v, the previous settings were restored", err)).Err()
 	}
 
-	return &emptypb.Empty{}, result
+	var revision *v1.Revision
+	// The accepted settings are the desired state, which is checked for drift afterwards.
+	if err == nil {
+		var recordErr error
+		if revision, recordErr = n.configurator.CommitDesiredState(newSettings, replace, callerIdentity(ctx), rollbackOf); recordErr != nil {
+			log.Println(recordErr)
+		}
+	}
 
+	// After the device's network configurations are set, a 5 second sleep is set because it takes time to apply to the device.
+	time.Sleep(5 * time.Second)
+
+	if err != nil {
+		return nil, status.New(codes.Internal,
+			fmt.Sprintf("Errors occured while applying new settings,  %v", err)).Err()
+	}
+	return revision, nil
+}
+
+// callerIdentity returns the "caller" metadata of the request, or the peer address if it is not set.
+func callerIdentity(ctx context.Context) string {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if caller := md.Get("caller"); len(caller) > 0 && caller[0] != "" {
+			return caller[0]
+		}
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil && p.Addr.String() != "" {
+		return p.Addr.Network() + ":" + p.Addr.String()
+	}
+	return "unknown"
 }
 
 func (n *networkServer) GetInterfaceWithLabel(ctx context.Context, request *v1.NetworkInterfaceRequestWithLabel) (*v1.Interface, error) {
 
 	log.Println("GetInterfaceWithLabel() called")
-	n.Lock()
+	mask, err := networking.NewInterfaceMask(request.FieldMask)
+	if err != nil {
+		log.Println(err)
+		return nil, status.New(codes.InvalidArgument, err.Error()).Err()
+	}
+
 	state := status.New(codes.OK, "GetInterfaceWithLabel Done!").Err()
-	retVal := n.configurator.GetInterfaceWithLabel(request.Label)
+	retVal := n.configurator.GetInterfaceWithLabel(request.Label, mask)
 	if retVal == nil {
 		state = status.New(codes.NotFound, errMsgInterfaceNotFound).Err()
 	}
 
-	n.Unlock()
 	log.Println("GetInterfaceWithLabel() done")
 
 	return retVal, state
 }
+
+// GetDrift returns the interfaces whose settings differ from the settings applied last.
+func (n *networkServer) GetDrift(ctx context.Context, e *emptypb.Empty) (*v1.DriftReport, error) {
+
+	log.Println("GetDrift() called")
+
+	retVal := n.configurator.GetDrift()
+
+	log.Println("GetDrift() done")
+
+	return retVal, status.New(codes.OK, "GetDrift Done!").Err()
+}
+
+// AuditProfiles returns the connection profiles of this service, which are duplicated, orphaned or conflict with the label map.
+func (n *networkServer) AuditProfiles(ctx context.Context, e *emptypb.Empty) (*v1.ProfileReport, error) {
+
+	log.Println("AuditProfiles() called")
+
+	retVal, err := n.configurator.AuditProfiles()
+	if err != nil {
+		log.Println(err)
+		return nil, status.New(codes.Internal, err.Error()).Err()
+	}
+
+	log.Println("AuditProfiles() done")
+
+	return retVal, status.New(codes.OK, "AuditProfiles Done!").Err()
+}
+
+// CleanupProfiles removes the connection profiles reported by AuditProfiles, unless DryRun is set.
+func (n *networkServer) CleanupProfiles(ctx context.Context, request *v1.ProfileCleanupRequest) (*v1.ProfileReport, error) {
+
+	log.Println("CleanupProfiles() called")
+
+	// Profiles of all devices are checked, so no apply may run meanwhile.
+	unlock := n.configurator.LockAll()
+	defer unlock()
+
+	retVal, err := n.configurator.CleanupProfiles(request.DryRun)
+	if err != nil {
+		log.Println(err)
+		return nil, status.New(codes.Internal, err.Error()).Err()
+	}
+
+	log.Println("CleanupProfiles() done")
+
+	return retVal, status.New(codes.OK, "CleanupProfiles Done!").Err()
+}
+
+// ListRevisions returns the stored revisions of applied settings, without their settings.
+func (n *networkServer) ListRevisions(ctx context.Context, e *emptypb.Empty) (*v1.RevisionList, error) {
+
+	log.Println("ListRevisions() called")
+
+	retVal := n.configurator.ListRevisions()
+
+	log.Println("ListRevisions() done")
+
+	return retVal, status.New(codes.OK, "ListRevisions Done!").Err()
+}
+
+// GetRevision returns the revision with the given number, including its settings.
+func (n *networkServer) GetRevision(ctx context.Context, request *v1.RevisionRequest) (*v1.Revision, error) {
+
+	log.Println("GetRevision() called")
+
+	retVal, err := n.configurator.GetRevision(request.Number)
+	if err != nil {
+		log.Println(err)
+		return nil, status.New(codes.NotFound, err.Error()).Err()
+	}
+
+	log.Println("GetRevision() done")
+
+	return retVal, status.New(codes.OK, "GetRevision Done!").Err()
+}
+
+// DiffRevisions returns the differences between two revisions.
+func (n *networkServer) DiffRevisions(ctx context.Context, request *v1.DiffRevisionsRequest) (*v1.RevisionDiff, error) {
+
+	log.Println("DiffRevisions() called")
+
+	retVal, err := n.configurator.DiffRevisions(request.From, request.To)
+	if err != nil {
+		log.Println(err)
+		return nil, status.New(codes.NotFound, err.Error()).Err()
+	}
+
+	log.Println("DiffRevisions() done")
+
+	return retVal, status.New(codes.OK, "DiffRevisions Done!").Err()
+}
+
+// RollbackToRevision applies the settings of the given revision again, through the same pipeline as ApplySettings.
+func (n *networkServer) RollbackToRevision(ctx context.Context, request *v1.RevisionRequest) (*v1.Revision, error) {
+
+	log.Println("RollbackToRevision() called")
+
+	settings, err := n.configurator.RollbackSettings(request.Number)
+	if err != nil {
+		log.Println(err)
+		return nil, status.New(codes.NotFound, err.Error()).Err()
+	}
+
+	// Only the foreign profile policy of the revision is used again, a change of the management interface
+	// has to be allowed by the caller of the rollback.
+	settings.Options = &v1.ApplyOptions{
+		ForeignProfiles:       settings.GetOptions().GetForeignProfiles(),
+		Comment:               request.Comment,
+		AllowManagementChange: request.AllowManagementChange,
+		ConfirmTimeout:        request.ConfirmTimeout,
+	}
+	if settings.Options.Comment == "" {
+		settings.Options.Comment = fmt.Sprintf("rollback to revision %d", request.Number)
+	}
+
+	retVal, err := n.applySettings(ctx, settings, request.Number)
+	if err != nil {
+		return nil, err
+	}
+	if retVal == nil {
+		// the settings were applied, but the revision could not be recorded
+		retVal = &v1.Revision{Settings: settings, RollbackOf: request.Number}
+	}
+
+	log.Println("RollbackToRevision() done")
+
+	return retVal, status.New(codes.OK, "RollbackToRevision Done!").Err()
+}
+
+// ExportConfiguration returns the complete network configuration of the device as a versioned document.
+func (n *networkServer) ExportConfiguration(ctx context.Context, request *v1.ExportRequest) (*v1.ConfigurationExport, error) {
+
+	log.Println("ExportConfiguration() called")
+
+	document, err := n.configurator.ExportConfiguration(request.Format, request.ByLabel)
+	if err != nil {
+		log.Println(err)
+		return nil, status.New(codes.Internal, err.Error()).Err()
+	}
+
+	log.Println("ExportConfiguration() done")
+
+	return &v1.ConfigurationExport{Format: request.Format, Document: document}, status.New(codes.OK, "ExportConfiguration Done!").Err()
+}
+
+// ImportConfiguration validates the given document and applies it, through the same pipeline as ApplySettings.
+// Missing L2 networks are created afterwards.
+func (n *networkServer) ImportConfiguration(ctx context.Context, request *v1.ImportRequest) (*v1.ImportResult, error) {
+
+	log.Println("ImportConfiguration() called")
+
+	settings, warnings, err := n.configurator.ImportSettings(request)
+	if err != nil {
+		log.Println(err)
+		return nil, status.New(codes.InvalidArgument, err.Error()).Err()
+	}
+
+	retVal := &v1.ImportResult{Settings: settings, Warnings: warnings}
+	if request.ValidateOnly {
+		log.Println("ImportConfiguration() done, validated only")
+		return retVal, status.New(codes.OK, "ImportConfiguration Done!").Err()
+	}
+
+	if settings.Options == nil {
+		settings.Options = &v1.ApplyOptions{}
+	}
+	if settings.Options.Comment == "" {
+		settings.Options.Comment = "configuration import"
+	}
+	if _, err = n.applySettings(ctx, settings, 0); err != nil {
+		return nil, err
+	}
+	retVal.Applied = true
+	retVal.Warnings = n.configurator.ImportL2Networks(settings, false)
+
+	log.Println("ImportConfiguration() done")
+
+	return retVal, status.New(codes.OK, "ImportConfiguration Done!").Err()
+}
+
+// ResetToFactoryDefaults deletes the connection profiles of this service and applies the factory defaults,
+// through the same pipeline as ApplySettings. The deleted profiles are added again if the defaults can not be applied.
+func (n *networkServer) ResetToFactoryDefaults(ctx context.Context, request *v1.FactoryResetRequest) (*v1.FactoryResetResult, error) {
+
+	log.Println("ResetToFactoryDefaults() called")
+
+	defaults, err := n.configurator.FactoryDefaults()
+	if err != nil {
+		log.Println(err)
+		if errors.Is(err, networking.ErrNoFactoryDefaults) {
+			return nil, status.New(codes.FailedPrecondition, err.Error()).Err()
+		}
+		return nil, status.New(codes.Internal, err.Error()).Err()
+	}
+	defaults.Options = &v1.ApplyOptions{Comment: request.Comment}
+	if defaults.Options.Comment == "" {
+		defaults.Options.Comment = "factory reset"
+	}
+
+	if _, err = n.configurator.ArePreconditionsOk(defaults); err != nil {
+		return nil, status.New(codes.FailedPrecondition, fmt.Sprintf("Factory defaults do not fit this device, %v", err)).Err()
+	}
+
+	confirm, err := n.guardManagement(ctx, defaults, request.AllowManagementChange)
+	if err != nil {
+		return nil, err
+	}
+
+	// The profiles of all devices are deleted, so no apply may run meanwhile.
+	unlock := n.configurator.LockAll()
+	defer unlock()
+	if err := n.rejectWhilePending(); err != nil {
+		return nil, err
+	}
+
+	var removed []string
+	reset := func() (*v1.Revision, error) {
+		var restore func()
+		if removed, restore, err = n.configurator.WipeManagedProfiles(); err != nil {
+			log.Println(err)
+			return nil, status.New(codes.Internal, err.Error()).Err()
+		}
+		// The settings applied before the reset must not be enforced again, nor be part of its revision.
+		revision, err := n.applyLocked(ctx, defaults, 0, true)
+		if err != nil {
+			log.Println("Factory defaults could not be applied, restoring the deleted profiles")
+			restore()
+		}
+		return revision, err
+	}
+
+	var revision *v1.Revision
+	if confirm {
+		revision, err = n.applyConfirmable(request.ConfirmTimeout, reset)
+	} else {
+		revision, err = reset()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	log.Println("ResetToFactoryDefaults() done")
+
+	return &v1.FactoryResetResult{Settings: defaults, RemovedProfiles: removed, Revision: revision},
+		status.New(codes.OK, "ResetToFactoryDefaults Done!").Err()
+}
+
+// ApplyTemplate renders the given NetworkSettings template and applies the result, through the same pipeline as ApplySettings.
+func (n *networkServer) ApplyTemplate(ctx context.Context, request *v1.TemplateRequest) (*v1.TemplateResult, error) {
+
+	log.Println("ApplyTemplate() called")
+
+	settings, err := n.configurator.RenderTemplate(request)
+	if err != nil {
+		log.Println(err)
+		return nil, status.New(codes.InvalidArgument, err.Error()).Err()
+	}
+
+	retVal := &v1.TemplateResult{Settings: settings}
+	if request.ValidateOnly {
+		log.Println("ApplyTemplate() done, validated only")
+		return retVal, status.New(codes.OK, "ApplyTemplate Done!").Err()
+	}
+
+	if retVal.Revision, err = n.applySettings(ctx, settings, 0); err != nil {
+		return nil, err
+	}
+	retVal.Applied = true
+
+	log.Println("ApplyTemplate() done")
+
+	return retVal, status.New(codes.OK, "ApplyTemplate Done!").Err()
+}
+
+// GetPolicy returns the policy of the device builder, which is enforced for every apply.
+func (n *networkServer) GetPolicy(ctx context.Context, e *emptypb.Empty) (*v1.NetworkPolicy, error) {
+
+	log.Println("GetPolicy() called")
+
+	retVal, err := n.configurator.Policy()
+	if err != nil {
+		log.Println(err)
+		return nil, status.New(codes.FailedPrecondition, err.Error()).Err()
+	}
+
+	log.Println("GetPolicy() done")
+
+	return retVal, status.New(codes.OK, "GetPolicy Done!").Err()
+}
+
+// ConfirmSettings keeps the change of the management interface, which waits for its confirmation.
+func (n *networkServer) ConfirmSettings(ctx context.Context, e *emptypb.Empty) (*v1.Revision, error) {
+
+	log.Println("ConfirmSettings() called")
+
+	retVal, err := n.configurator.ConfirmChange()
+	if err != nil {
+		log.Println(err)
+		return nil, status.New(codes.FailedPrecondition, err.Error()).Err()
+	}
+
+	log.Println("ConfirmSettings() done")
+
+	return retVal, status.New(codes.OK, "ConfirmSettings Done!").Err()
+}
+
+// RenewDhcpLease restarts the DHCP client of the interface and returns the new lease.
+func (n *networkServer) RenewDhcpLease(ctx context.Context, request *v1.DHCPLeaseRequest) (*v1.DHCPLease, error) {
+
+	log.Println("RenewDhcpLease() called")
+
+	retVal, err := n.configurator.RenewDHCPLease(&v1.Interface{MacAddress: request.Mac, Label: request.Label})
+	if err != nil {
+		log.Println(err)
+		return nil, leaseStatus(err)
+	}
+
+	log.Println("RenewDhcpLease() done")
+
+	return retVal, status.New(codes.OK, "RenewDhcpLease Done!").Err()
+}
+
+// ReleaseDhcpLease releases the DHCP lease of the interface. Releasing the lease of the interface the caller is
+// connected through has to be allowed explicitly, the caller can not reach the service over it afterwards.
+func (n *networkServer) ReleaseDhcpLease(ctx context.Context, request *v1.DHCPLeaseRequest) (*emptypb.Empty, error) {
+
+	log.Println("ReleaseDhcpLease() called")
+
+	element := &v1.Interface{MacAddress: request.Mac, Label: request.Label}
+	// Without lease the interface has no address, like with DHCP disabled and no static address.
+	released := &v1.NetworkSettings{Interfaces: []*v1.Interface{{MacAddress: request.Mac, Label: request.Label, DHCP: networking.Disabled}}}
+	if _, err := n.guardManagement(ctx, released, request.AllowManagementChange); err != nil {
+		return &emptypb.Empty{}, err
+	}
+
+	if err := n.configurator.ReleaseDHCPLease(element); err != nil {
+		log.Println(err)
+		return &emptypb.Empty{}, leaseStatus(err)
+	}
+
+	log.Println("ReleaseDhcpLease() done")
+
+	return &emptypb.Empty{}, status.New(codes.OK, "ReleaseDhcpLease Done!").Err()
+}
+
+// leaseStatus converts the errors of the DHCP lease requests to their status.
+func leaseStatus(err error) error {
+	switch {
+	case errors.Is(err, networking.ErrInterfaceNotFound):
+		return status.New(codes.NotFound, err.Error()).Err()
+	case errors.Is(err, networking.ErrNoDHCP), errors.Is(err, networking.ErrNoLease):
+		return status.New(codes.FailedPrecondition, err.Error()).Err()
+	}
+	return status.New(codes.Internal, err.Error()).Err()
+}
+
+// SetHostEntries replaces the static host entries of this service in the hosts file.
+func (n *networkServer) SetHostEntries(ctx context.Context, request *v1.HostEntries) (*emptypb.Empty, error) {
+
+	log.Println("SetHostEntries() called")
+
+	if err := n.configurator.SetHostEntries(request); err != nil {
+		log.Println(err)
+		return &emptypb.Empty{}, resolverStatus(err)
+	}
+
+	log.Println("SetHostEntries() done")
+
+	return &emptypb.Empty{}, status.New(codes.OK, "SetHostEntries Done!").Err()
+}
+
+// SetGlobalDns sets the global DNS configuration of NetworkManager, an empty configuration removes it.
+func (n *networkServer) SetGlobalDns(ctx context.Context, request *v1.GlobalDns) (*emptypb.Empty, error) {
+
+	log.Println("SetGlobalDns() called")
+
+	if err := n.configurator.SetGlobalDNS(request); err != nil {
+		log.Println(err)
+		return &emptypb.Empty{}, resolverStatus(err)
+	}
+
+	log.Println("SetGlobalDns() done")
+
+	return &emptypb.Empty{}, status.New(codes.OK, "SetGlobalDns Done!").Err()
+}
+
+// GetResolverStatus returns the effective resolver configuration of the device.
+func (n *networkServer) GetResolverStatus(ctx context.Context, e *emptypb.Empty) (*v1.ResolverStatus, error) {
+
+	log.Println("GetResolverStatus() called")
+
+	retVal, err := n.configurator.ResolverStatus()
+	if err != nil {
+		// the parts which could be read are still returned
+		log.Println(err)
+	}
+
+	log.Println("GetResolverStatus() done")
+
+	return retVal, status.New(codes.OK, "GetResolverStatus Done!").Err()
+}
+
+// resolverStatus converts the errors of the host entry and global DNS requests to their status.
+func resolverStatus(err error) error {
+	if errors.Is(err, networking.ErrInvalidHostEntries) || errors.Is(err, networking.ErrInvalidGlobalDNS) {
+		return status.New(codes.InvalidArgument, err.Error()).Err()
+	}
+	return status.New(codes.Internal, err.Error()).Err()
+}
@@ -0,0 +1,83 @@
+/*
+ * Copyright © Siemens 2025. ALL RIGHTS RESERVED.
+ * Licensed under the MIT license
+ * See LICENSE file in the top-level directory
+ */
+
+package app
+
+import (
+	"context"
+	"log"
+	v1 "networkservice/api/siemens_iedge_dmapi_v1"
+	"networkservice/internal/networking"
+	"time"
+
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// provisioningInterval is the interval in which the provisioning directories are checked, removable media
+// may be mounted after the service started.
+const provisioningInterval = 5 * time.Second
+
+// startProvisioning checks the given directories for provisioning files until the context is done.
+func (n *networkServer) startProvisioning(ctx context.Context, dirs []string) {
+	if len(dirs) == 0 {
+		return
+	}
+	log.Println("Checking provisioning directories: ", dirs)
+
+	go func() {
+		ticker := time.NewTicker(provisioningInterval)
+		defer ticker.Stop()
+		for {
+			n.provision(ctx, dirs)
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// provision applies the pending provisioning files of the given directories through the same pipeline as
+// ApplySettings and writes a report next to each file.
+func (n *networkServer) provision(ctx context.Context, dirs []string) {
+	for _, dir := range dirs {
+		file, err := networking.PendingProvisioning(dir)
+		if file == nil {
+			if err != nil {
+				log.Println("provisioning directory could not be checked: ", err)
+			}
+			continue
+		}
+		log.Println("Provisioning file found: ", file.Path)
+
+		var revision *v1.Revision
+		if err == nil {
+			if file.Settings.Options == nil {
+				file.Settings.Options = &v1.ApplyOptions{}
+			}
+			if file.Settings.Options.Comment == "" {
+				file.Settings.Options.Comment = "provisioning file"
+			}
+			// Invalid settings are rejected for good, other failures of valid settings are retried.
+			if _, err = n.configurator.ArePreconditionsOk(file.Settings); err == nil {
+				callerCtx := metadata.NewIncomingContext(ctx, metadata.Pairs("caller", "provisioning:"+file.Path))
+				revision, err = n.applySettings(callerCtx, file.Settings, 0)
+				file.Retry = err != nil
+			}
+		}
+
+		var errMessage string
+		if err != nil {
+			errMessage = status.Convert(err).Message()
+			log.Println("Provisioning file could not be applied: ", errMessage)
+		}
+		if err = networking.WriteProvisioningReport(file, revision.GetNumber(), errMessage); err != nil {
+			log.Println("Provisioning report could not be written: ", err)
+		}
+	}
+}
@@ -0,0 +1,607 @@
+/*
+ * Copyright © Siemens 2025. ALL RIGHTS RESERVED.
+ * Licensed under the MIT license
+ * See LICENSE file in the top-level directory
+ */
+
+package networking
+
+import (
+	"fmt"
+	"log"
+	"maps"
+	"net"
+	v1 "networkservice/api/siemens_iedge_dmapi_v1"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	nm "github.com/Wifx/gonetworkmanager/v2"
+	"github.com/godbus/dbus/v5"
+	"github.com/google/uuid"
+)
+
+// defaultMiiMon is the link monitoring interval of a bond in milliseconds, if none is given.
+const defaultMiiMon = 100
+
+// bondModes are the bonding modes of the kernel, the ones in primaryBondModes use a primary member.
+var (
+	bondModes        = []string{ActiveBackup, "balance-rr", "balance-xor", "broadcast", "802.3ad", "balance-tlb", "balance-alb"}
+	primaryBondModes = []string{ActiveBackup, "balance-tlb", "balance-alb"}
+)
+
+// bondBackup holds the bond and member profiles of this service, and the profiles of the members which were replaced,
+// as they were before an apply.
+type bondBackup struct {
+	profiles []nm.ConnectionSettings
+}
+
+// bondMode returns the mode of the bond, active-backup if none is given.
+func bondMode(bond *v1.Bond) string {
+	if bond.Mode == "" {
+		return ActiveBackup
+	}
+	return bond.Mode
+}
+
+// bondMaster returns the bond of a member profile, or an empty string if it is no member profile.
+func bondMaster(settings nm.ConnectionSettings) string {
+	if settingString(settings[ConnectionKey][SlaveTypeKey]) != BondType {
+		return ""
+	}
+	return settingString(settings[ConnectionKey][MasterKey])
+}
+
+// bondOptions returns the options of a bond profile.
+func bondOptions(settings nm.ConnectionSettings) map[string]string {
+	options, _ := settings[BondType][BondOptionsKey].(map[string]string)
+	return options
+}
+
+// memberInterface returns the interface settings, which refer to the same device as the bond member.
+// Members are given by MAC address or label.
+func memberInterface(member string) *v1.Interface {
+	if _, err := net.ParseMAC(member); err == nil {
+		return &v1.Interface{MacAddress: member}
+	}
+	return &v1.Interface{Label: member}
+}
+
+// memberDevice returns the device of the bond member, or nil if it does not exist.
+func (nc *NetworkConfigurator) memberDevice(member string) nm.DeviceWired {
+	device, _ := nc.getDeviceBy(memberInterface(member))
+	return device
+}
+
+// bondConnections returns the bond profiles and the member profiles of all bonds. Member profiles refer to their
+// bond by its interface name or UUID.
+func bondConnections() (bonds, members []nm.Connection, err error) {
+	connections, err := listAllConnections()
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, connection := range connections {
+		settings, err := connection.GetSettings()
+		if err != nil {
+			continue
+		}
+		if settings[ConnectionKey][TypeKey] == BondType {
+			bonds = append(bonds, connection)
+		} else if bondMaster(settings) != "" {
+			members = append(members, connection)
+		}
+	}
+	return bonds, members, nil
+}
+
+// isMemberOf reports whether the member profile belongs to the given bond profile.
+func isMemberOf(member, bond nm.ConnectionSettings) bool {
+	master := bondMaster(member)
+	return master != "" && (master == settingString(bond[ConnectionKey][InterfaceNameKey]) || master == settingString(bond[ConnectionKey][UUIDKey]))
+}
+
+// newBondSettings creates the profile of a bond, primary is the interface name of its primary member or empty.
+// The IPv4 and DNS settings are put like the ones of an Ethernet interface.
+func newBondSettings(bond *v1.Bond, primary string) nm.ConnectionSettings {
+	protoData := &v1.Interface{DHCP: bond.DHCP, Static: bond.Static, DNSConfig: bond.DNSConfig}
+	connection := initializeConnectionSettings()
+	delete(connection, EthernetType)
+	ipAssignmentMethod := determineIpAssignmentMethod(protoData)
+	applyConnectionSetting(ipAssignmentMethod, protoData, connection)
+
+	connection[ConnectionKey][IDKey] = fmt.Sprintf("%s_%s", bond.Name, ipAssignmentMethod)
+	connection[ConnectionKey][UUIDKey] = uuid.New().String()
+	connection[ConnectionKey][TimeStampKey] = time.Now().Unix()
+	connection[ConnectionKey][TypeKey] = BondType
+	connection[ConnectionKey][InterfaceNameKey] = bond.Name
+
+	miiMon := bond.MiiMon
+	if miiMon == 0 {
+		miiMon = defaultMiiMon
+	}
+	options := map[string]string{BondModeKey: bondMode(bond), BondMiiMonKey: strconv.FormatUint(uint64(miiMon), 10)}
+	if primary != "" {
+		options[BondPrimaryKey] = primary
+	}
+	connection[BondType] = map[string]interface{}{BondOptionsKey: options}
+	tagOwnership(connection)
+	return connection
+}
+
+// newMemberSettings creates the profile, which attaches the Ethernet interface to the bond. It has no IP settings.
+func newMemberSettings(bondName, interfaceName string, mac net.HardwareAddr) nm.ConnectionSettings {
+	connection := nm.ConnectionSettings{
+		ConnectionKey: {
+			IDKey:            fmt.Sprintf("%s_%s", bondName, interfaceName),
+			UUIDKey:          uuid.New().String(),
+			TimeStampKey:     time.Now().Unix(),
+			TypeKey:          EthernetType,
+			InterfaceNameKey: interfaceName,
+			MasterKey:        bondName,
+			SlaveTypeKey:     BondType,
+		},
+		EthernetType: {},
+	}
+	if mac != nil {
+		connection[EthernetType][MACAddressKey] = []byte(mac)
+	}
+	tagOwnership(connection)
+	return connection
+}
+
+// sameBondProfile reports whether the current bond profile already has the desired settings.
+func sameBondProfile(current, desired nm.ConnectionSettings) bool {
+	return equivalentSettings(current, desired) && maps.Equal(bondOptions(current), bondOptions(desired))
+}
+
+// memberName returns how a member interface is read back: by its label, or by its MAC address if it has none.
+// The profile is bound to the permanent MAC address, members of a bond share the one in use.
+func memberName(settings nm.ConnectionSettings) string {
+	interfaceName := settingString(settings[ConnectionKey][InterfaceNameKey])
+	if label, err := getLabelForInterface(interfaceName); err == nil && interfaceName != "" {
+		return label
+	}
+	if mac, ok := settings[EthernetType][MACAddressKey].([]byte); ok && len(mac) > 0 {
+		return strings.ToUpper(net.HardwareAddr(mac).String())
+	}
+	return interfaceName
+}
+
+// bondFromSettings converts a bond profile and its member profiles to the proto representation.
+func bondFromSettings(settings nm.ConnectionSettings, members []nm.ConnectionSettings) *v1.Bond {
+	options := bondOptions(settings)
+	bond := &v1.Bond{
+		Name:      settingString(settings[ConnectionKey][InterfaceNameKey]),
+		Mode:      options[BondModeKey],
+		DNSConfig: configuredDNS(settings),
+	}
+	if miiMon, err := strconv.ParseUint(options[BondMiiMonKey], 10, 32); err == nil {
+		bond.MiiMon = uint32(miiMon)
+	}
+	for _, member := range members {
+		bond.Members = append(bond.Members, memberName(member))
+		if options[BondPrimaryKey] != "" && options[BondPrimaryKey] == settingString(member[ConnectionKey][InterfaceNameKey]) {
+			bond.Primary = memberName(member)
+		}
+	}
+	if settings[IPV4Key][MethodKey] == Auto {
+		bond.DHCP = Enabled
+	} else {
+		bond.DHCP = Disabled
+		if _, ok := settings[IPV4Key][AddressDataKey].([]map[string]interface{}); ok {
+			bond.Static = parseStaticIPConfig(settings)
+		}
+	}
+	return bond
+}
+
+// readBondStatus reads the live state of the bond from the given sysfs directory, e.g: /sys/class/net.
+// It returns nil if the bond is not there.
+func readBondStatus(root, name string) *v1.Bond_BondState {
+	link := readLinkStatus(root, name)
+	if link == nil {
+		return nil
+	}
+	read := func(file string) string {
+		value, _ := os.ReadFile(filepath.Join(root, name, "bonding", file))
+		return strings.TrimSpace(string(value))
+	}
+	status := &v1.Bond_BondState{Carrier: link.Carrier, ActiveSlave: read("active_slave"), Slaves: strings.Fields(read("slaves"))}
+	// the mode is followed by its number, e.g: "active-backup 1"
+	if mode := strings.Fields(read("mode")); len(mode) > 0 {
+		status.Mode = mode[0]
+	}
+	return status
+}
+
+// readBonds returns all bonds of the device ordered by name, or nil if there are none.
+func readBonds() *v1.BondList {
+	bonds, _ := readBondsWithPaths()
+	return bonds
+}
+
+// readBondsWithPaths returns all bonds like readBonds, together with the D-Bus paths of the bond profiles.
+func readBondsWithPaths() (*v1.BondList, map[dbus.ObjectPath]bool) {
+	paths := make(map[dbus.ObjectPath]bool)
+	bonds, members, err := bondConnections()
+	if err != nil {
+		log.Println("could not read bond profiles: ", err)
+		return nil, paths
+	}
+
+	var memberSettings []nm.ConnectionSettings
+	for _, member := range members {
+		if settings, err := member.GetSettings(); err == nil {
+			memberSettings = append(memberSettings, settings)
+		}
+	}
+	list := &v1.BondList{}
+	for _, connection := range bonds {
+		paths[connection.GetPath()] = true
+		settings, err := connection.GetSettings()
+		if err != nil {
+			continue
+		}
+		var bondMembers []nm.ConnectionSettings
+		for _, member := range memberSettings {
+			if isMemberOf(member, settings) {
+				bondMembers = append(bondMembers, member)
+			}
+		}
+		bond := bondFromSettings(settings, bondMembers)
+		bond.Status = readBondStatus(SysClassNetPath, bond.Name)
+		list.Interfaces = append(list.Interfaces, bond)
+	}
+	if len(list.Interfaces) == 0 {
+		return nil, paths
+	}
+	slices.SortFunc(list.Interfaces, func(a, b *v1.Bond) int { return strings.Compare(a.Name, b.Name) })
+	return list, paths
+}
+
+// bondMemberNames returns the bond of each member interface, by interface name. With bonds given, their members are
+// resolved, otherwise the members of the bond profiles on the device are returned.
+func (nc *NetworkConfigurator) bondMemberNames(bonds *v1.BondList) map[string]string {
+	names := make(map[string]string)
+	if bonds != nil {
+		for _, bond := range bonds.Interfaces {
+			for _, member := range bond.Members {
+				if device := nc.memberDevice(member); device != nil {
+					interfaceName, _ := device.GetPropertyInterface()
+					names[interfaceName] = bond.Name
+				}
+			}
+		}
+		return names
+	}
+
+	_, members, err := bondConnections()
+	if err != nil {
+		return names
+	}
+	for _, member := range members {
+		if settings, err := member.GetSettings(); err == nil {
+			names[settingString(settings[ConnectionKey][InterfaceNameKey])] = bondMaster(settings)
+		}
+	}
+	return names
+}
+
+// applyBonds replaces the bond profiles of this service by the given bonds. Each member gets a profile, which attaches
+// it to the bond, its other profiles are replaced according to the policy. Unchanged profiles are kept. The previous
+// profiles are returned as backup, also if the apply fails.
+func (nc *NetworkConfigurator) applyBonds(bonds *v1.BondList, policy v1.ApplyOptions_ForeignProfilePolicy) (*bondBackup, error) {
+	bondProfiles, memberProfiles, err := bondConnections()
+	if err != nil {
+		return nil, fmt.Errorf("could not read bond profiles: %w", err)
+	}
+
+	backup := &bondBackup{}
+	current := make(map[string]nm.Connection)
+	currentSettings := make(map[string]nm.ConnectionSettings)
+	var obsolete []nm.Connection
+	for _, connection := range bondProfiles {
+		settings, err := connection.GetSettings()
+		if err != nil || !isServiceProfile(settings) {
+			continue
+		}
+		name := settingString(settings[ConnectionKey][InterfaceNameKey])
+		backup.profiles = append(backup.profiles, copySettings(settings))
+		if slices.ContainsFunc(bonds.GetInterfaces(), func(bond *v1.Bond) bool { return bond.Name == name }) {
+			current[name] = connection
+			currentSettings[name] = settings
+		} else {
+			obsolete = append(obsolete, connection)
+		}
+	}
+	members := make(map[string][]nm.Connection)
+	for _, connection := range memberProfiles {
+		settings, err := connection.GetSettings()
+		if err != nil || !isServiceProfile(settings) {
+			continue
+		}
+		backup.profiles = append(backup.profiles, copySettings(settings))
+		if bondSettings, ok := currentSettings[bondMaster(settings)]; ok && isMemberOf(settings, bondSettings) {
+			members[bondMaster(settings)] = append(members[bondMaster(settings)], connection)
+		} else {
+			obsolete = append(obsolete, connection)
+		}
+	}
+
+	if err := nc.deleteOldConnections(obsolete); err != nil {
+		return backup, err
+	}
+	for _, bond := range bonds.GetInterfaces() {
+		if err := nc.applyBond(bond, current[bond.Name], members[bond.Name], policy, backup); err != nil {
+			return backup, fmt.Errorf("could not configure bond %v: %w", bond.Name, err)
+		}
+	}
+	log.Printf("%d bonds configured", len(bonds.GetInterfaces()))
+	return backup, nil
+}
+
+// applyBond puts the profile of the bond and the profiles of its members. Profiles of the members, which are
+// replaced, are added to the backup.
+func (nc *NetworkConfigurator) applyBond(bond *v1.Bond, connection nm.Connection, members []nm.Connection,
+	policy v1.ApplyOptions_ForeignProfilePolicy, backup *bondBackup) error {
+	devices := make(map[string]nm.DeviceWired)
+	var names []string
+	for _, member := range bond.Members {
+		device := nc.memberDevice(member)
+		if device == nil {
+			return fmt.Errorf("device does not exist for member %v", member)
+		}
+		interfaceName, err := device.GetPropertyInterface()
+		if err != nil {
+			return err
+		}
+		devices[interfaceName] = device
+		names = append(names, interfaceName)
+	}
+	var primary string
+	if bond.Primary != "" {
+		if device := nc.memberDevice(bond.Primary); device != nil {
+			primary, _ = device.GetPropertyInterface()
+		}
+	}
+
+	bondConnection, err := nc.putBondProfile(connection, newBondSettings(bond, primary))
+	if err != nil {
+		return err
+	}
+
+	existing := make(map[string]nm.Connection)
+	var removed []nm.Connection
+	for _, member := range members {
+		settings, _ := member.GetSettings()
+		interfaceName := settingString(settings[ConnectionKey][InterfaceNameKey])
+		if devices[interfaceName] != nil {
+			existing[interfaceName] = member
+		} else {
+			removed = append(removed, member)
+		}
+	}
+	if err := nc.deleteOldConnections(removed); err != nil {
+		return err
+	}
+
+	for _, interfaceName := range names {
+		if err := nc.attachMember(bond.Name, devices[interfaceName], existing[interfaceName], policy, backup); err != nil {
+			return fmt.Errorf("could not attach %v: %w", interfaceName, err)
+		}
+	}
+	if _, err := nc.gnm.ActivateConnection(bondConnection, nil, nil); err != nil {
+		log.Println("bond configuration applied, but could not activated since: ", err)
+	}
+	return nil
+}
+
+// putBondProfile updates the given bond profile to the settings, or adds a new one if there is none.
+// A profile which already has the settings is left as it is.
+func (nc *NetworkConfigurator) putBondProfile(connection nm.Connection, settings nm.ConnectionSettings) (nm.Connection, error) {
+	if connection == nil {
+		settingsM, err := nm.NewSettings()
+		if err != nil {
+			return nil, err
+		}
+		return settingsM.AddConnection(settings)
+	}
+
+	current, err := connection.GetSettings()
+	if err != nil {
+		return nil, err
+	}
+	if sameBondProfile(current, settings) {
+		return connection, nil
+	}
+	settings[ConnectionKey][UUIDKey] = current[ConnectionKey][UUIDKey]
+	return connection, connection.Update(settings)
+}
+
+// attachMember replaces the profiles of the device by a profile attaching it to the bond, unless it already has one.
+// Profiles of other tools are handled according to the policy, the replaced profiles of this service are backed up.
+func (nc *NetworkConfigurator) attachMember(bondName string, device nm.DeviceWired, member nm.Connection,
+	policy v1.ApplyOptions_ForeignProfilePolicy, backup *bondBackup) error {
+	connections, foreign := managedConnections(device, policy)
+	if err := handleForeignProfiles(foreign, policy); err != nil {
+		return err
+	}
+
+	var replaced []nm.Connection
+	for _, connection := range connections {
+		settings, err := connection.GetSettings()
+		if err != nil {
+			return fmt.Errorf("failed to get settings for connection: %w", err)
+		}
+		if bondMaster(settings) == bondName {
+			continue
+		}
+		if isServiceProfile(settings) && bondMaster(settings) == "" {
+			profile := retrieveSettingsFromBackup(settings)
+			if err := setMACAddressInBackup(profile, device); err != nil {
+				log.Println("Cannot create backup, parse mac address error: ", err)
+			}
+			backup.profiles = append(backup.profiles, profile)
+		}
+		replaced = append(replaced, connection)
+	}
+	if err := nc.deleteOldConnections(replaced); err != nil {
+		return err
+	}
+	if member != nil {
+		return nil
+	}
+
+	interfaceName, err := device.GetPropertyInterface()
+	if err != nil {
+		return err
+	}
+	// the member is bound by the MAC address, which identifies it, the bond changes the one in use
+	permanent, _ := deviceMac(device)
+	mac, _ := net.ParseMAC(permanent)
+	settingsM, err := nm.NewSettings()
+	if err != nil {
+		return err
+	}
+	connection, err := settingsM.AddConnection(newMemberSettings(bondName, interfaceName, mac))
+	if err != nil {
+		return err
+	}
+	if _, err := nc.gnm.ActivateConnection(connection, device, nil); err != nil {
+		log.Println("member profile added, but could not activated since: ", err)
+	}
+	return nil
+}
+
+// restoreBonds replaces the bond and member profiles of this service by the ones of the backup. Replaced profiles
+// of the members are added again.
+func (nc *NetworkConfigurator) restoreBonds(backup *bondBackup) error {
+	bonds, members, err := bondConnections()
+	if err != nil {
+		return err
+	}
+	var current []nm.Connection
+	for _, connection := range append(members, bonds...) {
+		if settings, err := connection.GetSettings(); err == nil && isServiceProfile(settings) {
+			current = append(current, connection)
+		}
+	}
+	if err := nc.deleteOldConnections(current); err != nil {
+		return err
+	}
+
+	settingsM, err := nm.NewSettings()
+	if err != nil {
+		return err
+	}
+	// bonds are added before the members, which refer to them
+	for _, profile := range backup.profiles {
+		if profile[ConnectionKey][TypeKey] != BondType {
+			continue
+		}
+		settings := copySettings(profile)
+		removeDeprecatedKeys(settings)
+		connection, err := settingsM.AddConnection(settings)
+		if err != nil {
+			log.Printf("restoreBonds failed for %v: %v", settings[ConnectionKey][IDKey], err)
+			return err
+		}
+		if _, err := nc.gnm.ActivateConnection(connection, nil, nil); err != nil {
+			log.Println("bond restored, but could not activated since: ", err)
+		}
+	}
+	for _, profile := range backup.profiles {
+		if profile[ConnectionKey][TypeKey] == BondType {
+			continue
+		}
+		if _, ok := profile[EthernetType][MACAddressKey].([]byte); !ok {
+			log.Printf("restoreBonds skips %v, it has no MAC address", profile[ConnectionKey][IDKey])
+			continue
+		}
+		settings := copySettings(profile)
+		removeDeprecatedKeys(settings)
+		if err := nc.restoreConnection(settings); err != nil {
+			return err
+		}
+	}
+	log.Println("restoreBonds success")
+	return nil
+}
+
+// verifyBonds checks the bonds of the settings, and that no interface settings are given for their members.
+func verifyBonds(newSettings *v1.NetworkSettings, result *verifyResult, configurator *NetworkConfigurator) {
+	fail := func(format string, args ...interface{}) {
+		result.retVal = false
+		result.builder.WriteString(fmt.Sprintf(format+" \n", args...))
+	}
+
+	names := make(map[string]bool)
+	memberOf := make(map[string]string)
+	for _, bond := range newSettings.GetBonds().GetInterfaces() {
+		if !validInterfaceName(bond.Name) {
+			fail("wrong bond name %s", bond.Name)
+		} else if names[bond.Name] {
+			fail("bond %s is given twice", bond.Name)
+		}
+		names[bond.Name] = true
+
+		if !slices.Contains(bondModes, bondMode(bond)) {
+			fail("mode of bond %s has to be one of %v, not %s", bond.Name, bondModes, bond.Mode)
+		}
+		if len(bond.Members) == 0 {
+			fail("bond %s has no members", bond.Name)
+		}
+		var members []string
+		for _, member := range bond.Members {
+			device := configurator.memberDevice(member)
+			if device == nil {
+				fail("device does not exist: member %s of bond %s", member, bond.Name)
+				continue
+			}
+			interfaceName, _ := device.GetPropertyInterface()
+			if other, ok := memberOf[interfaceName]; ok {
+				fail("member %s of bond %s is already a member of bond %s", member, bond.Name, other)
+			}
+			memberOf[interfaceName] = bond.Name
+			members = append(members, interfaceName)
+		}
+		if bond.Primary != "" {
+			if !slices.Contains(primaryBondModes, bondMode(bond)) {
+				fail("bond %s in mode %s can not have a primary member", bond.Name, bondMode(bond))
+			} else if device := configurator.memberDevice(bond.Primary); device == nil {
+				fail("primary member %s of bond %s is no member", bond.Primary, bond.Name)
+			} else if interfaceName, _ := device.GetPropertyInterface(); !slices.Contains(members, interfaceName) {
+				fail("primary member %s of bond %s is no member", bond.Primary, bond.Name)
+			}
+		}
+
+		if bond.DHCP != "" && bond.DHCP != Enabled && bond.DHCP != Disabled {
+			fail("DHCP of bond %s has to be %s or %s, not %s", bond.Name, Enabled, Disabled, bond.DHCP)
+		}
+		settings := &v1.Interface{Label: bond.Name, Static: bond.Static, DNSConfig: bond.DNSConfig}
+		if bond.Static != nil {
+			verifyStaticConf(settings, result)
+		}
+		if bond.DNSConfig != nil {
+			verifyDNS(settings, result)
+		}
+	}
+
+	memberNames := configurator.bondMemberNames(newSettings.GetBonds())
+	if len(memberNames) == 0 {
+		return
+	}
+	for _, element := range newSettings.Interfaces {
+		device, _ := configurator.getDeviceBy(element)
+		if device == nil {
+			continue
+		}
+		interfaceName, _ := device.GetPropertyInterface()
+		if bond, ok := memberNames[interfaceName]; ok {
+			fail("%s is a member of bond %s, its IP settings are set on the bond", interfaceIdentifier(element), bond)
+		}
+	}
+}
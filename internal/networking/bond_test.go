@@ -0,0 +1,294 @@
+/*
+ * Copyright © Siemens 2025. ALL RIGHTS RESERVED.
+ * Licensed under the MIT license
+ * See LICENSE file in the top-level directory
+ */
+
+package networking
+
+import (
+	"net"
+	v1 "networkservice/api/siemens_iedge_dmapi_v1"
+	mockgnm "networkservice/internal/networking/mocks/gonetworkmanager"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	nm "github.com/Wifx/gonetworkmanager/v2"
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func getMockBondMember(interfaceName, mac string) *mockgnm.MockDeviceWired {
+	mockDevice := &mockgnm.MockDeviceWired{}
+	mockDevice.On("GetPropertyInterface").Return(interfaceName, nil)
+	mockDevice.On("GetPropertyPermHwAddress").Return(mac, nil)
+	return mockDevice
+}
+
+// applyBondMemberPatches resolves the labels X1 and X2 to the given devices.
+func applyBondMemberPatches(nc *NetworkConfigurator, x1, x2 nm.DeviceWired) *gomonkey.Patches {
+	return gomonkey.ApplyPrivateMethod(reflect.TypeOf(nc), "getDeviceWithLabel", func(_ *NetworkConfigurator, label string) nm.DeviceWired {
+		switch strings.ToUpper(label) {
+		case "X1":
+			return x1
+		case "X2":
+			return x2
+		}
+		return nil
+	})
+}
+
+func Test_newBondSettings_CreatesTaggedBondProfile(t *testing.T) {
+	bond := &v1.Bond{Name: "bond0", Members: []string{"X1", "X2"}, DHCP: Disabled,
+		Static: &v1.Interface_StaticConf{IPv4: "192.168.0.10", NetMask: "255.255.255.0", Gateway: "192.168.0.1"}}
+
+	settings := newBondSettings(bond, "eth0")
+
+	assert.Equal(t, BondType, settings[ConnectionKey][TypeKey])
+	assert.Equal(t, "bond0", settings[ConnectionKey][InterfaceNameKey])
+	assert.Equal(t, "bond0_static", settings[ConnectionKey][IDKey])
+	assert.Equal(t, map[string]string{BondModeKey: ActiveBackup, BondMiiMonKey: "100", BondPrimaryKey: "eth0"}, bondOptions(settings))
+	assert.Equal(t, Manual, settings[IPV4Key][MethodKey])
+	assert.NotContains(t, settings, EthernetType)
+	assert.True(t, isServiceProfile(settings))
+
+	balanced := newBondSettings(&v1.Bond{Name: "bond1", Mode: "balance-rr", MiiMon: 250, DHCP: Enabled}, "")
+	assert.Equal(t, map[string]string{BondModeKey: "balance-rr", BondMiiMonKey: "250"}, bondOptions(balanced))
+	assert.Equal(t, Auto, balanced[IPV4Key][MethodKey])
+}
+
+func Test_newMemberSettings_AttachesInterfaceWithoutIPSettings(t *testing.T) {
+	mac, _ := net.ParseMAC("00:0a:95:9d:68:16")
+
+	settings := newMemberSettings("bond0", "eth0", mac)
+
+	assert.Equal(t, "bond0", bondMaster(settings))
+	assert.Equal(t, EthernetType, settings[ConnectionKey][TypeKey])
+	assert.Equal(t, "eth0", settings[ConnectionKey][InterfaceNameKey])
+	assert.Equal(t, []byte(mac), settings[EthernetType][MACAddressKey])
+	assert.NotContains(t, settings, IPV4Key)
+	assert.True(t, isServiceProfile(settings))
+}
+
+func Test_bondFromSettings_ReadsMembersByLabelOrMac(t *testing.T) {
+	patches := gomonkey.ApplyFunc(readMapFromFile, func(_ string) (map[string]string, error) {
+		return map[string]string{"X1": "ETH0"}, nil
+	})
+	defer patches.Reset()
+	settings := nm.ConnectionSettings{
+		ConnectionKey: {TypeKey: BondType, InterfaceNameKey: "bond0", UUIDKey: "bond-uuid"},
+		BondType:      {BondOptionsKey: map[string]string{BondModeKey: ActiveBackup, BondMiiMonKey: "100", BondPrimaryKey: "eth0"}},
+		IPV4Key:       {MethodKey: Auto},
+	}
+	members := []nm.ConnectionSettings{
+		newMemberSettings("bond0", "eth0", nil),
+		newMemberSettings("bond-uuid", "eth1", net.HardwareAddr{0x00, 0x0a, 0x95, 0x9d, 0x68, 0x17}),
+	}
+
+	bond := bondFromSettings(settings, members)
+
+	assert.Equal(t, &v1.Bond{Name: "bond0", Mode: ActiveBackup, MiiMon: 100, Members: []string{"X1", "00:0A:95:9D:68:17"},
+		Primary: "X1", DHCP: Enabled}, bond)
+	assert.True(t, isMemberOf(members[0], settings))
+	assert.True(t, isMemberOf(members[1], settings))
+}
+
+func Test_readBondStatus_ReadsActiveSlave(t *testing.T) {
+	root := t.TempDir()
+	files := map[string]string{
+		"bond0/address":              "00:0a:95:9d:68:16\n",
+		"bond0/carrier":              "1\n",
+		"bond0/bonding/mode":         "active-backup 1\n",
+		"bond0/bonding/active_slave": "eth0\n",
+		"bond0/bonding/slaves":       "eth0 eth1\n",
+	}
+	for name, content := range files {
+		assert.Nil(t, os.MkdirAll(filepath.Dir(filepath.Join(root, name)), 0755))
+		assert.Nil(t, os.WriteFile(filepath.Join(root, name), []byte(content), 0644))
+	}
+
+	status := readBondStatus(root, "bond0")
+
+	assert.Equal(t, &v1.Bond_BondState{Carrier: true, Mode: ActiveBackup, ActiveSlave: "eth0", Slaves: []string{"eth0", "eth1"}}, status)
+	assert.Nil(t, readBondStatus(root, "bond1"))
+}
+
+func Test_convertToProto_ReportsBondOfMember(t *testing.T) {
+	iface := convertToProto(newMemberSettings("bond0", "eth0", nil), nil, "00:0a:95:9d:68:16", nil)
+
+	assert.Equal(t, &v1.Interface{MacAddress: "00:0A:95:9D:68:16", Bond: "bond0"}, iface)
+}
+
+func Test_applyBonds_CreatesBondAndReplacesMemberProfiles(t *testing.T) {
+	mockNetworkManager := &mockgnm.MockNetworkManager{}
+	nc := &NetworkConfigurator{gnm: mockNetworkManager}
+	x1 := getMockBondMember("eth0", "00:0a:95:9d:68:16")
+	x2 := getMockBondMember("eth1", "00:0a:95:9d:68:17")
+
+	obsoleteBond := getMockConnection(newBondSettings(&v1.Bond{Name: "bond1", DHCP: Enabled}, ""))
+	obsoleteBond.On("Delete").Return(nil).Once()
+	obsoleteMember := getMockConnection(newMemberSettings("bond1", "eth2", nil))
+	obsoleteMember.On("Delete").Return(nil).Once()
+	foreignBond := getMockConnection(nm.ConnectionSettings{ConnectionKey: {IDKey: "bond9", TypeKey: BondType, InterfaceNameKey: "bond9"}})
+	standalone := getMockConnection(nm.ConnectionSettings{
+		ConnectionKey: {IDKey: "x1_dhcp", UUIDKey: "standalone-uuid", TypeKey: EthernetType, InterfaceNameKey: "eth0"},
+		EthernetType:  {MACAddressKey: []byte{0x00, 0x0a, 0x95, 0x9d, 0x68, 0x16}},
+		IPV4Key:       {MethodKey: Auto},
+	})
+	standalone.On("Delete").Return(nil).Once()
+
+	bondConnection := &mockgnm.MockConnection{}
+	member0 := &mockgnm.MockConnection{}
+	member1 := &mockgnm.MockConnection{}
+	mockSettings := &mockgnm.MockSettings{}
+	added := func(interfaceName string) interface{} {
+		return mock.MatchedBy(func(settings nm.ConnectionSettings) bool {
+			return settings[ConnectionKey][InterfaceNameKey] == interfaceName
+		})
+	}
+	mockSettings.On("AddConnection", added("bond0")).Return(bondConnection, nil).Once()
+	mockSettings.On("AddConnection", added("eth0")).Return(member0, nil).Once()
+	mockSettings.On("AddConnection", added("eth1")).Return(member1, nil).Once()
+	mockNetworkManager.On("ActivateConnection", bondConnection, nil, mock.Anything).Return(&mockgnm.MockActiveConnection{}, nil).Once()
+	mockNetworkManager.On("ActivateConnection", member0, x1, mock.Anything).Return(&mockgnm.MockActiveConnection{}, nil).Once()
+	mockNetworkManager.On("ActivateConnection", member1, x2, mock.Anything).Return(&mockgnm.MockActiveConnection{}, nil).Once()
+
+	patches := applyBondMemberPatches(nc, x1, x2)
+	defer patches.Reset()
+	patches.ApplyFunc(listAllConnections, func() ([]nm.Connection, error) {
+		return []nm.Connection{obsoleteBond, obsoleteMember, foreignBond}, nil
+	})
+	useLegacyLabels(t, map[string]string{"X1": "ETH0", "X2": "ETH1"})
+	patches.ApplyFunc(managedConnections, func(device nm.DeviceWired, _ v1.ApplyOptions_ForeignProfilePolicy) ([]nm.Connection, []nm.Connection) {
+		if device == x1 {
+			return []nm.Connection{standalone}, nil
+		}
+		return nil, nil
+	})
+	patches.ApplyFunc(nm.NewSettings, func() (nm.Settings, error) {
+		return mockSettings, nil
+	})
+
+	bonds := &v1.BondList{Interfaces: []*v1.Bond{{Name: "bond0", Members: []string{"X1", "X2"}, Primary: "X1", DHCP: Enabled}}}
+	backup, err := nc.applyBonds(bonds, v1.ApplyOptions_DELETE)
+
+	assert.Nil(t, err)
+	assert.Len(t, backup.profiles, 3)
+	assert.Equal(t, "x1_dhcp", backup.profiles[2][ConnectionKey][IDKey])
+	obsoleteBond.AssertExpectations(t)
+	obsoleteMember.AssertExpectations(t)
+	standalone.AssertExpectations(t)
+	mockSettings.AssertExpectations(t)
+	mockNetworkManager.AssertExpectations(t)
+	foreignBond.AssertNotCalled(t, "Delete")
+}
+
+func Test_memberDevice_ResolvesMembersByPermanentMac(t *testing.T) {
+	nc := &NetworkConfigurator{}
+	x1 := getMockBondMember("eth0", "00:0a:95:9d:68:16")
+	x2 := getMockBondMember("eth1", "00:0a:95:9d:68:17")
+	// members of a bond use the MAC address of the bond
+	x1.On("GetPropertyHwAddress").Return("00:0a:95:9d:68:16", nil)
+	x2.On("GetPropertyHwAddress").Return("00:0a:95:9d:68:16", nil)
+
+	patches := gomonkey.ApplyPrivateMethod(reflect.TypeOf(nc), "getAllEthernetDevices", func(_ *NetworkConfigurator) []nm.DeviceWired {
+		return []nm.DeviceWired{x1, x2}
+	})
+	defer patches.Reset()
+
+	assert.Equal(t, x1, nc.memberDevice("00:0A:95:9D:68:16"))
+	assert.Equal(t, x2, nc.memberDevice("00:0A:95:9D:68:17"))
+	bonds := &v1.BondList{Interfaces: []*v1.Bond{{Name: "bond0", Members: []string{"00:0A:95:9D:68:16", "00:0A:95:9D:68:17"}}}}
+	assert.Equal(t, map[string]string{"eth0": "bond0", "eth1": "bond0"}, nc.bondMemberNames(bonds))
+}
+
+func Test_restoreBonds_RecreatesBondsBeforeMembers(t *testing.T) {
+	mockNetworkManager := &mockgnm.MockNetworkManager{}
+	nc := &NetworkConfigurator{gnm: mockNetworkManager}
+	current := getMockConnection(newBondSettings(&v1.Bond{Name: "bond0", DHCP: Enabled}, ""))
+	current.On("Delete").Return(nil).Once()
+	bond := newBondSettings(&v1.Bond{Name: "bond1", DHCP: Enabled}, "")
+	member := newMemberSettings("bond1", "eth0", net.HardwareAddr{0x00, 0x0a, 0x95, 0x9d, 0x68, 0x16})
+	bondConnection := &mockgnm.MockConnection{}
+	mockSettings := &mockgnm.MockSettings{}
+	mockSettings.On("AddConnection", bond).Return(bondConnection, nil).Once()
+	mockNetworkManager.On("ActivateConnection", bondConnection, nil, mock.Anything).Return(&mockgnm.MockActiveConnection{}, nil).Once()
+
+	var restored []nm.ConnectionSettings
+	patches := gomonkey.ApplyFunc(listAllConnections, func() ([]nm.Connection, error) {
+		return []nm.Connection{current}, nil
+	})
+	defer patches.Reset()
+	patches.ApplyFunc(nm.NewSettings, func() (nm.Settings, error) {
+		return mockSettings, nil
+	})
+	patches.ApplyPrivateMethod(reflect.TypeOf(nc), "restoreConnection", func(_ *NetworkConfigurator, backup nm.ConnectionSettings) error {
+		restored = append(restored, backup)
+		return nil
+	})
+
+	err := nc.restoreBonds(&bondBackup{profiles: []nm.ConnectionSettings{member, bond}})
+
+	assert.Nil(t, err)
+	assert.Equal(t, []nm.ConnectionSettings{member}, restored)
+	current.AssertExpectations(t)
+	mockSettings.AssertExpectations(t)
+	mockNetworkManager.AssertExpectations(t)
+}
+
+func TestVerifyBonds(t *testing.T) {
+	tests := []struct {
+		name       string
+		bonds      []*v1.Bond
+		interfaces []*v1.Interface
+		error      string
+	}{
+		{"valid", []*v1.Bond{{Name: "bond0", Members: []string{"X1", "x2"}, Primary: "X1", DHCP: Enabled}}, nil, ""},
+		{"wrong name", []*v1.Bond{{Name: "bond/0", Members: []string{"X1"}}}, nil, "wrong bond name"},
+		{"unknown mode", []*v1.Bond{{Name: "bond0", Mode: "lacp", Members: []string{"X1"}}}, nil, "mode of bond"},
+		{"no members", []*v1.Bond{{Name: "bond0"}}, nil, "has no members"},
+		{"missing member", []*v1.Bond{{Name: "bond0", Members: []string{"X3"}}}, nil, "device does not exist"},
+		{"member twice", []*v1.Bond{{Name: "bond0", Members: []string{"X1"}}, {Name: "bond1", Members: []string{"X1"}}}, nil, "already a member"},
+		{"primary no member", []*v1.Bond{{Name: "bond0", Members: []string{"X1"}, Primary: "X2"}}, nil, "is no member"},
+		{"primary in round robin", []*v1.Bond{{Name: "bond0", Mode: "balance-rr", Members: []string{"X1", "X2"}, Primary: "X1"}}, nil, "can not have a primary"},
+		{"fallback", []*v1.Bond{{Name: "bond0", Members: []string{"X1"}, DHCP: Fallback}}, nil, "DHCP of bond"},
+		{"member settings", []*v1.Bond{{Name: "bond0", Members: []string{"X1"}}}, []*v1.Interface{{Label: "X1", DHCP: Enabled}}, "is a member of bond bond0"},
+		{"other interface", []*v1.Bond{{Name: "bond0", Members: []string{"X1"}}}, []*v1.Interface{{Label: "X2", DHCP: Enabled}}, ""},
+		{"removed bonds", nil, []*v1.Interface{{Label: "X1", DHCP: Enabled}}, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			nc := &NetworkConfigurator{}
+			patches := applyBondMemberPatches(nc, getMockBondMember("eth0", ""), getMockBondMember("eth1", ""))
+			defer patches.Reset()
+
+			result := &verifyResult{retVal: true}
+			verifyBonds(&v1.NetworkSettings{Interfaces: tt.interfaces, Bonds: &v1.BondList{Interfaces: tt.bonds}}, result, nc)
+
+			assert.Equal(t, tt.error == "", result.retVal)
+			assert.True(t, strings.Contains(result.builder.String(), tt.error), result.builder.String())
+		})
+	}
+}
+
+func Test_mergeDesiredState_KeepsBondsAndDropsMembers(t *testing.T) {
+	current := &v1.NetworkSettings{Interfaces: []*v1.Interface{{Label: "X1", DHCP: Enabled}, {Label: "X3", DHCP: Enabled}}}
+	bonds := &v1.BondList{Interfaces: []*v1.Bond{{Name: "bond0", Members: []string{"x1", "X2"}, DHCP: Enabled,
+		Status: &v1.Bond_BondState{Carrier: true}}}}
+
+	merged := mergeDesiredState(current, &v1.NetworkSettings{Interfaces: []*v1.Interface{{Label: "X2", DHCP: Enabled}}, Bonds: bonds})
+
+	assert.Equal(t, []*v1.Interface{{Label: "X3", DHCP: Enabled}}, merged.Interfaces)
+	assert.Equal(t, []*v1.Bond{{Name: "bond0", Members: []string{"x1", "X2"}, DHCP: Enabled}}, merged.Bonds.Interfaces)
+
+	merged = mergeDesiredState(merged, &v1.NetworkSettings{Interfaces: []*v1.Interface{{Label: "X3", DHCP: Disabled}}})
+	assert.Len(t, merged.Bonds.GetInterfaces(), 1)
+
+	merged = mergeDesiredState(merged, &v1.NetworkSettings{Bonds: &v1.BondList{}})
+	assert.NotNil(t, merged.Bonds, "Removed bonds should be stored as an empty bond list")
+	assert.Empty(t, merged.Bonds.Interfaces)
+}
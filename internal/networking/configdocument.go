@@ -0,0 +1,307 @@
+/*
+ * Copyright © Siemens 2025. ALL RIGHTS RESERVED.
+ * Licensed under the MIT license
+ * See LICENSE file in the top-level directory
+ */
+
+package networking
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	v1 "networkservice/api/siemens_iedge_dmapi_v1"
+	"os"
+	"strings"
+	"time"
+
+	nm "github.com/Wifx/gonetworkmanager/v2"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// ConfigurationAPIVersion is the version of the configuration documents written and accepted by this service.
+	ConfigurationAPIVersion = "network.iedge.siemens.com/v1"
+	// ConfigurationKind is the kind of the configuration documents.
+	ConfigurationKind = "NetworkConfiguration"
+)
+
+// ErrInvalidDocument is returned for configuration documents, which can not be imported on this device.
+var ErrInvalidDocument = errors.New("invalid configuration document")
+
+// exportMask selects the fields of an interface, which are exported. DNS servers are read from the profile instead.
+// The bond of an interface is only selected to skip its members.
+var exportMask = &InterfaceMask{paths: maskTree{FieldMacAddress: {}, FieldDHCP: {}, FieldStatic: {}, FieldL2Conf: {}, FieldLabel: {}, FieldRoutes: {}, FieldFallback: {}, FieldDHCPOptions: {}, FieldLink: {}, FieldVlans: {}, FieldBond: {}}}
+
+// ExportConfiguration returns the settings of all ethernet interfaces, the bonds and the label map as a serialized
+// ConfigurationDocument. With byLabel, interfaces which have a label are exported without their MAC address.
+func (nc *NetworkConfigurator) ExportConfiguration(format v1.DocumentFormat, byLabel bool) (string, error) {
+	document := &v1.ConfigurationDocument{
+		ApiVersion: ConfigurationAPIVersion,
+		Kind:       ConfigurationKind,
+		ExportedAt: time.Now().Unix(),
+		Settings:   &v1.NetworkSettings{},
+	}
+	document.Hostname, _ = os.Hostname()
+	if labelMap, err := readMapFromFile(LabelMapFileName); err == nil {
+		document.Settings.LabelMap = labelMap
+	}
+
+	devices := nc.getAllEthernetDevices()
+	gatewayMAC := nc.findGatewayMAC(devices)
+	for _, device := range devices {
+		element := exportInterface(device)
+		// bond members are configured by the bond
+		if element.Bond != "" {
+			continue
+		}
+		element.GatewayInterface = gatewayMAC != "" && strings.EqualFold(element.MacAddress, gatewayMAC)
+		if byLabel && element.Label != "" {
+			element.MacAddress = ""
+		}
+		document.Settings.Interfaces = append(document.Settings.Interfaces, element)
+	}
+	if bonds := readBonds(); bonds != nil {
+		for _, bond := range bonds.Interfaces {
+			bond.Status = nil
+		}
+		document.Settings.Bonds = bonds
+	}
+	return marshalDocument(document, format)
+}
+
+// exportInterface returns the settings of the device as they are exported. The DNS servers are read from the
+// profile, so servers received by DHCP are not exported as configured ones.
+func exportInterface(device nm.DeviceWired) *v1.Interface {
+	element := DBusToProto(device, exportMask)
+	if element.DHCP == Enabled {
+		element.Static = nil
+	}
+	if element.L2Conf.GetStartingAddressIPv4() == "" {
+		element.L2Conf = nil
+	}
+
+	connections := listConnections(device)
+	profile := activeProfile(device, connections)
+	if profile == nil && len(connections) > 0 {
+		profile = connections[0]
+	}
+	if profile != nil {
+		if settings, err := profile.GetSettings(); err == nil {
+			element.DNSConfig = configuredDNS(settings)
+		}
+	}
+	return element
+}
+
+// configuredDNS returns the DNS settings of the connection settings, or nil if there are none.
+func configuredDNS(settings nm.ConnectionSettings) *v1.Interface_Dns {
+	servers, _ := settings[IPV4Key][DNSKey].([]uint32)
+	dns := &v1.Interface_Dns{}
+	for _, server := range servers {
+		dns.Servers = append(dns.Servers, IPFromUInt32LI(server))
+	}
+	if len(dns.Servers) > 0 {
+		dns.PrimaryDNS = dns.Servers[0]
+		if len(dns.Servers) > 1 {
+			dns.SecondaryDNS = dns.Servers[1]
+		}
+	}
+	parseDNSSettings(settings, dns)
+	if len(dns.Servers) == 0 && len(dns.Searches) == 0 && dns.Priority == 0 && len(dnsOptions(dns)) == 0 &&
+		dns.Auto == v1.Interface_Dns_DEFAULT {
+		return nil
+	}
+	return dns
+}
+
+// marshalDocument serializes the document. Field names are the names of the proto file in both formats.
+func marshalDocument(document *v1.ConfigurationDocument, format v1.DocumentFormat) (string, error) {
+	buffer, err := protojson.MarshalOptions{Multiline: true, Indent: "  ", UseProtoNames: true}.Marshal(document)
+	if err != nil {
+		return "", err
+	}
+
+	if format == v1.DocumentFormat_YAML {
+		// JSON is valid YAML, so the field order of the JSON document is kept
+		var node yaml.Node
+		if err := yaml.Unmarshal(buffer, &node); err != nil {
+			return "", err
+		}
+		blockStyle(&node)
+		if buffer, err = yaml.Marshal(&node); err != nil {
+			return "", err
+		}
+	}
+	return string(buffer), nil
+}
+
+// blockStyle removes the JSON flow style of the node and its children. Strings are still quoted where needed.
+func blockStyle(node *yaml.Node) {
+	node.Style = 0
+	for _, child := range node.Content {
+		blockStyle(child)
+	}
+}
+
+// decodeDocument parses the JSON or YAML encoded message. YAML is converted to JSON, so the field names are the same.
+func decodeDocument(buffer []byte, format v1.DocumentFormat, message proto.Message) error {
+	if format == v1.DocumentFormat_YAML {
+		var content interface{}
+		if err := yaml.Unmarshal(buffer, &content); err != nil {
+			return err
+		}
+		var err error
+		if buffer, err = json.Marshal(content); err != nil {
+			return err
+		}
+	}
+	return protojson.Unmarshal(buffer, message)
+}
+
+// unmarshalDocument parses the serialized document and checks its version.
+func unmarshalDocument(document string, format v1.DocumentFormat) (*v1.ConfigurationDocument, error) {
+	parsed := &v1.ConfigurationDocument{}
+	if err := decodeDocument([]byte(document), format, parsed); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidDocument, err)
+	}
+	if parsed.ApiVersion != ConfigurationAPIVersion {
+		return nil, fmt.Errorf("%w: unsupported ApiVersion %q, expected %q", ErrInvalidDocument, parsed.ApiVersion, ConfigurationAPIVersion)
+	}
+	if parsed.Kind != ConfigurationKind {
+		return nil, fmt.Errorf("%w: unsupported Kind %q, expected %q", ErrInvalidDocument, parsed.Kind, ConfigurationKind)
+	}
+	return parsed, nil
+}
+
+// importedSettings returns the settings of the document to apply. With matchByLabel the MAC addresses are
+// dropped, so the interfaces are matched by their label on this device.
+func importedSettings(document *v1.ConfigurationDocument, matchByLabel bool) (*v1.NetworkSettings, error) {
+	settings := &v1.NetworkSettings{LabelMap: document.GetSettings().GetLabelMap()}
+	for _, element := range document.GetSettings().GetInterfaces() {
+		imported := proto.Clone(element).(*v1.Interface)
+		imported.Generation = 0
+		imported.ResourceVersion = ""
+		imported.ProfileOwner = ""
+		imported.InterfaceName = ""
+
+		if matchByLabel {
+			if imported.Label == "" {
+				return nil, fmt.Errorf("%w: interface %v has no label", ErrInvalidDocument, imported.MacAddress)
+			}
+			imported.MacAddress = ""
+		} else if imported.MacAddress == "" && imported.Label == "" {
+			return nil, fmt.Errorf("%w: interface without MAC address and label", ErrInvalidDocument)
+		}
+		settings.Interfaces = append(settings.Interfaces, imported)
+	}
+	if bonds := document.GetSettings().GetBonds(); bonds != nil {
+		settings.Bonds = proto.Clone(bonds).(*v1.BondList)
+		for _, bond := range settings.Bonds.Interfaces {
+			bond.Status = nil
+		}
+	}
+	return settings, nil
+}
+
+// ImportSettings parses the document of the request and returns the settings to apply on this device, together
+// with warnings for the L2 networks which will not be created. The settings are validated like in ApplySettings,
+// every interface has to exist on this device.
+func (nc *NetworkConfigurator) ImportSettings(request *v1.ImportRequest) (*v1.NetworkSettings, []string, error) {
+	document, err := unmarshalDocument(request.Document, request.Format)
+	if err != nil {
+		return nil, nil, err
+	}
+	settings, err := importedSettings(document, request.MatchByLabel)
+	if err != nil {
+		return nil, nil, err
+	}
+	settings.Options = request.Options
+
+	if _, err := nc.ArePreconditionsOk(settings); err != nil {
+		return nil, nil, fmt.Errorf("%w: %v", ErrInvalidDocument, err)
+	}
+	labelMap := importLabelMap(settings)
+	for _, element := range settings.Interfaces {
+		if _, err := nc.importInterfaceName(element, labelMap); err != nil {
+			return nil, nil, fmt.Errorf("%w: %v", ErrInvalidDocument, err)
+		}
+	}
+	return settings, nc.ImportL2Networks(settings, true), nil
+}
+
+// ImportL2Networks creates the L2 networks of the imported settings, which do not exist yet. Existing networks
+// are not changed, a warning is returned if their settings differ. With dryRun nothing is created.
+func (nc *NetworkConfigurator) ImportL2Networks(settings *v1.NetworkSettings, dryRun bool) []string {
+	var warnings []string
+	labelMap := importLabelMap(settings)
+	for _, element := range settings.Interfaces {
+		if element.L2Conf.GetStartingAddressIPv4() == "" {
+			continue
+		}
+		interfaceName, err := nc.importInterfaceName(element, labelMap)
+		if err != nil {
+			warnings = append(warnings, fmt.Sprintf("L2 network not created: %v", err))
+			continue
+		}
+
+		existing := dockerNetworkGetMacvlanConnection(interfaceName)
+		if existing.GetStartingAddressIPv4() != "" {
+			if !proto.Equal(existing, element.L2Conf) {
+				warnings = append(warnings, fmt.Sprintf("L2 network of %v exists with other settings, it is not changed", interfaceName))
+			}
+			continue
+		}
+		if dryRun {
+			continue
+		}
+		if err := dockerNetworkCreateMacvlan(interfaceName, element.L2Conf); err != nil {
+			log.Println(err)
+			warnings = append(warnings, fmt.Sprintf("L2 network of %v not created: %v", interfaceName, err))
+		}
+	}
+	return warnings
+}
+
+// importLabelMap returns the label map of the imported settings, or the label map of the device if they have none.
+func importLabelMap(settings *v1.NetworkSettings) map[string]string {
+	if len(settings.LabelMap) != 0 {
+		return GetMapWithUppercase(settings.LabelMap)
+	}
+	labelMap, err := readMapFromFile(LabelMapFileName)
+	if err != nil {
+		log.Println(err)
+	}
+	return labelMap
+}
+
+// importInterfaceName returns the name of the device, the imported interface is applied to. Like in Apply,
+// the device is found by MAC address if the interface has one, otherwise by its label.
+func (nc *NetworkConfigurator) importInterfaceName(element *v1.Interface, labelMap map[string]string) (string, error) {
+	expectedInterface := ""
+	if element.MacAddress == "" {
+		expectedInterface = labelMap[strings.ToUpper(element.Label)]
+		if expectedInterface == "" {
+			return "", fmt.Errorf("label %v is not in the label map", element.Label)
+		}
+	}
+
+	for _, device := range nc.getAllEthernetDevices() {
+		interfaceName, _ := device.GetPropertyInterface()
+		if expectedInterface != "" && strings.EqualFold(expectedInterface, interfaceName) {
+			return interfaceName, nil
+		}
+		if expectedInterface == "" {
+			if hw, _ := deviceMac(device); strings.EqualFold(hw, element.MacAddress) {
+				return interfaceName, nil
+			}
+		}
+	}
+	if expectedInterface != "" {
+		return "", fmt.Errorf("device does not exist for label %v", element.Label)
+	}
+	return "", fmt.Errorf("device does not exist: mac address %v", element.MacAddress)
+}
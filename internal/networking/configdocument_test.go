@@ -0,0 +1,207 @@
+/*
+ * Copyright © Siemens 2025. ALL RIGHTS RESERVED.
+ * Licensed under the MIT license
+ * See LICENSE file in the top-level directory
+ */
+
+package networking
+
+import (
+	"errors"
+	v1 "networkservice/api/siemens_iedge_dmapi_v1"
+	mockgnm "networkservice/internal/networking/mocks/gonetworkmanager"
+	"reflect"
+	"strings"
+	"testing"
+
+	nm "github.com/Wifx/gonetworkmanager/v2"
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+func getMockConfigurationDocument() *v1.ConfigurationDocument {
+	return &v1.ConfigurationDocument{
+		ApiVersion: ConfigurationAPIVersion,
+		Kind:       ConfigurationKind,
+		ExportedAt: 1760000000,
+		Hostname:   "ipc-0001",
+		Settings: &v1.NetworkSettings{
+			Interfaces: []*v1.Interface{
+				{
+					MacAddress:       "00:0A:95:9D:68:16",
+					Label:            "X1",
+					DHCP:             Disabled,
+					Static:           getMockInterfaceStaticConf(),
+					DNSConfig:        &v1.Interface_Dns{PrimaryDNS: "8.8.8.8"},
+					GatewayInterface: true,
+					Routes:           []*v1.Interface_Route{{Destination: "10.0.0.0/8", NextHop: "192.168.1.254"}},
+					L2Conf:           getMockInterfaceL2Config(),
+				},
+				{MacAddress: "00:0A:95:9D:68:17", Label: "X2", DHCP: Enabled},
+			},
+			LabelMap: map[string]string{"X1": "ENS18", "X2": "ENS19"},
+		},
+	}
+}
+
+func Test_marshalDocument_RoundTrip(t *testing.T) {
+	document := getMockConfigurationDocument()
+
+	for _, format := range []v1.DocumentFormat{v1.DocumentFormat_JSON, v1.DocumentFormat_YAML} {
+		serialized, err := marshalDocument(document, format)
+		assert.NoError(t, err)
+
+		parsed, err := unmarshalDocument(serialized, format)
+		assert.NoError(t, err)
+		assert.True(t, proto.Equal(document, parsed), "%v document should be parsed unchanged", format)
+	}
+
+	serialized, _ := marshalDocument(document, v1.DocumentFormat_YAML)
+	assert.True(t, strings.HasPrefix(serialized, "ApiVersion: network.iedge.siemens.com/v1\nKind: NetworkConfiguration\n"),
+		"YAML documents should be written in block style with the field order of the proto file")
+}
+
+func Test_unmarshalDocument_RejectsInvalidDocuments(t *testing.T) {
+	document := getMockConfigurationDocument()
+	document.ApiVersion = "network.iedge.siemens.com/v2"
+	serialized, _ := marshalDocument(document, v1.DocumentFormat_JSON)
+
+	_, err := unmarshalDocument(serialized, v1.DocumentFormat_JSON)
+	assert.True(t, errors.Is(err, ErrInvalidDocument))
+	assert.Contains(t, err.Error(), "unsupported ApiVersion")
+
+	_, err = unmarshalDocument(`{"ApiVersion": "network.iedge.siemens.com/v1", "Unknown": 1}`, v1.DocumentFormat_JSON)
+	assert.True(t, errors.Is(err, ErrInvalidDocument), "Unknown fields should be rejected")
+
+	_, err = unmarshalDocument("ApiVersion: [", v1.DocumentFormat_YAML)
+	assert.True(t, errors.Is(err, ErrInvalidDocument))
+}
+
+func Test_importedSettings_MatchByLabel(t *testing.T) {
+	document := getMockConfigurationDocument()
+	document.Settings.Interfaces[0].ResourceVersion = "abc"
+	document.Settings.Interfaces[0].InterfaceName = "ens18"
+
+	settings, err := importedSettings(document, true)
+
+	assert.NoError(t, err)
+	assert.Len(t, settings.Interfaces, 2)
+	assert.Empty(t, settings.Interfaces[0].MacAddress, "Interfaces should be matched by label")
+	assert.Empty(t, settings.Interfaces[0].ResourceVersion, "Read only fields should not be imported")
+	assert.Empty(t, settings.Interfaces[0].InterfaceName)
+	assert.Equal(t, "X1", settings.Interfaces[0].Label)
+	assert.Equal(t, "00:0A:95:9D:68:16", document.Settings.Interfaces[0].MacAddress, "The document should not be modified")
+
+	document.Settings.Interfaces[1].Label = ""
+	_, err = importedSettings(document, true)
+	assert.True(t, errors.Is(err, ErrInvalidDocument), "Interfaces without label can not be matched by label")
+
+	settings, err = importedSettings(document, false)
+	assert.NoError(t, err)
+	assert.Equal(t, "00:0A:95:9D:68:17", settings.Interfaces[1].MacAddress)
+}
+
+func Test_ImportSettings_ValidatesAgainstDevice(t *testing.T) {
+	nc := &NetworkConfigurator{}
+	mockDevice := &mockgnm.MockDeviceWired{}
+	mockDevice.On("GetPropertyInterface").Return("ens18", nil)
+	mockDevice.On("GetPropertyHwAddress").Return("00:0A:95:9D:68:99", nil)
+	mockDevice.On("GetPropertyPermHwAddress").Return("00:0A:95:9D:68:99", nil)
+
+	patches := gomonkey.NewPatches()
+	defer patches.Reset()
+	patches.ApplyPrivateMethod(reflect.TypeOf(nc), "getAllEthernetDevices", func(_ *NetworkConfigurator) []nm.DeviceWired {
+		return []nm.DeviceWired{mockDevice}
+	})
+	patches.ApplyFunc(dockerNetworkGetMacvlanConnection, func(_ string) *v1.Interface_L2 {
+		return &v1.Interface_L2{StartingAddressIPv4: "192.168.18.24", NetMask: "255.255.0.0", Range: "8"}
+	})
+
+	document := getMockConfigurationDocument()
+	document.Settings.Interfaces = document.Settings.Interfaces[:1]
+	serialized, _ := marshalDocument(document, v1.DocumentFormat_YAML)
+	request := &v1.ImportRequest{Format: v1.DocumentFormat_YAML, Document: serialized, ValidateOnly: true}
+
+	_, _, err := nc.ImportSettings(request)
+	assert.True(t, errors.Is(err, ErrInvalidDocument), "The MAC address of another device should be rejected")
+
+	request.MatchByLabel = true
+	request.Options = &v1.ApplyOptions{Comment: "commissioning"}
+	settings, warnings, err := nc.ImportSettings(request)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "commissioning", settings.Options.Comment)
+	assert.Equal(t, []string{"L2 network of ens18 exists with other settings, it is not changed"}, warnings)
+
+	document.Settings.LabelMap = map[string]string{"X1": "ENS20"}
+	request.Document, _ = marshalDocument(document, v1.DocumentFormat_YAML)
+	_, _, err = nc.ImportSettings(request)
+	assert.True(t, errors.Is(err, ErrInvalidDocument), "Labels of missing interfaces should be rejected")
+}
+
+func Test_configuredDNS(t *testing.T) {
+	settings := nm.ConnectionSettings{IPV4Key: {DNSKey: []uint32{IPToUInt32LI("8.8.8.8"), IPToUInt32LI("8.8.4.4")}}}
+
+	assert.Equal(t, &v1.Interface_Dns{PrimaryDNS: "8.8.8.8", SecondaryDNS: "8.8.4.4", Servers: []string{"8.8.8.8", "8.8.4.4"}},
+		configuredDNS(settings))
+	assert.Nil(t, configuredDNS(nm.ConnectionSettings{IPV4Key: {}}), "DNS servers received by DHCP should not be exported")
+}
+
+// getMockExportDevice returns a device without active connection, which exports the given profile.
+func getMockExportDevice(interfaceName, mac string) *mockgnm.MockDeviceWired {
+	device := &mockgnm.MockDeviceWired{}
+	device.On("GetPropertyInterface").Return(interfaceName, nil)
+	device.On("GetPropertyHwAddress").Return(mac, nil)
+	device.On("GetPropertyPermHwAddress").Return(mac, nil)
+	device.On("GetPropertyActiveConnection").Return((*mockgnm.MockActiveConnection)(nil), errors.New("not active"))
+	return device
+}
+
+func Test_ExportConfiguration_RoundTripWithBond(t *testing.T) {
+	nc := &NetworkConfigurator{}
+	x1 := getMockExportDevice("eth0", "00:0a:95:9d:68:16")
+	x2 := getMockExportDevice("eth1", "00:0a:95:9d:68:17")
+	x3 := getMockExportDevice("eth2", "00:0a:95:9d:68:18")
+	profiles := map[nm.DeviceWired]nm.ConnectionSettings{
+		x1: newMemberSettings("bond0", "eth0", []byte{0x00, 0x0a, 0x95, 0x9d, 0x68, 0x16}),
+		x2: newMemberSettings("bond0", "eth1", []byte{0x00, 0x0a, 0x95, 0x9d, 0x68, 0x17}),
+		x3: newSettingsFromProto(&v1.Interface{MacAddress: "00:0a:95:9d:68:18", DHCP: Enabled}, "eth2"),
+	}
+
+	patches := applyBondMemberPatches(nc, x1, x2)
+	defer patches.Reset()
+	patches.ApplyPrivateMethod(reflect.TypeOf(nc), "getAllEthernetDevices", func(_ *NetworkConfigurator) []nm.DeviceWired {
+		return []nm.DeviceWired{x1, x2, x3}
+	})
+	patches.ApplyPrivateMethod(reflect.TypeOf(nc), "findGatewayMAC", func(_ *NetworkConfigurator, _ []nm.DeviceWired) string {
+		return ""
+	})
+	patches.ApplyFunc(listConnections, func(device nm.DeviceWired) []nm.Connection {
+		return []nm.Connection{getMockConnection(profiles[device])}
+	})
+	patches.ApplyFunc(readMapFromFile, func(_ string) (map[string]string, error) {
+		return map[string]string{"X1": "ETH0", "X2": "ETH1", "X3": "ETH2"}, nil
+	})
+	patches.ApplyFunc(dockerNetworkGetMacvlanConnection, func(_ string) *v1.Interface_L2 {
+		return nil
+	})
+	patches.ApplyFunc(readVlans, func(_ string) *v1.Interface_VlanList {
+		return nil
+	})
+	patches.ApplyFunc(readBonds, func() *v1.BondList {
+		return &v1.BondList{Interfaces: []*v1.Bond{{Name: "bond0", Members: []string{"X1", "X2"}, DHCP: Enabled,
+			Status: &v1.Bond_BondState{Carrier: true, ActiveSlave: "eth0"}}}}
+	})
+
+	exported, err := nc.ExportConfiguration(v1.DocumentFormat_JSON, true)
+	assert.NoError(t, err)
+
+	settings, _, err := nc.ImportSettings(&v1.ImportRequest{Format: v1.DocumentFormat_JSON, Document: exported, MatchByLabel: true, ValidateOnly: true})
+	assert.NoError(t, err, "The own export should be importable")
+	assert.Len(t, settings.Interfaces, 1, "Bond members should not be exported as interfaces")
+	assert.Equal(t, "X3", settings.Interfaces[0].Label)
+	assert.Len(t, settings.Bonds.Interfaces, 1)
+	assert.Equal(t, []string{"X1", "X2"}, settings.Bonds.Interfaces[0].Members)
+	assert.Nil(t, settings.Bonds.Interfaces[0].Status)
+}
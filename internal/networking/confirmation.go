@@ -0,0 +1,221 @@
+/*
+ * Copyright © Siemens 2025. ALL RIGHTS RESERVED.
+ * Licensed under the MIT license
+ * See LICENSE file in the top-level directory
+ */
+
+package networking
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	v1 "networkservice/api/siemens_iedge_dmapi_v1"
+	"sync"
+	"time"
+
+	nm "github.com/Wifx/gonetworkmanager/v2"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// DefaultConfirmTimeout is the time to confirm a change of the management interface, if the caller gives none.
+	DefaultConfirmTimeout = 120 * time.Second
+	// checkpointGrace is added to the rollback timeout of the checkpoint, so NetworkManager only rolls back on its
+	// own if the service could not, e.g. because it was restarted.
+	checkpointGrace = 60 * time.Second
+)
+
+var (
+	// ErrConfirmationPending is returned while a change of the management interface waits for its confirmation.
+	ErrConfirmationPending = errors.New("a change of the management interface is pending confirmation")
+	// ErrNothingToConfirm is returned by ConfirmChange, if no change waits for its confirmation.
+	ErrNothingToConfirm = errors.New("no change is pending confirmation")
+)
+
+// pendingChange is an applied change of the management interface, which is rolled back unless it is confirmed.
+type pendingChange struct {
+	checkpoint nm.Checkpoint
+	timer      *time.Timer
+	desired    *v1.NetworkSettings
+	applied    *v1.NetworkSettings
+	labelMap   map[string]string
+	previous   uint64
+	revision   *v1.Revision
+}
+
+// confirmationStore holds the change waiting for its confirmation, at most one at a time.
+type confirmationStore struct {
+	mu      sync.Mutex
+	pending *pendingChange
+}
+
+func newConfirmationStore() *confirmationStore {
+	return &confirmationStore{}
+}
+
+// ConfirmationPending checks if a change of the management interface waits for its confirmation.
+func (nc *NetworkConfigurator) ConfirmationPending() bool {
+	nc.confirmation.mu.Lock()
+	defer nc.confirmation.mu.Unlock()
+	return nc.confirmation.pending != nil
+}
+
+// BeginConfirmation creates a NetworkManager checkpoint of all devices before a change of the management interface.
+// The desired state and the label map are kept for the rollback. The desired state of the change is only saved when
+// it is confirmed, see deferDesiredState. It has to be called while all apply locks are held.
+func (nc *NetworkConfigurator) BeginConfirmation(timeout time.Duration) error {
+	nc.confirmation.mu.Lock()
+	defer nc.confirmation.mu.Unlock()
+	if nc.confirmation.pending != nil {
+		return ErrConfirmationPending
+	}
+
+	checkpoint, err := nc.createCheckpoint(timeout+checkpointGrace, nm.NmCheckpointCreateFlagsNone)
+	if err != nil {
+		return err
+	}
+	change := &pendingChange{checkpoint: checkpoint, desired: nc.DesiredState()}
+	if labelMap, err := readMapFromFile(LabelMapFileName); err == nil {
+		change.labelMap = labelMap
+	}
+	if revisions := nc.ListRevisions().Revisions; len(revisions) > 0 {
+		change.previous = revisions[len(revisions)-1].Number
+	}
+	nc.confirmation.pending = change
+	return nil
+}
+
+// AwaitConfirmation starts the timeout of the applied change. If it is not confirmed in time, the checkpoint is
+// rolled back and the desired state and label map of the time before the change are restored.
+func (nc *NetworkConfigurator) AwaitConfirmation(revision *v1.Revision, timeout time.Duration) {
+	nc.confirmation.mu.Lock()
+	defer nc.confirmation.mu.Unlock()
+	change := nc.confirmation.pending
+	if change == nil {
+		return
+	}
+	change.revision = revision
+	change.timer = time.AfterFunc(timeout, func() { nc.rollbackUnconfirmed(change) })
+	log.Printf("revision %d has to be confirmed within %v", revision.GetNumber(), timeout)
+}
+
+// deferDesiredState keeps the desired state, which results from the change waiting for its confirmation, until
+// ConfirmChange saves it. If the service is restarted meanwhile, NetworkManager rolls back the checkpoint and the
+// reconciler must not apply the unconfirmed change again. It returns a copy of the kept desired state, or nil if no
+// change is being applied for confirmation.
+func (nc *NetworkConfigurator) deferDesiredState(newSettings *v1.NetworkSettings, replace bool) *v1.NetworkSettings {
+	if nc.confirmation == nil {
+		return nil
+	}
+	nc.confirmation.mu.Lock()
+	defer nc.confirmation.mu.Unlock()
+	change := nc.confirmation.pending
+	if change == nil || change.revision != nil {
+		return nil
+	}
+
+	current := change.desired
+	if replace {
+		current = nil
+	}
+	change.applied = mergeDesiredState(current, newSettings)
+	return proto.Clone(change.applied).(*v1.NetworkSettings)
+}
+
+// CancelConfirmation rolls back the checkpoint of a change, which could not be applied.
+// It has to be called while the apply locks are held.
+func (nc *NetworkConfigurator) CancelConfirmation() {
+	nc.confirmation.mu.Lock()
+	change := nc.confirmation.pending
+	nc.confirmation.pending = nil
+	nc.confirmation.mu.Unlock()
+
+	if change != nil {
+		nc.rollbackCheckpoint(change.checkpoint)
+	}
+}
+
+// ConfirmChange keeps the pending change of the management interface, saves its desired state and returns its revision.
+func (nc *NetworkConfigurator) ConfirmChange() (*v1.Revision, error) {
+	nc.confirmation.mu.Lock()
+	defer nc.confirmation.mu.Unlock()
+	change := nc.confirmation.pending
+	if change == nil || change.timer == nil {
+		return nil, ErrNothingToConfirm
+	}
+
+	change.timer.Stop()
+	nc.confirmation.pending = nil
+	if change.applied != nil {
+		if _, err := nc.ResetDesiredState(change.applied); err != nil {
+			log.Println(err)
+		}
+	}
+	if err := nc.gnm.CheckpointDestroy(change.checkpoint); err != nil {
+		log.Println("checkpoint could not be destroyed: ", err)
+	}
+	log.Printf("revision %d confirmed", change.revision.GetNumber())
+	return change.revision, nil
+}
+
+// rollbackUnconfirmed restores the state before the change, unless it was confirmed meanwhile.
+func (nc *NetworkConfigurator) rollbackUnconfirmed(change *pendingChange) {
+	unlock := nc.LockAll()
+	defer unlock()
+
+	nc.confirmation.mu.Lock()
+	if nc.confirmation.pending != change {
+		nc.confirmation.mu.Unlock()
+		return
+	}
+	nc.confirmation.pending = nil
+	nc.confirmation.mu.Unlock()
+
+	number := change.revision.GetNumber()
+	log.Printf("revision %d was not confirmed in time, rolling back", number)
+	nc.rollbackCheckpoint(change.checkpoint)
+
+	if change.labelMap != nil {
+		if err := WriteMapToFile(change.labelMap, LabelMapFileName); err != nil {
+			log.Println("label map could not be restored: ", err)
+		}
+	}
+	restored := &v1.NetworkSettings{}
+	if change.desired != nil {
+		restored = proto.Clone(change.desired).(*v1.NetworkSettings)
+	}
+	restored.Options = &v1.ApplyOptions{
+		ForeignProfiles: restored.GetOptions().GetForeignProfiles(),
+		Comment:         fmt.Sprintf("revision %d was not confirmed", number),
+	}
+	if _, err := nc.CommitDesiredState(restored, true, "confirmation timeout", change.previous); err != nil {
+		log.Println(err)
+	}
+}
+
+// createCheckpoint creates a checkpoint of all devices. NetworkManager rolls back to it on its own after the timeout.
+// Connection profiles added after the checkpoint are deleted by a rollback.
+func (nc *NetworkConfigurator) createCheckpoint(rollbackTimeout time.Duration, flags nm.NmCheckpointCreateFlags) (nm.Checkpoint, error) {
+	flags |= nm.NmCheckpointCreateFlagsDeleteNewConnections
+	checkpoint, err := nc.gnm.CheckpointCreate(nil, uint32(rollbackTimeout/time.Second), uint32(flags))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create checkpoint: %w", err)
+	}
+	return checkpoint, nil
+}
+
+// rollbackCheckpoint restores the devices to the checkpoint.
+func (nc *NetworkConfigurator) rollbackCheckpoint(checkpoint nm.Checkpoint) {
+	defer nc.InvalidateSnapshot()
+	results, err := nc.gnm.CheckpointRollback(checkpoint)
+	if err != nil {
+		log.Println("checkpoint could not be rolled back: ", err)
+		return
+	}
+	for device, result := range results {
+		if result != nm.NmRollbackResultOk {
+			log.Printf("device %v could not be rolled back: %v", device, result)
+		}
+	}
+}
@@ -0,0 +1,131 @@
+/*
+ * Copyright © Siemens 2025. ALL RIGHTS RESERVED.
+ * Licensed under the MIT license
+ * See LICENSE file in the top-level directory
+ */
+
+package networking
+
+import (
+	v1 "networkservice/api/siemens_iedge_dmapi_v1"
+	mockgnm "networkservice/internal/networking/mocks/gonetworkmanager"
+	"testing"
+	"time"
+
+	nm "github.com/Wifx/gonetworkmanager/v2"
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/godbus/dbus/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/protobuf/proto"
+)
+
+// mockCheckpoint is a checkpoint, which is only passed back to the NetworkManager mock.
+type mockCheckpoint struct {
+	nm.Checkpoint
+}
+
+func getMockConfirmationConfigurator() (*NetworkConfigurator, *gomonkey.Patches) {
+	mockNetworkManager := &mockgnm.MockNetworkManager{}
+	mockNetworkManager.On("CheckpointCreate", mock.Anything, uint32(3660), mock.Anything).Return(mockCheckpoint{}, nil)
+	mockNetworkManager.On("CheckpointDestroy", mock.Anything).Return(nil)
+	mockNetworkManager.On("CheckpointRollback", mock.Anything).Return(map[dbus.ObjectPath]nm.NmRollbackResult{}, nil)
+	nc := &NetworkConfigurator{gnm: mockNetworkManager, locks: newApplyLocks(), confirmation: newConfirmationStore()}
+
+	patches := gomonkey.ApplyFunc(readMapFromFile, func(_ string) (map[string]string, error) {
+		return map[string]string{"X1": "ENS18"}, nil
+	})
+	patches.ApplyMethod(nc, "DesiredState", func(_ *NetworkConfigurator) *v1.NetworkSettings {
+		return &v1.NetworkSettings{Interfaces: []*v1.Interface{{Label: "X1", DHCP: Enabled}}}
+	})
+	patches.ApplyMethod(nc, "ListRevisions", func(_ *NetworkConfigurator) *v1.RevisionList {
+		return &v1.RevisionList{Revisions: []*v1.Revision{{Number: 4}}}
+	})
+	return nc, patches
+}
+
+func Test_Confirmation_ConfirmKeepsChange(t *testing.T) {
+	nc, patches := getMockConfirmationConfigurator()
+	defer patches.Reset()
+
+	assert.NoError(t, nc.BeginConfirmation(time.Hour))
+	assert.True(t, nc.ConfirmationPending())
+	assert.ErrorIs(t, nc.BeginConfirmation(time.Hour), ErrConfirmationPending)
+	_, err := nc.ConfirmChange()
+	assert.ErrorIs(t, err, ErrNothingToConfirm, "A change can only be confirmed after it was applied")
+
+	nc.AwaitConfirmation(&v1.Revision{Number: 5}, time.Hour)
+	revision, err := nc.ConfirmChange()
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(5), revision.Number)
+	assert.False(t, nc.ConfirmationPending())
+
+	_, err = nc.ConfirmChange()
+	assert.ErrorIs(t, err, ErrNothingToConfirm)
+}
+
+func Test_Confirmation_RollsBackUnconfirmedChange(t *testing.T) {
+	nc, patches := getMockConfirmationConfigurator()
+	defer patches.Reset()
+
+	var writtenLabelMap map[string]string
+	var resetState *v1.NetworkSettings
+	recorded := make(chan uint64, 1)
+	patches.ApplyFunc(WriteMapToFile, func(labelMap map[string]string, _ string) error {
+		writtenLabelMap = labelMap
+		return nil
+	})
+	patches.ApplyMethod(nc, "CommitDesiredState", func(_ *NetworkConfigurator, settings *v1.NetworkSettings, replace bool, caller string, rollbackOf uint64) (*v1.Revision, error) {
+		assert.True(t, replace, "The desired state before the change should replace the current one")
+		assert.Equal(t, "revision 5 was not confirmed", settings.Options.Comment)
+		resetState = settings
+		recorded <- rollbackOf
+		return &v1.Revision{Number: 6}, nil
+	})
+
+	assert.NoError(t, nc.BeginConfirmation(time.Hour))
+	nc.AwaitConfirmation(&v1.Revision{Number: 5}, 10*time.Millisecond)
+
+	select {
+	case rollbackOf := <-recorded:
+		assert.Equal(t, uint64(4), rollbackOf, "The revision before the change should be restored")
+	case <-time.After(5 * time.Second):
+		t.Fatal("unconfirmed change was not rolled back")
+	}
+	assert.False(t, nc.ConfirmationPending())
+	assert.Equal(t, map[string]string{"X1": "ENS18"}, writtenLabelMap)
+	assert.Equal(t, Enabled, resetState.Interfaces[0].DHCP)
+
+	_, err := nc.ConfirmChange()
+	assert.ErrorIs(t, err, ErrNothingToConfirm, "A rolled back change can not be confirmed")
+}
+
+func Test_Confirmation_SavesDesiredStateWhenConfirmed(t *testing.T) {
+	nc, patches := getMockConfirmationConfigurator()
+	defer patches.Reset()
+	nc.reconciler = newReconciler()
+	nc.reconciler.desired = &v1.NetworkSettings{Interfaces: []*v1.Interface{{Label: "X1", DHCP: Enabled}}}
+	nc.reconciler.loaded = true
+	nc.revisions = newRevisionStore()
+	nc.revisions.history = &v1.RevisionList{}
+	var written []string
+	patches.ApplyFunc(writeProtoFile, func(_ proto.Message, fileName string) error {
+		written = append(written, fileName)
+		return nil
+	})
+
+	assert.NoError(t, nc.BeginConfirmation(time.Hour))
+	applied := &v1.NetworkSettings{Interfaces: []*v1.Interface{{Label: "X2", DHCP: Disabled, Static: getMockInterfaceStaticConf()}}}
+	revision, err := nc.CommitDesiredState(applied, false, "operator", 0)
+	assert.NoError(t, err)
+	assert.Len(t, revision.Settings.Interfaces, 2, "The revision should hold the desired state of the change")
+	assert.Equal(t, []string{RevisionsFileName}, written, "The desired state should not be saved before the confirmation")
+	assert.Len(t, nc.reconciler.desired.Interfaces, 1)
+
+	nc.AwaitConfirmation(revision, time.Hour)
+	_, err = nc.ConfirmChange()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{RevisionsFileName, DesiredStateFileName}, written)
+	assert.True(t, proto.Equal(revision.Settings.Interfaces[1], nc.reconciler.desired.Interfaces[1]),
+		"The confirmed change should be the desired state")
+}
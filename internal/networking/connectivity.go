@@ -0,0 +1,301 @@
+/*
+ * Copyright © Siemens 2025. ALL RIGHTS RESERVED.
+ * Licensed under the MIT license
+ * See LICENSE file in the top-level directory
+ */
+
+package networking
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	v1 "networkservice/api/siemens_iedge_dmapi_v1"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	nm "github.com/Wifx/gonetworkmanager/v2"
+	"golang.org/x/net/icmp"
+	"golang.org/x/net/ipv4"
+)
+
+const (
+	// defaultCheckTimeout is the time until a connectivity check has to succeed, if the caller gives none.
+	defaultCheckTimeout = 10 * time.Second
+	// checkInterval is the time between two attempts of a connectivity check.
+	checkInterval = 500 * time.Millisecond
+	// ARPTableFileName lists the neighbours of the device, a complete entry means the neighbour answered an ARP request.
+	ARPTableFileName = "/proc/net/arp"
+	// arpFlagComplete marks complete entries of the ARP table.
+	arpFlagComplete = 0x2
+)
+
+// ErrCheckFailed is returned if a connectivity check failed after an apply. The previous settings are restored then.
+var ErrCheckFailed = errors.New("connectivity check failed")
+
+// gatewayMask selects the fields needed to find the current gateway.
+var gatewayMask = &InterfaceMask{paths: maskTree{FieldMacAddress: {}, FieldStatic: {}, FieldGatewayInterface: {}}}
+
+// ApplyChecked applies the settings like Apply and runs the connectivity checks of their options afterwards. The
+// devices are rolled back to a checkpoint taken before, if the settings can not be applied or a check fails.
+// The checkpoint covers all devices, so settings with checks have to be applied under the exclusive apply lock.
+func (nc *NetworkConfigurator) ApplyChecked(newSettings *v1.NetworkSettings) error {
+	checks := newSettings.GetOptions().GetChecks()
+	if len(checks) == 0 {
+		return nc.Apply(newSettings)
+	}
+
+	rollbackTimeout := checkpointGrace
+	for _, check := range checks {
+		rollbackTimeout += checkTimeout(check)
+	}
+	// A change of the management interface may wait for its confirmation with an older checkpoint.
+	checkpoint, err := nc.createCheckpoint(rollbackTimeout, nm.NmCheckpointCreateFlagsAllowOverlapping)
+	if err != nil {
+		return err
+	}
+
+	if err = nc.Apply(newSettings); err == nil {
+		err = nc.RunChecks(newSettings, checks)
+	}
+	if err != nil {
+		log.Println("restoring the checkpoint taken before the apply: ", err)
+		nc.rollbackCheckpoint(checkpoint)
+		return err
+	}
+	if err := nc.gnm.CheckpointDestroy(checkpoint); err != nil {
+		log.Println("checkpoint could not be destroyed: ", err)
+	}
+	return nil
+}
+
+// RunChecks runs the connectivity checks in the given order and returns the first failed one.
+func (nc *NetworkConfigurator) RunChecks(newSettings *v1.NetworkSettings, checks []*v1.ConnectivityCheck) error {
+	for _, check := range checks {
+		var err error
+		switch check.Type {
+		case v1.ConnectivityCheck_GATEWAY:
+			err = poll(checkTimeout(check), func(_ time.Time) error { return nc.checkGateway(check.Target) })
+		case v1.ConnectivityCheck_DNS:
+			err = poll(checkTimeout(check), func(deadline time.Time) error { return checkDNS(check.Target, deadline) })
+		case v1.ConnectivityCheck_TCP:
+			err = poll(checkTimeout(check), func(deadline time.Time) error { return checkTCP(check.Target, deadline) })
+		case v1.ConnectivityCheck_DHCP_LEASE:
+			err = poll(checkTimeout(check), func(_ time.Time) error { return nc.checkDHCPLease(newSettings, check.Target) })
+		default:
+			err = fmt.Errorf("unknown check type %v", check.Type)
+		}
+		if err != nil {
+			return fmt.Errorf("%w: %v %v: %v", ErrCheckFailed, check.Type, check.Target, err)
+		}
+		log.Printf("connectivity check %v %v succeeded", check.Type, check.Target)
+	}
+	return nil
+}
+
+func checkTimeout(check *v1.ConnectivityCheck) time.Duration {
+	if check.Timeout == 0 {
+		return defaultCheckTimeout
+	}
+	return time.Duration(check.Timeout) * time.Second
+}
+
+// poll runs the probe until it succeeds or the timeout is over, it returns the error of the last attempt.
+func poll(timeout time.Duration, probe func(deadline time.Time) error) error {
+	deadline := time.Now().Add(timeout)
+	for {
+		err := probe(deadline)
+		if err == nil {
+			return nil
+		}
+		if time.Now().Add(checkInterval).After(deadline) {
+			return err
+		}
+		time.Sleep(checkInterval)
+	}
+}
+
+// checkGateway checks if the gateway answers ICMP echo requests or, if they are blocked, ARP requests.
+func (nc *NetworkConfigurator) checkGateway(target string) error {
+	address := target
+	if address == "" {
+		address = nc.currentGateway()
+		if address == "" {
+			return errors.New("no gateway is configured")
+		}
+	}
+	gateway := net.ParseIP(address).To4()
+	if gateway == nil {
+		return fmt.Errorf("%v is not an IPv4 address", address)
+	}
+
+	pingErr := pingICMP(gateway, time.Second)
+	if pingErr == nil || arpComplete(ARPTableFileName, gateway) {
+		return nil
+	}
+	// A datagram resolves the gateway by ARP, if ICMP sockets can not be opened. It is checked by the next attempt.
+	if conn, err := net.Dial("udp4", net.JoinHostPort(gateway.String(), "9")); err == nil {
+		_, _ = conn.Write([]byte{0})
+		conn.Close()
+	}
+	return fmt.Errorf("gateway %v does not answer: %v", gateway, pingErr)
+}
+
+// currentGateway returns the gateway of the gateway interface, read from NetworkManager after the apply.
+func (nc *NetworkConfigurator) currentGateway() string {
+	for _, element := range nc.GetEthernetInterfaces(gatewayMask) {
+		if element.GatewayInterface {
+			return element.GetStatic().GetGateway()
+		}
+	}
+	return ""
+}
+
+// pingICMP sends an ICMP echo request to the address and waits for the reply. Unprivileged ICMP sockets are used,
+// if raw sockets can not be opened.
+func pingICMP(address net.IP, timeout time.Duration) error {
+	privileged := true
+	conn, err := icmp.ListenPacket("ip4:icmp", "0.0.0.0")
+	if err != nil {
+		privileged = false
+		if conn, err = icmp.ListenPacket("udp4", "0.0.0.0"); err != nil {
+			return err
+		}
+	}
+	defer conn.Close()
+
+	id := os.Getpid() & 0xffff
+	request := icmp.Message{Type: ipv4.ICMPTypeEcho, Body: &icmp.Echo{ID: id, Seq: 1, Data: []byte(OwnerServiceName)}}
+	buffer, err := request.Marshal(nil)
+	if err != nil {
+		return err
+	}
+	var destination net.Addr = &net.IPAddr{IP: address}
+	if !privileged {
+		destination = &net.UDPAddr{IP: address}
+	}
+	if err = conn.SetDeadline(time.Now().Add(timeout)); err != nil {
+		return err
+	}
+	if _, err = conn.WriteTo(buffer, destination); err != nil {
+		return err
+	}
+
+	reply := make([]byte, 1500)
+	for {
+		n, source, err := conn.ReadFrom(reply)
+		if err != nil {
+			return err
+		}
+		message, err := icmp.ParseMessage(ipv4.ICMPTypeEcho.Protocol(), reply[:n])
+		if err != nil || message.Type != ipv4.ICMPTypeEchoReply {
+			continue
+		}
+		// The kernel sets the ID of unprivileged sockets and only passes their own replies.
+		if echo, ok := message.Body.(*icmp.Echo); ok && privileged && echo.ID != id {
+			continue
+		}
+		if sourceIP(source).Equal(address) {
+			return nil
+		}
+	}
+}
+
+func sourceIP(source net.Addr) net.IP {
+	switch addr := source.(type) {
+	case *net.IPAddr:
+		return addr.IP
+	case *net.UDPAddr:
+		return addr.IP
+	}
+	return nil
+}
+
+// arpComplete checks if the ARP table in the given file has a complete entry for the address.
+func arpComplete(fileName string, address net.IP) bool {
+	file, err := os.Open(fileName)
+	if err != nil {
+		return false
+	}
+	defer file.Close()
+
+	// IP address, HW type, Flags, HW address, Mask, Device
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 3 || !net.ParseIP(fields[0]).Equal(address) {
+			continue
+		}
+		flags, err := strconv.ParseUint(fields[2], 0, 32)
+		return err == nil && flags&arpFlagComplete != 0
+	}
+	return false
+}
+
+func checkDNS(name string, deadline time.Time) error {
+	ctx, cancel := context.WithDeadline(context.Background(), deadline)
+	defer cancel()
+	addresses, err := net.DefaultResolver.LookupHost(ctx, name)
+	if err == nil && len(addresses) == 0 {
+		err = fmt.Errorf("%v has no addresses", name)
+	}
+	return err
+}
+
+func checkTCP(endpoint string, deadline time.Time) error {
+	dialer := net.Dialer{Deadline: deadline}
+	conn, err := dialer.Dial("tcp", endpoint)
+	if err != nil {
+		return err
+	}
+	return conn.Close()
+}
+
+// checkDHCPLease checks if the interface of the target, or every interface of the settings with DHCP enabled,
+// obtained a DHCP lease.
+func (nc *NetworkConfigurator) checkDHCPLease(newSettings *v1.NetworkSettings, target string) error {
+	var targets []string
+	if target != "" {
+		targets = append(targets, target)
+	} else {
+		for _, element := range newSettings.Interfaces {
+			if element.DHCP == Enabled {
+				targets = append(targets, interfaceIdentifier(element))
+			}
+		}
+	}
+	if len(targets) == 0 {
+		return errors.New("no interface with DHCP enabled")
+	}
+
+	for _, identifier := range targets {
+		var device nm.DeviceWired
+		if _, err := net.ParseMAC(identifier); err == nil {
+			device = nc.getDeviceWithMac(identifier)
+		} else {
+			device = nc.getDeviceWithLabel(identifier)
+		}
+		if device == nil {
+			return fmt.Errorf("device does not exist: %v", identifier)
+		}
+		if !hasDHCPLease(device) {
+			return fmt.Errorf("%v has no DHCP lease", identifier)
+		}
+	}
+	return nil
+}
+
+// hasDHCPLease checks if the device obtained an address from a DHCP server.
+func hasDHCPLease(device nm.DeviceWired) bool {
+	config, err := device.GetPropertyDHCP4Config()
+	if err != nil || config == nil {
+		return false
+	}
+	options, err := config.GetPropertyOptions()
+	return err == nil && options[IPAddressKey] != nil
+}
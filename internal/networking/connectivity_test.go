@@ -0,0 +1,236 @@
+/*
+ * Copyright © Siemens 2025. ALL RIGHTS RESERVED.
+ * Licensed under the MIT license
+ * See LICENSE file in the top-level directory
+ */
+
+package networking
+
+import (
+	"errors"
+	"net"
+	v1 "networkservice/api/siemens_iedge_dmapi_v1"
+	mockgnm "networkservice/internal/networking/mocks/gonetworkmanager"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	nm "github.com/Wifx/gonetworkmanager/v2"
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"golang.org/x/net/icmp"
+	"golang.org/x/sys/unix"
+)
+
+// mockDHCP4Config is a DHCP configuration with the given options.
+type mockDHCP4Config struct {
+	options nm.DHCP4Options
+}
+
+func (c mockDHCP4Config) GetPropertyOptions() (nm.DHCP4Options, error) { return c.options, nil }
+func (c mockDHCP4Config) MarshalJSON() ([]byte, error)                 { return nil, nil }
+
+// closedPort returns a loopback endpoint, no one listens on.
+func closedPort(t *testing.T) string {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	endpoint := listener.Addr().String()
+	listener.Close()
+	return endpoint
+}
+
+func Test_RunChecks_Loopback(t *testing.T) {
+	nc := &NetworkConfigurator{}
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	defer listener.Close()
+
+	err = nc.RunChecks(&v1.NetworkSettings{}, []*v1.ConnectivityCheck{
+		{Type: v1.ConnectivityCheck_TCP, Target: listener.Addr().String(), Timeout: 1},
+		{Type: v1.ConnectivityCheck_DNS, Target: "localhost", Timeout: 1},
+	})
+	assert.NoError(t, err)
+
+	err = nc.RunChecks(&v1.NetworkSettings{}, []*v1.ConnectivityCheck{
+		{Type: v1.ConnectivityCheck_TCP, Target: listener.Addr().String(), Timeout: 1},
+		{Type: v1.ConnectivityCheck_TCP, Target: closedPort(t), Timeout: 1},
+	})
+	assert.True(t, errors.Is(err, ErrCheckFailed))
+	assert.ErrorContains(t, err, "TCP 127.0.0.1", "The failed check should be reported")
+}
+
+// bringUpLoopback sets the loopback interface of the network namespace of the calling thread up.
+func bringUpLoopback() error {
+	fd, err := unix.Socket(unix.AF_INET, unix.SOCK_DGRAM, 0)
+	if err != nil {
+		return err
+	}
+	defer unix.Close(fd)
+
+	ifreq, err := unix.NewIfreq("lo")
+	if err != nil {
+		return err
+	}
+	ifreq.SetUint16(unix.IFF_UP)
+	return unix.IoctlIfreq(fd, unix.SIOCSIFFLAGS, ifreq)
+}
+
+// namespaceCheck is the result of a connectivity check run in a new network namespace.
+type namespaceCheck struct {
+	endpoint string
+	err      error
+}
+
+func Test_RunChecks_NetworkNamespace(t *testing.T) {
+	result := make(chan namespaceCheck, 1)
+	done := make(chan struct{})
+	go func() {
+		// The thread stays in the new namespace and is terminated with the goroutine.
+		runtime.LockOSThread()
+		if err := unix.Unshare(unix.CLONE_NEWNET); err != nil {
+			result <- namespaceCheck{err: err}
+			return
+		}
+		if err := bringUpLoopback(); err != nil {
+			result <- namespaceCheck{err: err}
+			return
+		}
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		if err != nil {
+			result <- namespaceCheck{err: err}
+			return
+		}
+		defer listener.Close()
+
+		nc := &NetworkConfigurator{}
+		endpoint := listener.Addr().String()
+		result <- namespaceCheck{endpoint: endpoint,
+			err: nc.RunChecks(&v1.NetworkSettings{}, []*v1.ConnectivityCheck{{Type: v1.ConnectivityCheck_TCP, Target: endpoint, Timeout: 1}})}
+		// The listener is kept until the endpoint was checked from outside of the namespace.
+		<-done
+	}()
+	defer close(done)
+
+	inside := <-result
+	if errors.Is(inside.err, unix.EPERM) {
+		t.Skip("network namespaces can not be created: ", inside.err)
+	}
+	assert.NoError(t, inside.err, "The endpoint should be reachable inside of its namespace")
+
+	nc := &NetworkConfigurator{}
+	err := nc.RunChecks(&v1.NetworkSettings{}, []*v1.ConnectivityCheck{{Type: v1.ConnectivityCheck_TCP, Target: inside.endpoint, Timeout: 1}})
+	assert.True(t, errors.Is(err, ErrCheckFailed), "The endpoint should not be reachable from outside of its namespace")
+}
+
+func Test_checkGateway(t *testing.T) {
+	nc := &NetworkConfigurator{}
+	patches := gomonkey.ApplyMethod(nc, "GetEthernetInterfaces", func(_ *NetworkConfigurator, _ *InterfaceMask) []*v1.Interface {
+		return []*v1.Interface{
+			{MacAddress: "00:11:22:33:44:66", Static: &v1.Interface_StaticConf{Gateway: "10.0.0.1"}},
+			{MacAddress: "00:11:22:33:44:55", GatewayInterface: true, Static: &v1.Interface_StaticConf{Gateway: "127.0.0.1"}},
+		}
+	})
+	var pinged net.IP
+	patches.ApplyFunc(pingICMP, func(address net.IP, _ time.Duration) error {
+		pinged = address
+		return nil
+	})
+	defer patches.Reset()
+
+	assert.NoError(t, nc.checkGateway(""))
+	assert.Equal(t, "127.0.0.1", pinged.String(), "The gateway of the gateway interface should be checked")
+	assert.Error(t, nc.checkGateway("fd00::1"))
+}
+
+func Test_arpComplete(t *testing.T) {
+	// Stand-in for /proc/net/arp
+	fileName := filepath.Join(t.TempDir(), "arp")
+	assert.NoError(t, os.WriteFile(fileName, []byte(
+		"IP address       HW type     Flags       HW address            Mask     Device\n"+
+			"192.168.1.1      0x1         0x2         00:11:22:33:44:55     *        ens18\n"+
+			"192.168.1.2      0x1         0x0         00:00:00:00:00:00     *        ens18\n"), 0644))
+
+	assert.True(t, arpComplete(fileName, net.ParseIP("192.168.1.1")))
+	assert.False(t, arpComplete(fileName, net.ParseIP("192.168.1.2")), "Incomplete entries did not get an ARP reply")
+	assert.False(t, arpComplete(fileName, net.ParseIP("192.168.1.3")))
+	assert.False(t, arpComplete(filepath.Join(t.TempDir(), "missing"), net.ParseIP("192.168.1.1")))
+}
+
+func Test_pingICMP_Loopback(t *testing.T) {
+	for _, network := range []string{"ip4:icmp", "udp4"} {
+		conn, err := icmp.ListenPacket(network, "0.0.0.0")
+		if err == nil {
+			conn.Close()
+			assert.NoError(t, pingICMP(net.ParseIP("127.0.0.1").To4(), time.Second))
+			return
+		}
+	}
+	t.Skip("ICMP sockets can not be opened")
+}
+
+func Test_checkDHCPLease(t *testing.T) {
+	nc := &NetworkConfigurator{}
+	leased := new(mockgnm.MockDeviceWired)
+	leased.On("GetPropertyDHCP4Config").Return(mockDHCP4Config{nm.DHCP4Options{"ip_address": "192.168.1.20"}}, nil)
+	waiting := new(mockgnm.MockDeviceWired)
+	waiting.On("GetPropertyDHCP4Config").Return(mockDHCP4Config{nm.DHCP4Options{}}, nil)
+	patches := gomonkey.ApplyPrivateMethod(nc, "getDeviceWithLabel", func(_ *NetworkConfigurator, label string) nm.DeviceWired {
+		if label == "X1" {
+			return leased
+		}
+		return waiting
+	})
+	defer patches.Reset()
+
+	settings := &v1.NetworkSettings{Interfaces: []*v1.Interface{{Label: "X1", DHCP: Enabled}, {Label: "X2", DHCP: Disabled}}}
+	assert.NoError(t, nc.checkDHCPLease(settings, ""))
+	assert.ErrorContains(t, nc.checkDHCPLease(settings, "X2"), "X2 has no DHCP lease")
+	assert.Error(t, nc.checkDHCPLease(&v1.NetworkSettings{}, ""), "A check without interface should fail")
+}
+
+func Test_ApplyChecked_RollsBackFailedChecks(t *testing.T) {
+	mockNetworkManager := &mockgnm.MockNetworkManager{}
+	mockNetworkManager.On("CheckpointCreate", mock.Anything, uint32(61), mock.Anything).Return(mockCheckpoint{}, nil)
+	nc := &NetworkConfigurator{gnm: mockNetworkManager}
+
+	applied, rolledBack := false, false
+	patches := gomonkey.ApplyMethod(nc, "Apply", func(_ *NetworkConfigurator, _ *v1.NetworkSettings) error {
+		applied = true
+		return nil
+	})
+	patches.ApplyPrivateMethod(nc, "rollbackCheckpoint", func(_ *NetworkConfigurator, _ nm.Checkpoint) {
+		rolledBack = true
+	})
+	defer patches.Reset()
+
+	settings := &v1.NetworkSettings{
+		Interfaces: []*v1.Interface{{Label: "X1", DHCP: Enabled}},
+		Options:    &v1.ApplyOptions{Checks: []*v1.ConnectivityCheck{{Type: v1.ConnectivityCheck_TCP, Target: closedPort(t), Timeout: 1}}},
+	}
+	err := nc.ApplyChecked(settings)
+
+	assert.True(t, errors.Is(err, ErrCheckFailed))
+	assert.True(t, applied)
+	assert.True(t, rolledBack, "The checkpoint should be rolled back after a failed check")
+}
+
+func Test_verifyChecks(t *testing.T) {
+	result := &verifyResult{retVal: true}
+	verifyChecks(&v1.NetworkSettings{Options: &v1.ApplyOptions{Checks: []*v1.ConnectivityCheck{
+		{Type: v1.ConnectivityCheck_GATEWAY},
+		{Type: v1.ConnectivityCheck_TCP, Target: "192.168.1.20:443"},
+		{Type: v1.ConnectivityCheck_DHCP_LEASE},
+	}}}, result)
+	assert.True(t, result.retVal)
+
+	verifyChecks(&v1.NetworkSettings{Options: &v1.ApplyOptions{Checks: []*v1.ConnectivityCheck{
+		{Type: v1.ConnectivityCheck_TCP, Target: "192.168.1.20"},
+		{Type: v1.ConnectivityCheck_DNS},
+		{Type: v1.ConnectivityCheck_GATEWAY, Target: "gateway"},
+	}}}, result)
+	assert.False(t, result.retVal)
+	assert.Contains(t, result.builder.String(), "wrong TCP check target 192.168.1.20")
+}
@@ -18,6 +18,16 @@ const (
 	DNSSearchKey = "dns-search"
 	// DNSIgnoreAuto
 	DNSIgnoreAutoKey = "ignore-auto-dns"
+	// DNSPriorityKey
+	DNSPriorityKey = "dns-priority"
+	// DNSOptionsKey
+	DNSOptionsKey = "dns-options"
+	// DNSOptionTimeout is the resolver option of the seconds to wait for an answer, e.g: "timeout:2"
+	DNSOptionTimeout = "timeout"
+	// DNSOptionAttempts is the resolver option of the queries sent to each server, e.g: "attempts:3"
+	DNSOptionAttempts = "attempts"
+	// DNSOptionRotate is the resolver option to query the servers in turn
+	DNSOptionRotate = "rotate"
 	// Yes
 	Yes = 1
 	// Enabled
@@ -32,16 +42,74 @@ const (
 	DHCP = "dhcp"
 	// Static
 	Static = "static"
+	// Fallback uses DHCP and falls back to a static or link-local address if no DHCP server answers
+	Fallback = "fallback"
+	// LinkLocal
+	LinkLocal = "link-local"
+	// DHCPTimeoutKey
+	DHCPTimeoutKey = "dhcp-timeout"
+	// DHCPHostnameKey
+	DHCPHostnameKey = "dhcp-hostname"
+	// DHCPSendHostnameKey
+	DHCPSendHostnameKey = "dhcp-send-hostname"
+	// DHCPClientIDKey
+	DHCPClientIDKey = "dhcp-client-id"
+	// DHCPVendorClassKey
+	DHCPVendorClassKey = "dhcp-vendor-class-identifier"
+	// MayFailKey
+	MayFailKey = "may-fail"
+	// MTUKey
+	MTUKey = "mtu"
+	// SpeedKey
+	SpeedKey = "speed"
+	// DuplexKey
+	DuplexKey = "duplex"
+	// AutoNegotiateKey
+	AutoNegotiateKey = "auto-negotiate"
+	// ClonedMACAddressKey is the D-Bus name of cloned-mac-address, which also takes 'preserve', 'permanent', 'random' and 'stable'
+	ClonedMACAddressKey = "assigned-mac-address"
+	// WakeOnLanKey
+	WakeOnLanKey = "wake-on-lan"
+	// Full
+	Full = "full"
+	// Half
+	Half = "half"
+	// DefaultDHCPTimeout is the time in seconds to wait for a DHCP lease in fallback mode, if the caller gives none
+	DefaultDHCPTimeout = 30
 	// MethodKey
 	MethodKey = "method"
 	// RouteMetricKey
 	RouteMetricKey = "route-metric"
 	// EthernetType
 	EthernetType = "802-3-ethernet"
+	// VlanType is the connection type and the setting name of VLAN profiles
+	VlanType = "vlan"
+	// VlanParentKey
+	VlanParentKey = "parent"
+	// VlanIDKey
+	VlanIDKey = "id"
+	// BondType is the connection type and the setting name of bond profiles
+	BondType = "bond"
+	// BondOptionsKey
+	BondOptionsKey = "options"
+	// BondModeKey
+	BondModeKey = "mode"
+	// BondMiiMonKey
+	BondMiiMonKey = "miimon"
+	// BondPrimaryKey
+	BondPrimaryKey = "primary"
+	// ActiveBackup is the default bond mode, one member carries the traffic and another one takes over without link
+	ActiveBackup = "active-backup"
+	// MasterKey holds the bond of a member profile
+	MasterKey = "master"
+	// SlaveTypeKey
+	SlaveTypeKey = "slave-type"
 	// ConnectionKey
 	ConnectionKey = "connection"
 	// IPV4Key
 	IPV4Key = "ipv4"
+	// IPV6Key
+	IPV6Key = "ipv6"
 	// IDKey
 	IDKey = "id"
 	// TypeKey
@@ -64,10 +132,60 @@ const (
 	SubnetMaskKey = "subnet_mask"
 	// DHCPServerIdentifierKey
 	DHCPServerIdentifierKey = "dhcp_server_identifier"
+	// DHCPLeaseTimeKey
+	DHCPLeaseTimeKey = "dhcp_lease_time"
+	// DHCPExpiryKey
+	DHCPExpiryKey = "expiry"
 	// AddressDataKey
 	AddressDataKey = "address-data"
+	// RouteDataKey
+	RouteDataKey = "route-data"
+	// RouteDestinationKey
+	RouteDestinationKey = "dest"
+	// RouteNextHopKey
+	RouteNextHopKey = "next-hop"
+	// RouteMetricDataKey
+	RouteMetricDataKey = "metric"
+	// AutoconnectKey
+	AutoconnectKey = "autoconnect"
+	// UserKey
+	UserKey = "user"
+	// UserDataKey
+	UserDataKey = "data"
+	// OwnerTagKey is the user data key which marks profiles created by this service
+	OwnerTagKey = "org.siemens.iedge.network.owner"
+	// FallbackTagKey is the user data key which holds the fallback of a profile in fallback mode: static or link-local
+	FallbackTagKey = "org.siemens.iedge.network.fallback"
+	// FallbackAddressTagKey is the user data key which holds the static fallback address, e.g: 192.168.0.2/24
+	FallbackAddressTagKey = "org.siemens.iedge.network.fallback-address"
+	// FallbackGatewayTagKey is the user data key which holds the gateway of the static fallback address
+	FallbackGatewayTagKey = "org.siemens.iedge.network.fallback-gateway"
+	// LabelTagKey is the user data key which holds the label of a VLAN profile, e.g: X1.100
+	LabelTagKey = "org.siemens.iedge.network.label"
+	// OwnerServiceName is the owner of profiles created by this service
+	OwnerServiceName = "dm-network"
+	// OwnerForeign is the owner of profiles created by other tools
+	OwnerForeign = "foreign"
 	// LabelMapFileName
 	LabelMapFileName = "/var/network.label"
+	// DesiredStateFileName holds the settings accepted by the last applies
+	DesiredStateFileName = "/var/network.desired"
+	// RevisionsFileName holds the history of applied settings
+	RevisionsFileName = "/var/network.revisions"
+	// ProvisionedFileName holds the SHA-256 checksums of the processed provisioning files
+	ProvisionedFileName = "/var/network.provisioned"
+	// FactoryDefaultsFileName holds the factory default settings shipped by the device builder
+	FactoryDefaultsFileName = "/etc/dm-network/factory-defaults.json"
+	// FactsFileName holds the variables of the device for NetworkSettings templates, e.g. its station number
+	FactsFileName = "/etc/dm-network/facts.json"
+	// PolicyFileName holds the constraints of the device builder, which are enforced for every apply
+	PolicyFileName = "/etc/dm-network/policy.json"
+	// HostsFileName is the hosts file of the system, it holds the static host entries of this service
+	HostsFileName = "/etc/hosts"
+	// ResolvConfFileName is the resolver configuration file of the system, written by NetworkManager
+	ResolvConfFileName = "/etc/resolv.conf"
+	// SysClassNetPath holds the live values of the network interfaces, e.g: /sys/class/net/ens18/speed
+	SysClassNetPath = "/sys/class/net"
 	// Highest Possible Metric Value
 	MaxMetricValue = 255
 	// Route Destination Value For Outgoing Traffic
@@ -0,0 +1,219 @@
+/*
+ * Copyright © Siemens 2025. ALL RIGHTS RESERVED.
+ * Licensed under the MIT license
+ * See LICENSE file in the top-level directory
+ */
+
+package networking
+
+import (
+	"fmt"
+	"log"
+	v1 "networkservice/api/siemens_iedge_dmapi_v1"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// desiredKey returns the key of the interface in the desired state. Settings applied by MAC address and by label
+// are kept apart, a label may be mapped to another interface later.
+func desiredKey(element *v1.Interface) string {
+	if element.MacAddress != "" {
+		return strings.ToUpper(element.MacAddress)
+	}
+	return "LABEL:" + strings.ToUpper(element.Label)
+}
+
+// mergeDesiredState returns the desired state after the given settings were applied. Interfaces of the new
+// settings replace the ones with the same key, other interfaces are kept. Read only fields are not stored.
+// VLANs are kept from the current interface if the new settings do not give them, the same applies to the bonds.
+// Interfaces which become bond members are dropped, their IP settings are the ones of the bond.
+// The foreign profile policy of the new settings is kept for drift corrections, other options are not stored.
+func mergeDesiredState(current, newSettings *v1.NetworkSettings) *v1.NetworkSettings {
+	merged := &v1.NetworkSettings{LabelMap: current.GetLabelMap(), Bonds: current.GetBonds()}
+	if policy := newSettings.GetOptions().GetForeignProfiles(); policy != v1.ApplyOptions_DELETE {
+		merged.Options = &v1.ApplyOptions{ForeignProfiles: policy}
+	}
+	if len(newSettings.GetLabelMap()) != 0 {
+		merged.LabelMap = GetMapWithUppercase(newSettings.GetLabelMap())
+	}
+	if newSettings.GetBonds() != nil {
+		merged.Bonds = proto.Clone(newSettings.Bonds).(*v1.BondList)
+		for _, bond := range merged.Bonds.Interfaces {
+			bond.Status = nil
+		}
+	}
+
+	index := make(map[string]int)
+	for _, element := range current.GetInterfaces() {
+		index[desiredKey(element)] = len(merged.Interfaces)
+		merged.Interfaces = append(merged.Interfaces, element)
+	}
+	for _, element := range newSettings.GetInterfaces() {
+		desired := proto.Clone(element).(*v1.Interface)
+		desired.Generation = 0
+		desired.ResourceVersion = ""
+		desired.ProfileOwner = ""
+		desired.Bond = ""
+		for _, vlan := range desired.GetVlans().GetInterfaces() {
+			vlan.Parent = ""
+			vlan.Active = false
+		}
+
+		if i, ok := index[desiredKey(desired)]; ok {
+			if desired.Vlans == nil {
+				desired.Vlans = merged.Interfaces[i].GetVlans()
+			}
+			merged.Interfaces[i] = desired
+		} else {
+			index[desiredKey(desired)] = len(merged.Interfaces)
+			merged.Interfaces = append(merged.Interfaces, desired)
+		}
+	}
+	if newSettings.GetBonds() != nil {
+		merged.Interfaces = slices.DeleteFunc(merged.Interfaces, func(element *v1.Interface) bool {
+			return isBondMember(merged.Bonds, element)
+		})
+	}
+	return merged
+}
+
+// isBondMember reports whether one of the bonds has a member, which is given like the interface.
+func isBondMember(bonds *v1.BondList, element *v1.Interface) bool {
+	for _, bond := range bonds.GetInterfaces() {
+		for _, member := range bond.Members {
+			if desiredKey(memberInterface(member)) == desiredKey(element) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// readProtoFile reads the JSON encoded message from the given file. It returns false if the file does not exist.
+func readProtoFile(fileName string, message proto.Message) (bool, error) {
+	buffer, err := os.ReadFile(fileName)
+	if os.IsNotExist(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	if err := protojson.Unmarshal(buffer, message); err != nil {
+		return false, fmt.Errorf("failed to parse %v: %w", fileName, err)
+	}
+	return true, nil
+}
+
+// writeProtoFile writes the message JSON encoded to the given file. The file is replaced atomically,
+// so a crash while writing does not lose the previous content.
+func writeProtoFile(message proto.Message, fileName string) error {
+	buffer, err := protojson.MarshalOptions{Multiline: true}.Marshal(message)
+	if err != nil {
+		return err
+	}
+	return writeFileAtomic(fileName, buffer, 0600)
+}
+
+// writeFileAtomic writes the buffer to a temporary file next to the given one and renames it, so readers see
+// either the previous or the new content.
+func writeFileAtomic(fileName string, buffer []byte, perm os.FileMode) error {
+	tmpFile, err := os.CreateTemp(filepath.Dir(fileName), filepath.Base(fileName)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmpFile.Name())
+
+	if _, err := tmpFile.Write(buffer); err != nil {
+		tmpFile.Close()
+		return err
+	}
+	if err := tmpFile.Chmod(perm); err != nil {
+		tmpFile.Close()
+		return err
+	}
+	if err := tmpFile.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmpFile.Name(), fileName)
+}
+
+// readDesiredState reads the desired state from the given file. A missing file results in a nil desired state.
+func readDesiredState(fileName string) (*v1.NetworkSettings, error) {
+	settings := &v1.NetworkSettings{}
+	found, err := readProtoFile(fileName, settings)
+	if !found {
+		return nil, err
+	}
+	return settings, nil
+}
+
+// desiredState returns the desired state, reading it from DesiredStateFileName on first use. The caller must hold r.mu.
+func (r *reconciler) desiredState() *v1.NetworkSettings {
+	if !r.loaded {
+		desired, err := readDesiredState(DesiredStateFileName)
+		if err != nil {
+			log.Println("desired state could not be read: ", err)
+		}
+		r.desired = desired
+		r.loaded = true
+	}
+	return r.desired
+}
+
+// DesiredState returns a copy of the settings accepted by the last applies, or nil if nothing was applied yet.
+func (nc *NetworkConfigurator) DesiredState() *v1.NetworkSettings {
+	if nc.reconciler == nil {
+		return nil
+	}
+	nc.reconciler.mu.Lock()
+	defer nc.reconciler.mu.Unlock()
+
+	desired := nc.reconciler.desiredState()
+	if desired == nil {
+		return nil
+	}
+	return proto.Clone(desired).(*v1.NetworkSettings)
+}
+
+// SaveDesiredState merges the given applied settings into the desired state and persists it. It returns a copy
+// of the merged desired state, also if it could not be written. It has to be called while the apply locks of the
+// settings are held.
+func (nc *NetworkConfigurator) SaveDesiredState(newSettings *v1.NetworkSettings) (*v1.NetworkSettings, error) {
+	if nc.reconciler == nil {
+		return mergeDesiredState(nil, newSettings), nil
+	}
+	nc.reconciler.mu.Lock()
+	defer nc.reconciler.mu.Unlock()
+
+	return nc.reconciler.store(mergeDesiredState(nc.reconciler.desiredState(), newSettings))
+}
+
+// ResetDesiredState replaces the desired state with the given settings, e.g. after a factory reset. It returns a
+// copy of the new desired state like SaveDesiredState. It has to be called while all apply locks are held.
+func (nc *NetworkConfigurator) ResetDesiredState(newSettings *v1.NetworkSettings) (*v1.NetworkSettings, error) {
+	if nc.reconciler == nil {
+		return mergeDesiredState(nil, newSettings), nil
+	}
+	nc.reconciler.mu.Lock()
+	defer nc.reconciler.mu.Unlock()
+
+	return nc.reconciler.store(mergeDesiredState(nil, newSettings))
+}
+
+// store persists the given desired state and schedules a drift check. It returns a copy of the desired state.
+// The caller must hold r.mu.
+func (r *reconciler) store(desired *v1.NetworkSettings) (*v1.NetworkSettings, error) {
+	stored := proto.Clone(desired).(*v1.NetworkSettings)
+	if err := writeProtoFile(desired, DesiredStateFileName); err != nil {
+		return stored, fmt.Errorf("failed to write desired state: %w", err)
+	}
+	r.desired = desired
+	r.notify()
+	return stored, nil
+}
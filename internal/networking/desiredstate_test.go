@@ -0,0 +1,98 @@
+/*
+ * Copyright © Siemens 2025. ALL RIGHTS RESERVED.
+ * Licensed under the MIT license
+ * See LICENSE file in the top-level directory
+ */
+
+package networking
+
+import (
+	v1 "networkservice/api/siemens_iedge_dmapi_v1"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+func Test_mergeDesiredState_ReplacesAppliedInterfaces(t *testing.T) {
+	current := &v1.NetworkSettings{
+		Interfaces: []*v1.Interface{
+			{MacAddress: "00:0A:95:9D:68:16", DHCP: Enabled},
+			{Label: "X2", DHCP: Enabled},
+		},
+		LabelMap: map[string]string{"X1": "ETH0", "X2": "ETH1"},
+	}
+	applied := &v1.NetworkSettings{
+		Interfaces: []*v1.Interface{
+			{MacAddress: "00:0a:95:9d:68:16", DHCP: Disabled, Static: getMockInterfaceStaticConf(), ResourceVersion: "abc"},
+			{MacAddress: "00:0A:95:9D:68:17", DHCP: Enabled, Reconcile: v1.Interface_ENFORCE},
+		},
+	}
+
+	merged := mergeDesiredState(current, applied)
+
+	assert.Len(t, merged.Interfaces, 3)
+	assert.Equal(t, Disabled, merged.Interfaces[0].DHCP, "Interfaces with the same MAC address should be replaced")
+	assert.Empty(t, merged.Interfaces[0].ResourceVersion, "Read only fields should not be stored")
+	assert.Equal(t, "X2", merged.Interfaces[1].Label)
+	assert.Equal(t, v1.Interface_ENFORCE, merged.Interfaces[2].Reconcile)
+	assert.Equal(t, current.LabelMap, merged.LabelMap, "The label map should be kept when no new one is applied")
+	assert.Equal(t, "abc", applied.Interfaces[0].ResourceVersion, "The applied settings should not be modified")
+
+	merged = mergeDesiredState(merged, &v1.NetworkSettings{LabelMap: map[string]string{"x1": "eth1"}})
+	assert.Equal(t, map[string]string{"X1": "ETH1"}, merged.LabelMap)
+	assert.Len(t, merged.Interfaces, 3)
+}
+
+func Test_mergeDesiredState_KeepsForeignProfilePolicy(t *testing.T) {
+	applied := &v1.NetworkSettings{
+		Interfaces: []*v1.Interface{{Label: "x1", DHCP: Enabled}},
+		Options:    &v1.ApplyOptions{ForeignProfiles: v1.ApplyOptions_DISABLE, Comment: "maintenance", AllowManagementChange: true},
+	}
+
+	merged := mergeDesiredState(nil, applied)
+	assert.Equal(t, &v1.ApplyOptions{ForeignProfiles: v1.ApplyOptions_DISABLE}, merged.Options, "Only the foreign profile policy should be stored")
+
+	merged = mergeDesiredState(merged, &v1.NetworkSettings{Interfaces: []*v1.Interface{{Label: "x2", DHCP: Enabled}}})
+	assert.Nil(t, merged.Options, "The policy of the latest apply should be stored")
+}
+
+func Test_mergeDesiredState_WithoutCurrentState(t *testing.T) {
+	applied := &v1.NetworkSettings{Interfaces: []*v1.Interface{{Label: "x1", DHCP: Enabled}}}
+
+	merged := mergeDesiredState(nil, applied)
+
+	assert.True(t, proto.Equal(applied, merged))
+}
+
+func Test_DesiredState_WriteAndRead(t *testing.T) {
+	fileName := filepath.Join(t.TempDir(), "network.desired")
+	settings := &v1.NetworkSettings{
+		Interfaces: []*v1.Interface{{MacAddress: "00:0A:95:9D:68:16", DHCP: Enabled, Reconcile: v1.Interface_ENFORCE}},
+		LabelMap:   map[string]string{"X1": "ETH0"},
+	}
+
+	assert.NoError(t, writeProtoFile(settings, fileName))
+	read, err := readDesiredState(fileName)
+
+	assert.NoError(t, err)
+	assert.True(t, proto.Equal(settings, read))
+	entries, _ := os.ReadDir(filepath.Dir(fileName))
+	assert.Len(t, entries, 1, "No temporary file should be left")
+}
+
+func Test_readDesiredState_MissingAndInvalidFile(t *testing.T) {
+	dir := t.TempDir()
+
+	settings, err := readDesiredState(filepath.Join(dir, "missing"))
+	assert.NoError(t, err, "A missing desired state is not an error")
+	assert.Nil(t, settings)
+
+	invalid := filepath.Join(dir, "invalid")
+	assert.NoError(t, os.WriteFile(invalid, []byte("{invalid"), 0644))
+	settings, err = readDesiredState(invalid)
+	assert.Error(t, err)
+	assert.Nil(t, settings)
+}
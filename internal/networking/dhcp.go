@@ -0,0 +1,175 @@
+/*
+ * Copyright © Siemens 2025. ALL RIGHTS RESERVED.
+ * Licensed under the MIT license
+ * See LICENSE file in the top-level directory
+ */
+
+package networking
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/rand"
+	"net"
+	"os"
+	"syscall"
+	"time"
+
+	"golang.org/x/sys/unix"
+)
+
+const (
+	dhcpServerPort = 67
+	dhcpClientPort = 68
+	// dhcpHeaderLength is the length of the fixed BOOTP header, the magic cookie and the options follow.
+	dhcpHeaderLength = 236
+	// dhcpBroadcastFlag makes the server broadcast its answer, the client has no DHCP address yet.
+	dhcpBroadcastFlag = 0x8000
+
+	dhcpOpRequest          = 1
+	dhcpOpReply            = 2
+	dhcpHardwareEthernet   = 1
+	dhcpOptionPad          = 0
+	dhcpOptionMessageType  = 53
+	dhcpOptionServerID     = 54
+	dhcpOptionEnd          = 255
+	dhcpMessageDiscover    = 1
+	dhcpMessageOffer       = 2
+	dhcpMessageRelease     = 7
+	dhcpClientAddressStart = 12
+)
+
+// dhcpMagicCookie separates the BOOTP header from the DHCP options.
+var dhcpMagicCookie = []byte{99, 130, 83, 99}
+
+// probeDHCP broadcasts a DHCP discovery on the interface and reports whether a DHCP server answered with an offer.
+// NetworkManager runs no DHCP client while the fallback address is in effect, so the client port is free.
+func probeDHCP(interfaceName string, mac net.HardwareAddr, timeout time.Duration) (bool, error) {
+	conn, err := listenDHCP(interfaceName, fmt.Sprintf(":%d", dhcpClientPort))
+	if err != nil {
+		return false, err
+	}
+	defer conn.Close()
+
+	xid := rand.Uint32()
+	if err = conn.SetDeadline(time.Now().Add(timeout)); err != nil {
+		return false, err
+	}
+	discover := newDHCPMessage(dhcpMessageDiscover, xid, mac, nil)
+	if _, err = conn.WriteTo(discover, &net.UDPAddr{IP: net.IPv4bcast, Port: dhcpServerPort}); err != nil {
+		return false, err
+	}
+
+	buffer := make([]byte, 1500)
+	for {
+		n, _, err := conn.ReadFrom(buffer)
+		if errors.Is(err, os.ErrDeadlineExceeded) {
+			return false, nil
+		}
+		if err != nil {
+			return false, err
+		}
+		if isDHCPOffer(buffer[:n], xid) {
+			return true, nil
+		}
+	}
+}
+
+// sendDHCPRelease tells the DHCP server that the client gives up its address. The server expects no answer.
+// The client port may be held by the DHCP client of NetworkManager, then any port is used.
+func sendDHCPRelease(interfaceName string, mac net.HardwareAddr, client, server net.IP) error {
+	if client.To4() == nil || server.To4() == nil {
+		return fmt.Errorf("no IPv4 lease of %v from %v", client, server)
+	}
+	var conn net.PacketConn
+	var err error
+	for _, port := range []int{dhcpClientPort, 0} {
+		if conn, err = listenDHCP(interfaceName, net.JoinHostPort(client.String(), fmt.Sprint(port))); err == nil {
+			break
+		}
+	}
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	release := newDHCPMessage(dhcpMessageRelease, rand.Uint32(), mac, client, dhcpOptionServerID, 4, server[0], server[1], server[2], server[3])
+	_, err = conn.WriteTo(release, &net.UDPAddr{IP: server, Port: dhcpServerPort})
+	return err
+}
+
+// listenDHCP opens a UDP socket on the interface, which may send broadcasts.
+func listenDHCP(interfaceName, address string) (net.PacketConn, error) {
+	config := net.ListenConfig{Control: func(_, _ string, raw syscall.RawConn) error {
+		var optErr error
+		err := raw.Control(func(fd uintptr) {
+			if optErr = unix.SetsockoptInt(int(fd), unix.SOL_SOCKET, unix.SO_REUSEADDR, 1); optErr != nil {
+				return
+			}
+			if optErr = unix.SetsockoptInt(int(fd), unix.SOL_SOCKET, unix.SO_BROADCAST, 1); optErr != nil {
+				return
+			}
+			optErr = unix.BindToDevice(int(fd), interfaceName)
+		})
+		if err != nil {
+			return err
+		}
+		return optErr
+	}}
+	return config.ListenPacket(context.Background(), "udp4", address)
+}
+
+// newDHCPMessage creates a DHCP message of the given type. A client without address asks the server for a broadcast
+// answer, otherwise its address is sent as ciaddr. The options are added after the message type.
+func newDHCPMessage(messageType byte, xid uint32, mac net.HardwareAddr, client net.IP, options ...byte) []byte {
+	packet := make([]byte, dhcpHeaderLength)
+	packet[0] = dhcpOpRequest
+	packet[1] = dhcpHardwareEthernet
+	packet[2] = byte(len(mac))
+	binary.BigEndian.PutUint32(packet[4:8], xid)
+	if client = client.To4(); client != nil {
+		copy(packet[dhcpClientAddressStart:dhcpClientAddressStart+4], client)
+	} else {
+		binary.BigEndian.PutUint16(packet[10:12], dhcpBroadcastFlag)
+	}
+	// chaddr, the hardware address of the client
+	copy(packet[28:44], mac)
+
+	packet = append(packet, dhcpMagicCookie...)
+	packet = append(packet, dhcpOptionMessageType, 1, messageType)
+	packet = append(packet, options...)
+	return append(packet, dhcpOptionEnd)
+}
+
+// isDHCPOffer checks if the packet is a DHCPOFFER answering the discovery with the given transaction ID.
+func isDHCPOffer(packet []byte, xid uint32) bool {
+	optionsStart := dhcpHeaderLength + len(dhcpMagicCookie)
+	if len(packet) < optionsStart || packet[0] != dhcpOpReply || binary.BigEndian.Uint32(packet[4:8]) != xid ||
+		!bytes.Equal(packet[dhcpHeaderLength:optionsStart], dhcpMagicCookie) {
+		return false
+	}
+
+	options := packet[optionsStart:]
+	for len(options) > 0 {
+		code := options[0]
+		if code == dhcpOptionEnd {
+			return false
+		}
+		if code == dhcpOptionPad {
+			options = options[1:]
+			continue
+		}
+		if len(options) < 2 || len(options) < 2+int(options[1]) {
+			return false
+		}
+		value := options[2 : 2+int(options[1])]
+		if code == dhcpOptionMessageType && len(value) == 1 {
+			return value[0] == dhcpMessageOffer
+		}
+		options = options[2+len(value):]
+	}
+	return false
+}
@@ -0,0 +1,43 @@
+/*
+ * Copyright © Siemens 2025. ALL RIGHTS RESERVED.
+ * Licensed under the MIT license
+ * See LICENSE file in the top-level directory
+ */
+
+package networking
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_DHCPOffer(t *testing.T) {
+	mac, _ := net.ParseMAC("00:11:22:33:44:55")
+	discover := newDHCPMessage(dhcpMessageDiscover, 42, mac, nil)
+	assert.Equal(t, []byte(mac), discover[28:34])
+	assert.False(t, isDHCPOffer(discover, 42), "A discovery is no offer")
+
+	offer := append([]byte{}, discover...)
+	offer[0] = dhcpOpReply
+	offer[len(offer)-2] = dhcpMessageOffer
+	assert.True(t, isDHCPOffer(offer, 42))
+	assert.False(t, isDHCPOffer(offer, 43), "Offers for other clients should be ignored")
+	assert.False(t, isDHCPOffer(offer[:dhcpHeaderLength], 42))
+
+	// option 53 preceded by padding and another option
+	padded := append(append([]byte{}, offer[:dhcpHeaderLength+4]...), dhcpOptionPad, 1, 4, 255, 255, 255, 0, dhcpOptionMessageType, 1, dhcpMessageOffer, dhcpOptionEnd)
+	assert.True(t, isDHCPOffer(padded, 42))
+	assert.False(t, isDHCPOffer(padded[:len(padded)-3], 42), "A truncated option should not be read")
+}
+
+func Test_newDHCPMessage_Release(t *testing.T) {
+	mac, _ := net.ParseMAC("00:11:22:33:44:55")
+	release := newDHCPMessage(dhcpMessageRelease, 42, mac, net.ParseIP("192.168.0.20"), dhcpOptionServerID, 4, 192, 168, 0, 1)
+
+	assert.Equal(t, []byte{192, 168, 0, 20}, release[dhcpClientAddressStart:dhcpClientAddressStart+4], "The released address should be sent as ciaddr")
+	assert.Equal(t, []byte{0, 0}, release[10:12], "A client with address does not need a broadcast answer")
+	assert.Equal(t, []byte{dhcpOptionMessageType, 1, dhcpMessageRelease, dhcpOptionServerID, 4, 192, 168, 0, 1, dhcpOptionEnd},
+		release[dhcpHeaderLength+len(dhcpMagicCookie):])
+}
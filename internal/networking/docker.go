@@ -8,8 +8,10 @@ package networking
 
 import (
 	"encoding/json"
+	"fmt"
 	"log"
 	"math"
+	"net"
 	v1 "networkservice/api/siemens_iedge_dmapi_v1"
 	"os/exec"
 	"strconv"
@@ -114,3 +116,36 @@ func dockerNetworkGetMacvlanConnection(interfaceName string) *v1.Interface_L2{
 
 	return retVal
 }
+
+// dockerNetworkCreateMacvlan creates a docker macvlan network on the given parent interface from the L2 config.
+// The subnet is derived from StartingAddressIPv4 and NetMask, the IP range from StartingAddressIPv4 and Range.
+func dockerNetworkCreateMacvlan(interfaceName string, l2 *v1.Interface_L2) error {
+	subnetPrefix := ParseNetMaskSize(l2.NetMask)
+	_, subnet, err := net.ParseCIDR(fmt.Sprintf("%s/%d", l2.StartingAddressIPv4, subnetPrefix))
+	if err != nil {
+		return fmt.Errorf("invalid L2 network %v/%v: %w", l2.StartingAddressIPv4, l2.NetMask, err)
+	}
+	ipRange, err := strconv.Atoi(l2.Range)
+	if err != nil || ipRange <= 0 {
+		return fmt.Errorf("invalid L2 range %q", l2.Range)
+	}
+	rangePrefix := 32 - int(math.Ceil(math.Log2(float64(ipRange))))
+
+	args := []string{"network", "create", "-d", "macvlan",
+		"--subnet=" + subnet.String(),
+		fmt.Sprintf("--ip-range=%s/%d", l2.StartingAddressIPv4, rangePrefix),
+		"-o", "parent=" + interfaceName}
+	if l2.Gateway != "" {
+		args = append(args, "--gateway="+l2.Gateway)
+	}
+	for name, address := range l2.AuxiliaryAddresses {
+		args = append(args, fmt.Sprintf("--aux-address=%s=%s", name, address))
+	}
+	args = append(args, "zzz_layer2_"+interfaceName)
+
+	if out, err := execCommand("docker", args...).CombinedOutput(); err != nil {
+		return fmt.Errorf("docker network create: %v: %w", strings.TrimSpace(string(out)), err)
+	}
+	log.Println("docker macvlan network created for ", interfaceName)
+	return nil
+}
@@ -0,0 +1,101 @@
+/*
+ * Copyright © Siemens 2025. ALL RIGHTS RESERVED.
+ * Licensed under the MIT license
+ * See LICENSE file in the top-level directory
+ */
+
+package networking
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	v1 "networkservice/api/siemens_iedge_dmapi_v1"
+	"slices"
+
+	nm "github.com/Wifx/gonetworkmanager/v2"
+)
+
+// ErrNoFactoryDefaults is returned if the device builder did not ship factory defaults.
+var ErrNoFactoryDefaults = errors.New("no factory defaults")
+
+// FactoryDefaults reads the factory default settings from FactoryDefaultsFileName. The file is a JSON encoded
+// NetworkSettings, e.g: {"Interfaces": [{"Label": "X1", "DHCP": "disabled", "Static": {"IPv4": "192.168.0.1",
+// "NetMask": "255.255.255.0"}}, {"Label": "X2", "DHCP": "enabled"}], "LabelMap": {"X1": "ENS18", "X2": "ENS19"}}
+func (nc *NetworkConfigurator) FactoryDefaults() (*v1.NetworkSettings, error) {
+	defaults := &v1.NetworkSettings{}
+	found, err := readProtoFile(FactoryDefaultsFileName, defaults)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, fmt.Errorf("%w: %v does not exist", ErrNoFactoryDefaults, FactoryDefaultsFileName)
+	}
+	return defaults, nil
+}
+
+// wipedProfileTypes are the connection types of the profiles deleted by WipeManagedProfiles, in the order they are
+// restored: bonds before their members and Ethernet profiles before the VLANs on top of them.
+var wipedProfileTypes = []string{BondType, EthernetType, VlanType}
+
+// WipeManagedProfiles deletes the connection profiles of this service on all devices, including its bonds and
+// VLANs. Profiles of other tools are kept. It returns the IDs of the deleted profiles and a function, which adds them again and restores the
+// label map, if the following apply fails. It has to be called while all apply locks are held.
+func (nc *NetworkConfigurator) WipeManagedProfiles() ([]string, func(), error) {
+	defer nc.InvalidateSnapshot()
+
+	connections, err := listAllConnections()
+	if err != nil {
+		return nil, func() {}, fmt.Errorf("failed to list connection profiles: %w", err)
+	}
+	labelMap, labelErr := readMapFromFile(LabelMapFileName)
+
+	var removed []string
+	var backups []nm.ConnectionSettings
+	restore := func() {
+		slices.SortStableFunc(backups, func(a, b nm.ConnectionSettings) int {
+			return slices.Index(wipedProfileTypes, settingString(a[ConnectionKey][TypeKey])) -
+				slices.Index(wipedProfileTypes, settingString(b[ConnectionKey][TypeKey]))
+		})
+		for _, backup := range backups {
+			if err := addProfile(backup); err != nil {
+				log.Printf("profile %v could not be restored: %v", backup[ConnectionKey][IDKey], err)
+			}
+		}
+		if labelErr == nil {
+			if err := WriteMapToFile(labelMap, LabelMapFileName); err != nil {
+				log.Println("label map could not be restored: ", err)
+			}
+		}
+	}
+
+	for _, connection := range connections {
+		settings, err := connection.GetSettings()
+		if err != nil || !slices.Contains(wipedProfileTypes, settingString(settings[ConnectionKey][TypeKey])) ||
+			!isServiceProfile(settings) {
+			continue
+		}
+		if err := connection.Delete(); err != nil {
+			restore()
+			return nil, func() {}, fmt.Errorf("failed to delete profile %v: %w", settings[ConnectionKey][IDKey], err)
+		}
+		log.Printf("profile %v has been deleted", settings[ConnectionKey][IDKey])
+		backups = append(backups, settings)
+		removed = append(removed, settingString(settings[ConnectionKey][IDKey]))
+	}
+	return removed, restore, nil
+}
+
+// addProfile adds the given profile again with its original UUID. NetworkManager activates it on its own, if it
+// is an autoconnect profile.
+func addProfile(backup nm.ConnectionSettings) error {
+	profile := copySettings(backup)
+	removeDeprecatedKeys(profile)
+
+	settings, err := nm.NewSettings()
+	if err != nil {
+		return err
+	}
+	_, err = settings.AddConnection(profile)
+	return err
+}
@@ -0,0 +1,162 @@
+/*
+ * Copyright © Siemens 2025. ALL RIGHTS RESERVED.
+ * Licensed under the MIT license
+ * See LICENSE file in the top-level directory
+ */
+
+package networking
+
+import (
+	"errors"
+	"net"
+	v1 "networkservice/api/siemens_iedge_dmapi_v1"
+	"testing"
+
+	nm "github.com/Wifx/gonetworkmanager/v2"
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+func Test_FactoryDefaults_MissingFile(t *testing.T) {
+	nc := &NetworkConfigurator{}
+	patches := gomonkey.ApplyFunc(readProtoFile, func(fileName string, _ proto.Message) (bool, error) {
+		assert.Equal(t, FactoryDefaultsFileName, fileName)
+		return false, nil
+	})
+	defer patches.Reset()
+
+	defaults, err := nc.FactoryDefaults()
+
+	assert.Nil(t, defaults)
+	assert.True(t, errors.Is(err, ErrNoFactoryDefaults))
+}
+
+func Test_WipeManagedProfiles_DeletesServiceProfilesOnly(t *testing.T) {
+	nc := &NetworkConfigurator{}
+	service := getMockProfile("00:0A:95:9D:68:16_static", testActiveUUID, "eth0", []byte{0x00, 0x0A, 0x95, 0x9D, 0x68, 0x16}, 0)
+	service.On("Delete").Return(nil)
+	label := getMockProfile("x2_dhcp", "label-uuid", "eth1", nil, 0)
+	label.On("Delete").Return(nil)
+	foreign := getMockProfile("Wired connection 1", "foreign-uuid", "eth0", nil, 0)
+
+	patches := gomonkey.NewPatches()
+	defer patches.Reset()
+	patches.ApplyFunc(listAllConnections, func() ([]nm.Connection, error) {
+		return []nm.Connection{service, foreign, label}, nil
+	})
+	patches.ApplyFunc(readMapFromFile, func(_ string) (map[string]string, error) {
+		return map[string]string{"X2": "ETH1"}, nil
+	})
+	useLegacyLabels(t, map[string]string{"X2": "ETH1"})
+	var restored []string
+	patches.ApplyFunc(addProfile, func(backup nm.ConnectionSettings) error {
+		restored = append(restored, settingString(backup[ConnectionKey][UUIDKey]))
+		return nil
+	})
+	var labelMap map[string]string
+	patches.ApplyFunc(WriteMapToFile, func(mapToBeWritten map[string]string, _ string) error {
+		labelMap = mapToBeWritten
+		return nil
+	})
+
+	removed, restore, err := nc.WipeManagedProfiles()
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"00:0A:95:9D:68:16_static", "x2_dhcp"}, removed)
+	foreign.AssertNotCalled(t, "Delete")
+
+	restore()
+	assert.Equal(t, []string{testActiveUUID, "label-uuid"}, restored, "The profiles should be restored with their UUID")
+	assert.Equal(t, map[string]string{"X2": "ETH1"}, labelMap)
+}
+
+func Test_WipeManagedProfiles_RestoresOnDeleteError(t *testing.T) {
+	nc := &NetworkConfigurator{}
+	first := getMockProfile("00:0A:95:9D:68:16_static", testActiveUUID, "eth0", []byte{0x00, 0x0A, 0x95, 0x9D, 0x68, 0x16}, 0)
+	first.On("Delete").Return(nil)
+	second := getMockTaggedProfile("x2_dhcp", "label-uuid", "eth1", nil, 0)
+	second.On("Delete").Return(errors.New("permission denied"))
+
+	patches := gomonkey.NewPatches()
+	defer patches.Reset()
+	patches.ApplyFunc(listAllConnections, func() ([]nm.Connection, error) {
+		return []nm.Connection{first, second}, nil
+	})
+	patches.ApplyFunc(readMapFromFile, func(_ string) (map[string]string, error) {
+		return nil, errors.New("no label map")
+	})
+	var restored []string
+	patches.ApplyFunc(addProfile, func(backup nm.ConnectionSettings) error {
+		restored = append(restored, settingString(backup[ConnectionKey][IDKey]))
+		return nil
+	})
+	patches.ApplyFunc(WriteMapToFile, func(_ map[string]string, _ string) error {
+		t.Error("A label map, which could not be read, should not be written")
+		return nil
+	})
+
+	removed, _, err := nc.WipeManagedProfiles()
+
+	assert.Error(t, err)
+	assert.Nil(t, removed)
+	assert.Equal(t, []string{"00:0A:95:9D:68:16_static"}, restored, "Profiles deleted before the error should be restored")
+}
+
+func Test_ResetDesiredState_ReplacesDesiredState(t *testing.T) {
+	nc := getReconcileConfigurator(&v1.Interface{MacAddress: "00:0A:95:9D:68:16", DHCP: Disabled, Reconcile: v1.Interface_ENFORCE})
+	patches := gomonkey.ApplyFunc(writeProtoFile, func(_ proto.Message, _ string) error {
+		return nil
+	})
+	defer patches.Reset()
+
+	defaults := &v1.NetworkSettings{
+		Interfaces: []*v1.Interface{{Label: "X1", DHCP: Enabled}},
+		LabelMap:   map[string]string{"x1": "ens18"},
+	}
+	reset, err := nc.ResetDesiredState(defaults)
+	assert.NoError(t, err)
+
+	desired := nc.DesiredState()
+	assert.True(t, proto.Equal(desired, reset), "The new desired state should be returned")
+	assert.Len(t, desired.Interfaces, 1, "Interfaces applied before the reset should not be desired anymore")
+	assert.Equal(t, "X1", desired.Interfaces[0].Label)
+	assert.Equal(t, map[string]string{"X1": "ENS18"}, desired.LabelMap)
+}
+
+func Test_WipeManagedProfiles_DeletesBondsAndVlans(t *testing.T) {
+	nc := &NetworkConfigurator{}
+	vlan := getMockConnection(newVlanSettings("eth0", &v1.Interface_VlanConf{ID: 100, DHCP: Enabled}))
+	vlan.On("Delete").Return(nil)
+	member := getMockConnection(newMemberSettings("bond0", "eth1", net.HardwareAddr{0x00, 0x0A, 0x95, 0x9D, 0x68, 0x17}))
+	member.On("Delete").Return(nil)
+	bond := getMockConnection(newBondSettings(&v1.Bond{Name: "bond0", Members: []string{"X2", "X3"}, DHCP: Enabled}, ""))
+	bond.On("Delete").Return(nil)
+	foreignBond := getMockConnection(nm.ConnectionSettings{
+		ConnectionKey: {IDKey: "bond1", TypeKey: BondType, InterfaceNameKey: "bond1"},
+	})
+
+	patches := gomonkey.NewPatches()
+	defer patches.Reset()
+	patches.ApplyFunc(listAllConnections, func() ([]nm.Connection, error) {
+		return []nm.Connection{vlan, member, bond, foreignBond}, nil
+	})
+	patches.ApplyFunc(readMapFromFile, func(_ string) (map[string]string, error) {
+		return nil, errors.New("no label map")
+	})
+	var restored []string
+	patches.ApplyFunc(addProfile, func(backup nm.ConnectionSettings) error {
+		restored = append(restored, settingString(backup[ConnectionKey][IDKey]))
+		return nil
+	})
+
+	removed, restore, err := nc.WipeManagedProfiles()
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"eth0.100_dhcp", "bond0_eth1", "bond0_dhcp"}, removed)
+	foreignBond.AssertNotCalled(t, "Delete")
+
+	restore()
+	assert.Equal(t, []string{"bond0_dhcp", "bond0_eth1", "eth0.100_dhcp"}, restored,
+		"Bonds should be restored before their members and VLANs after their parents")
+}
@@ -0,0 +1,344 @@
+/*
+ * Copyright © Siemens 2025. ALL RIGHTS RESERVED.
+ * Licensed under the MIT license
+ * See LICENSE file in the top-level directory
+ */
+
+package networking
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+	v1 "networkservice/api/siemens_iedge_dmapi_v1"
+	"sync"
+	"time"
+
+	nm "github.com/Wifx/gonetworkmanager/v2"
+)
+
+const (
+	// fallbackCheckInterval is the interval in which the interfaces in DHCP fallback mode are checked.
+	fallbackCheckInterval = 5 * time.Second
+	// fallbackProbeInterval is the time between two DHCP discoveries while the fallback address is in effect.
+	fallbackProbeInterval = 30 * time.Second
+	// dhcpProbeTimeout is the time a DHCP server has to answer a discovery.
+	dhcpProbeTimeout = 3 * time.Second
+)
+
+// fallbackTagKeys are the user data keys, which hold the fallback settings of a profile.
+var fallbackTagKeys = []string{FallbackTagKey, FallbackAddressTagKey, FallbackGatewayTagKey}
+
+// fallbackSupervisor holds the progress of the interfaces in DHCP fallback mode.
+type fallbackSupervisor struct {
+	mu     sync.Mutex
+	states map[string]*fallbackState
+	// released holds the interfaces whose DHCP lease was released, they are left alone until they are activated again
+	released map[string]bool
+}
+
+// fallbackState is the progress of an interface in DHCP fallback mode.
+type fallbackState struct {
+	// waitingSince is the start of the wait for a DHCP lease, zero if there is none to wait for
+	waitingSince time.Time
+	// lastProbe is the time of the last DHCP discovery while the fallback address is in effect
+	lastProbe time.Time
+}
+
+func newFallbackSupervisor() *fallbackSupervisor {
+	return &fallbackSupervisor{states: make(map[string]*fallbackState), released: make(map[string]bool)}
+}
+
+// setReleased marks the interface with the given key as released, or as active again.
+func (s *fallbackSupervisor) setReleased(key string, released bool) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if released {
+		s.released[key] = true
+	} else {
+		delete(s.released, key)
+	}
+}
+
+func (s *fallbackSupervisor) isReleased(key string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.released[key]
+}
+
+// state returns the progress of the interface with the given key, creating it if needed.
+func (s *fallbackSupervisor) state(key string) *fallbackState {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	state, ok := s.states[key]
+	if !ok {
+		state = &fallbackState{}
+		s.states[key] = state
+	}
+	return state
+}
+
+// retain forgets the progress of all interfaces which are not in the given set.
+func (s *fallbackSupervisor) retain(keys map[string]bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for key := range s.states {
+		if !keys[key] {
+			delete(s.states, key)
+		}
+	}
+}
+
+// StartFallbackSupervisor supervises the interfaces in DHCP fallback mode until the given context is done.
+// Their profile is switched to the fallback address if no DHCP lease is obtained in time, and back to DHCP
+// as soon as a DHCP server answers again.
+func (nc *NetworkConfigurator) StartFallbackSupervisor(ctx context.Context) {
+	go nc.runFallbackSupervisor(ctx)
+	log.Println("fallback supervisor started")
+}
+
+func (nc *NetworkConfigurator) runFallbackSupervisor(ctx context.Context) {
+	ticker := time.NewTicker(fallbackCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			nc.superviseFallback(now)
+		}
+	}
+}
+
+// superviseFallback checks every interface of the desired state, which is in DHCP fallback mode.
+func (nc *NetworkConfigurator) superviseFallback(now time.Time) {
+	keys := make(map[string]bool)
+	for _, element := range nc.DesiredState().GetInterfaces() {
+		if element.DHCP == Fallback {
+			keys[desiredKey(element)] = true
+			nc.superviseInterface(element, now)
+		}
+	}
+	nc.fallback.retain(keys)
+}
+
+// superviseInterface switches the interface to its fallback address, if it waited too long for a DHCP lease,
+// or probes for a DHCP server, if the fallback address is in effect.
+func (nc *NetworkConfigurator) superviseInterface(element *v1.Interface, now time.Time) {
+	key := desiredKey(element)
+	device, _ := nc.getDeviceBy(element)
+	if device == nil {
+		return
+	}
+	if nc.fallback.isReleased(key) {
+		// The released interface is without profile, until it is renewed or settings are applied.
+		if active, err := device.GetPropertyActiveConnection(); err != nil || active == nil {
+			return
+		}
+		nc.fallback.setReleased(key, false)
+	}
+	connection, settings := serviceProfile(device)
+	if connection == nil || fallbackTags(settings) == nil {
+		return
+	}
+	state := nc.fallback.state(key)
+
+	if settings[IPV4Key][MethodKey] == Auto {
+		if hasDHCPLease(device) {
+			state.waitingSince = time.Time{}
+			return
+		}
+		if state.waitingSince.IsZero() {
+			state.waitingSince = now
+			return
+		}
+		timeout := fallbackTimeout(element)
+		if now.Sub(state.waitingSince) < timeout {
+			return
+		}
+		state.waitingSince = time.Time{}
+		state.lastProbe = now
+		log.Printf("no DHCP lease for %v within %v, switching to the fallback address", key, timeout)
+		if err := nc.switchAddressSource(device, connection, element, true); err != nil {
+			log.Printf("fallback address of %v could not be activated: %v", key, err)
+		}
+		return
+	}
+
+	if now.Sub(state.lastProbe) < fallbackProbeInterval {
+		return
+	}
+	state.lastProbe = now
+	interfaceName, _ := device.GetPropertyInterface()
+	hwAddress, _ := device.GetPropertyHwAddress()
+	mac, err := net.ParseMAC(hwAddress)
+	if err != nil {
+		log.Printf("DHCP server of %v can not be probed: %v", key, err)
+		return
+	}
+	offered, err := probeDHCP(interfaceName, mac, dhcpProbeTimeout)
+	if err != nil {
+		log.Printf("DHCP server of %v could not be probed: %v", key, err)
+		return
+	}
+	if !offered {
+		return
+	}
+	log.Printf("DHCP server answers on %v, switching back to DHCP", key)
+	state.waitingSince = now
+	if err := nc.switchAddressSource(device, connection, element, false); err != nil {
+		log.Printf("DHCP of %v could not be activated: %v", key, err)
+	}
+}
+
+// switchAddressSource updates the profile to DHCP or to the fallback address and activates it.
+// The desired settings are checked again under the apply lock, they may have been changed by an apply meanwhile.
+func (nc *NetworkConfigurator) switchAddressSource(device nm.DeviceWired, connection nm.Connection, element *v1.Interface, fallback bool) error {
+	unlock := nc.LockForApply(&v1.NetworkSettings{Interfaces: []*v1.Interface{{MacAddress: element.MacAddress, Label: element.Label}}})
+	defer unlock()
+	defer nc.InvalidateSnapshot()
+
+	current := nc.desiredInterface(desiredKey(element))
+	if current == nil || current.DHCP != Fallback {
+		return nil
+	}
+	interfaceName, _ := device.GetPropertyInterface()
+	settings := newSettingsFromProto(current, interfaceName)
+	if fallback {
+		putFallbackAddress(current, settings)
+	}
+
+	currentSettings, err := connection.GetSettings()
+	if err != nil {
+		return err
+	}
+	if err := connection.Update(mergeSettings(currentSettings, settings)); err != nil {
+		return fmt.Errorf("could not update connection: %w", err)
+	}
+	if _, err := nc.gnm.ActivateConnection(connection, device, nil); err != nil {
+		return fmt.Errorf("could not activate connection: %w", err)
+	}
+	return nil
+}
+
+// serviceProfile returns the profile of the device created by this service and its settings, the active one
+// if there are several. It returns nil if the device has no such profile.
+func serviceProfile(device nm.DeviceWired) (nm.Connection, nm.ConnectionSettings) {
+	var profiles []nm.Connection
+	for _, connection := range listConnections(device) {
+		if settings, err := connection.GetSettings(); err == nil && isServiceProfile(settings) {
+			profiles = append(profiles, connection)
+		}
+	}
+	profile := activeProfile(device, profiles)
+	if profile == nil && len(profiles) > 0 {
+		profile = profiles[0]
+	}
+	if profile == nil {
+		return nil, nil
+	}
+	settings, err := profile.GetSettings()
+	if err != nil {
+		return nil, nil
+	}
+	return profile, settings
+}
+
+// usesDHCP checks if the given DHCP value obtains the address from a DHCP server, at least while one answers.
+func usesDHCP(dhcp string) bool {
+	return dhcp == Enabled || dhcp == Fallback
+}
+
+// fallbackTimeout returns the time to wait for a DHCP lease before the fallback address is used.
+func fallbackTimeout(protoData *v1.Interface) time.Duration {
+	if seconds := protoData.GetFallback().GetDHCPTimeout(); seconds != 0 {
+		return time.Duration(seconds) * time.Second
+	}
+	if seconds := protoData.GetDHCPOptions().GetTimeout(); seconds != 0 {
+		return time.Duration(seconds) * time.Second
+	}
+	return DefaultDHCPTimeout * time.Second
+}
+
+// putFallback puts the DHCP timeout of the fallback mode, NetworkManager gives up on DHCP after it.
+func putFallback(protoData *v1.Interface, connection nm.ConnectionSettings) {
+	if protoData.DHCP == Fallback {
+		connection[IPV4Key][DHCPTimeoutKey] = int32(fallbackTimeout(protoData) / time.Second)
+	}
+}
+
+// putFallbackAddress switches the DHCP settings of the fallback mode to the fallback address.
+func putFallbackAddress(protoData *v1.Interface, connection nm.ConnectionSettings) {
+	if protoData.GetFallback().GetLinkLocal() {
+		connection[IPV4Key][MethodKey] = LinkLocal
+		return
+	}
+	putStaticIP(&v1.Interface{Static: protoData.GetFallback().GetStatic()}, connection)
+}
+
+// tagFallback stores the fallback settings in the user data of the profile, so they can be read back while
+// DHCP is in effect. It has to be called after tagOwnership.
+func tagFallback(protoData *v1.Interface, connection nm.ConnectionSettings) {
+	data := userData(connection)
+	if protoData.DHCP != Fallback || data == nil {
+		return
+	}
+	if protoData.GetFallback().GetLinkLocal() {
+		data[FallbackTagKey] = LinkLocal
+		return
+	}
+	static := protoData.GetFallback().GetStatic()
+	data[FallbackTagKey] = Static
+	data[FallbackAddressTagKey] = fmt.Sprintf("%s/%d", static.GetIPv4(), ParseNetMaskSize(static.GetNetMask()))
+	if static.GetGateway() != "" {
+		data[FallbackGatewayTagKey] = static.GetGateway()
+	}
+}
+
+// mergeFallbackTags replaces the fallback settings in the user data of the merged profile with the desired ones.
+func mergeFallbackTags(merged, desired nm.ConnectionSettings) {
+	data := userData(merged)
+	if data == nil {
+		return
+	}
+	desiredData := userData(desired)
+	for _, key := range fallbackTagKeys {
+		if value, ok := desiredData[key]; ok {
+			data[key] = value
+		} else {
+			delete(data, key)
+		}
+	}
+}
+
+// fallbackTags returns the fallback settings in the user data of the profile, or nil if it is not in fallback mode.
+func fallbackTags(settings nm.ConnectionSettings) []string {
+	data := userData(settings)
+	if data[FallbackTagKey] == "" {
+		return nil
+	}
+	return []string{data[FallbackTagKey], data[FallbackAddressTagKey], data[FallbackGatewayTagKey]}
+}
+
+// parseFallback reads the fallback settings of the profile, it returns nil if the profile is not in fallback mode.
+func parseFallback(connection nm.ConnectionSettings) *v1.Interface_FallbackConf {
+	data := userData(connection)
+	if data[FallbackTagKey] == "" {
+		return nil
+	}
+	fallback := &v1.Interface_FallbackConf{
+		DHCPTimeout: uint32(settingInt(connection[IPV4Key][DHCPTimeoutKey], DefaultDHCPTimeout)),
+		LinkLocal:   data[FallbackTagKey] == LinkLocal,
+	}
+	if ip, network, err := net.ParseCIDR(data[FallbackAddressTagKey]); err == nil {
+		prefix, _ := network.Mask.Size()
+		fallback.Static = &v1.Interface_StaticConf{IPv4: ip.String(), NetMask: ParseNetMask(uint32(prefix)),
+			Gateway: data[FallbackGatewayTagKey]}
+	}
+	return fallback
+}
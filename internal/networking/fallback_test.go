@@ -0,0 +1,120 @@
+/*
+ * Copyright © Siemens 2025. ALL RIGHTS RESERVED.
+ * Licensed under the MIT license
+ * See LICENSE file in the top-level directory
+ */
+
+package networking
+
+import (
+	"net"
+	v1 "networkservice/api/siemens_iedge_dmapi_v1"
+	mockgnm "networkservice/internal/networking/mocks/gonetworkmanager"
+	"testing"
+	"time"
+
+	nm "github.com/Wifx/gonetworkmanager/v2"
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func getMockFallbackInterface() *v1.Interface {
+	return &v1.Interface{MacAddress: "00:11:22:33:44:55", DHCP: Fallback, Fallback: &v1.Interface_FallbackConf{DHCPTimeout: 20,
+		Static: &v1.Interface_StaticConf{IPv4: "192.168.0.10", NetMask: "255.255.255.0", Gateway: "192.168.0.1"}}}
+}
+
+func Test_newSettingsFromProto_Fallback(t *testing.T) {
+	settings := newSettingsFromProto(getMockFallbackInterface(), "ens18")
+
+	assert.Equal(t, Auto, settings[IPV4Key][MethodKey], "DHCP should be tried first")
+	assert.Equal(t, int32(20), settings[IPV4Key][DHCPTimeoutKey])
+	assert.Nil(t, settings[IPV4Key][AddressDataKey], "The fallback address should not be added to the DHCP address")
+	assert.Equal(t, []string{Static, "192.168.0.10/24", "192.168.0.1"}, fallbackTags(settings))
+
+	fallback := parseFallback(settings)
+	assert.Equal(t, uint32(20), fallback.DHCPTimeout)
+	assert.Equal(t, "192.168.0.10", fallback.Static.IPv4)
+	assert.Equal(t, "255.255.255.0", fallback.Static.NetMask)
+	assert.Equal(t, "192.168.0.1", fallback.Static.Gateway)
+
+	putFallbackAddress(&v1.Interface{DHCP: Fallback, Fallback: &v1.Interface_FallbackConf{LinkLocal: true}}, settings)
+	assert.Equal(t, LinkLocal, settings[IPV4Key][MethodKey])
+}
+
+func Test_convertToProto_Fallback(t *testing.T) {
+	settings := newSettingsFromProto(getMockFallbackInterface(), "ens18")
+	putFallbackAddress(getMockFallbackInterface(), settings)
+	// NetworkManager returns the address data without variants
+	settings[IPV4Key][AddressDataKey] = []map[string]interface{}{{AddressKey: "192.168.0.10", PrefixKey: uint32(24)}}
+
+	element := convertToProto(settings, nil, "00:11:22:33:44:55", nil)
+	assert.Equal(t, Fallback, element.DHCP)
+	assert.Equal(t, Static, element.AddressSource, "The fallback address is in effect")
+	assert.Equal(t, "192.168.0.10", element.Static.IPv4)
+	assert.Equal(t, uint32(20), element.Fallback.DHCPTimeout)
+
+	plain := newSettingsFromProto(&v1.Interface{MacAddress: "00:11:22:33:44:55", DHCP: Enabled}, "ens18")
+	element = convertToProto(plain, nil, "00:11:22:33:44:55", nil)
+	assert.Equal(t, Enabled, element.DHCP)
+	assert.Equal(t, DHCP, element.AddressSource)
+	assert.Nil(t, element.Fallback)
+}
+
+func Test_mergeSettings_FallbackTags(t *testing.T) {
+	current := newSettingsFromProto(getMockFallbackInterface(), "ens18")
+	desired := newSettingsFromProto(&v1.Interface{MacAddress: "00:11:22:33:44:55", DHCP: Enabled}, "ens18")
+
+	merged := mergeSettings(current, desired)
+	assert.Nil(t, fallbackTags(merged), "The fallback settings should be removed with the fallback mode")
+	assert.Nil(t, merged[IPV4Key][DHCPTimeoutKey])
+	assert.Equal(t, OwnerServiceName, userData(merged)[OwnerTagKey])
+	assert.False(t, equivalentSettings(current, desired))
+}
+
+func Test_superviseInterface(t *testing.T) {
+	nc := &NetworkConfigurator{fallback: newFallbackSupervisor()}
+	device := new(mockgnm.MockDeviceWired)
+	device.On("GetPropertyInterface").Return("ens18", nil)
+	device.On("GetPropertyHwAddress").Return("00:11:22:33:44:55", nil)
+	settings := newSettingsFromProto(getMockFallbackInterface(), "ens18")
+	lease, offered := false, false
+	var switched []bool
+
+	patches := gomonkey.ApplyPrivateMethod(nc, "getDeviceBy", func(_ *NetworkConfigurator, _ *v1.Interface) (nm.DeviceWired, error) {
+		return device, nil
+	})
+	patches.ApplyFunc(serviceProfile, func(_ nm.DeviceWired) (nm.Connection, nm.ConnectionSettings) {
+		return &mockgnm.MockConnection{}, settings
+	})
+	patches.ApplyFunc(hasDHCPLease, func(_ nm.DeviceWired) bool { return lease })
+	patches.ApplyFunc(probeDHCP, func(_ string, _ net.HardwareAddr, _ time.Duration) (bool, error) { return offered, nil })
+	patches.ApplyPrivateMethod(nc, "switchAddressSource", func(_ *NetworkConfigurator, _ nm.DeviceWired, _ nm.Connection, _ *v1.Interface, fallback bool) error {
+		switched = append(switched, fallback)
+		if fallback {
+			putFallbackAddress(getMockFallbackInterface(), settings)
+		} else {
+			putDHCP(settings)
+		}
+		return nil
+	})
+	defer patches.Reset()
+
+	element := getMockFallbackInterface()
+	start := time.Now()
+	nc.superviseInterface(element, start)
+	nc.superviseInterface(element, start.Add(10*time.Second))
+	assert.Empty(t, switched, "The DHCP timeout is not over yet")
+
+	nc.superviseInterface(element, start.Add(21*time.Second))
+	assert.Equal(t, []bool{true}, switched, "The fallback address should be used after the DHCP timeout")
+
+	offered = true
+	nc.superviseInterface(element, start.Add(30*time.Second))
+	assert.Len(t, switched, 1, "The DHCP server is not probed before the probe interval")
+	nc.superviseInterface(element, start.Add(52*time.Second))
+	assert.Equal(t, []bool{true, false}, switched, "DHCP should be used again when a server answers")
+
+	lease = true
+	nc.superviseInterface(element, start.Add(100*time.Second))
+	assert.Len(t, switched, 2, "An interface with a lease should stay on DHCP")
+}
@@ -0,0 +1,117 @@
+/*
+ * Copyright © Siemens 2025. ALL RIGHTS RESERVED.
+ * Licensed under the MIT license
+ * See LICENSE file in the top-level directory
+ */
+
+package networking
+
+import (
+	"fmt"
+	v1 "networkservice/api/siemens_iedge_dmapi_v1"
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// Interface field names which can be used as top level FieldMask paths. Each constant is the name of the
+// v1.Interface field it selects, including all of its sub fields.
+const (
+	FieldGatewayInterface = "GatewayInterface"
+	FieldMacAddress       = "MacAddress"
+	FieldDHCP             = "DHCP"
+	FieldStatic           = "Static"
+	FieldDNSConfig        = "DNSConfig"
+	FieldL2Conf           = "L2Conf"
+	FieldInterfaceName    = "InterfaceName"
+	FieldLabel            = "Label"
+	FieldResourceVersion  = "ResourceVersion"
+	FieldProfileOwner     = "ProfileOwner"
+	FieldRoutes           = "Routes"
+	FieldFallback         = "Fallback"
+	FieldAddressSource    = "AddressSource"
+	FieldDHCPOptions      = "DHCPOptions"
+	FieldLease            = "Lease"
+	FieldLink             = "Link"
+	FieldLinkStatus       = "LinkStatus"
+	FieldVlans            = "Vlans"
+	FieldBond             = "Bond"
+)
+
+// InterfaceMask selects the fields of v1.Interface which a read has to resolve.
+// A nil InterfaceMask selects all fields.
+type InterfaceMask struct {
+	paths maskTree
+}
+
+// maskTree holds the field mask paths split by their segments.
+type maskTree map[string]maskTree
+
+// NewInterfaceMask creates an InterfaceMask from the given FieldMask. Paths are relative to v1.Interface.
+// An empty or nil FieldMask results in a nil InterfaceMask, which selects all fields.
+func NewInterfaceMask(fieldMask *fieldmaskpb.FieldMask) (*InterfaceMask, error) {
+	if fieldMask == nil || len(fieldMask.GetPaths()) == 0 {
+		return nil, nil
+	}
+	if !fieldMask.IsValid(&v1.Interface{}) {
+		return nil, fmt.Errorf("invalid field mask paths for Interface: %v", fieldMask.GetPaths())
+	}
+
+	mask := &InterfaceMask{paths: make(maskTree)}
+	for _, path := range fieldMask.GetPaths() {
+		node := mask.paths
+		for _, segment := range strings.Split(path, ".") {
+			if node[segment] == nil {
+				node[segment] = make(maskTree)
+			}
+			node = node[segment]
+		}
+	}
+	return mask, nil
+}
+
+// Includes reports whether the given top level Interface field is selected.
+func (m *InterfaceMask) Includes(field string) bool {
+	if m == nil {
+		return true
+	}
+	_, ok := m.paths[field]
+	return ok
+}
+
+// includesAny reports whether at least one of the given top level Interface fields is selected.
+func (m *InterfaceMask) includesAny(fields ...string) bool {
+	for _, field := range fields {
+		if m.Includes(field) {
+			return true
+		}
+	}
+	return false
+}
+
+// Prune clears every field of the given interface which is not selected by the mask.
+func (m *InterfaceMask) Prune(iface *v1.Interface) {
+	if m == nil || iface == nil {
+		return
+	}
+	pruneMessage(iface.ProtoReflect(), m.paths)
+}
+
+// pruneMessage clears the populated fields of msg which are not part of the given tree.
+// A tree node without children keeps the whole field.
+func pruneMessage(msg protoreflect.Message, tree maskTree) {
+	var unselected []protoreflect.FieldDescriptor
+	msg.Range(func(fd protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		children, ok := tree[string(fd.Name())]
+		if !ok {
+			unselected = append(unselected, fd)
+		} else if len(children) > 0 && fd.Message() != nil && !fd.IsList() && !fd.IsMap() {
+			pruneMessage(value.Message(), children)
+		}
+		return true
+	})
+	for _, fd := range unselected {
+		msg.Clear(fd)
+	}
+}
@@ -0,0 +1,102 @@
+/*
+ * Copyright © Siemens 2025. ALL RIGHTS RESERVED.
+ * Licensed under the MIT license
+ * See LICENSE file in the top-level directory
+ */
+
+package networking
+
+import (
+	v1 "networkservice/api/siemens_iedge_dmapi_v1"
+	mockgnm "networkservice/internal/networking/mocks/gonetworkmanager"
+	"testing"
+
+	nm "github.com/Wifx/gonetworkmanager/v2"
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func Test_NewInterfaceMask_ReturnsNilForEmptyFieldMask(t *testing.T) {
+	mask, err := NewInterfaceMask(nil)
+	assert.NoError(t, err)
+	assert.Nil(t, mask, "NewInterfaceMask should return nil for a nil field mask")
+
+	mask, err = NewInterfaceMask(&fieldmaskpb.FieldMask{})
+	assert.NoError(t, err)
+	assert.Nil(t, mask, "NewInterfaceMask should return nil for a field mask without paths")
+	assert.True(t, mask.Includes(FieldL2Conf), "A nil mask should include every field")
+}
+
+func Test_NewInterfaceMask_ReturnsErrorForUnknownPath(t *testing.T) {
+	mask, err := NewInterfaceMask(&fieldmaskpb.FieldMask{Paths: []string{"DHCP", "Unknown"}})
+
+	assert.Error(t, err, "NewInterfaceMask should fail for paths which are not part of Interface")
+	assert.Nil(t, mask)
+}
+
+func Test_InterfaceMask_IncludesOnlySelectedTopLevelFields(t *testing.T) {
+	mask, err := NewInterfaceMask(&fieldmaskpb.FieldMask{Paths: []string{"DHCP", "Static.IPv4"}})
+
+	assert.NoError(t, err)
+	assert.True(t, mask.Includes(FieldDHCP))
+	assert.True(t, mask.Includes(FieldStatic))
+	assert.False(t, mask.Includes(FieldL2Conf))
+	assert.False(t, mask.Includes(FieldGatewayInterface))
+}
+
+func Test_InterfaceMask_PruneClearsUnselectedFields(t *testing.T) {
+	mask, _ := NewInterfaceMask(&fieldmaskpb.FieldMask{Paths: []string{"DHCP", "Static.IPv4"}})
+	iface := &v1.Interface{
+		MacAddress:    "00:0A:95:9D:68:16",
+		DHCP:          Disabled,
+		Static:        getMockInterfaceStaticConf(),
+		DNSConfig:     getMockInterfaceDNSConfig(),
+		InterfaceName: "eth0",
+	}
+
+	mask.Prune(iface)
+
+	assert.Equal(t, Disabled, iface.DHCP)
+	assert.Equal(t, "192.168.1.1", iface.Static.IPv4)
+	assert.Empty(t, iface.Static.NetMask, "Prune should clear unselected nested fields")
+	assert.Empty(t, iface.MacAddress)
+	assert.Empty(t, iface.InterfaceName)
+	assert.Nil(t, iface.DNSConfig)
+}
+
+func Test_DBusToProto_SkipsUnselectedLookups(t *testing.T) {
+	mockDeviceWired := &mockgnm.MockDeviceWired{}
+	mask, _ := NewInterfaceMask(&fieldmaskpb.FieldMask{Paths: []string{"InterfaceName"}})
+
+	mockDeviceWired.On("GetPropertyHwAddress").Return("00:0A:95:9D:68:16", nil)
+	mockDeviceWired.On("GetPropertyPermHwAddress").Return("00:0A:95:9D:68:16", nil)
+	mockDeviceWired.On("GetPropertyInterface").Return("eth0", nil)
+
+	patches := gomonkey.NewPatches()
+	defer patches.Reset()
+
+	dockerCalled, labelCalled, listCalled := false, false, false
+	patches.ApplyFunc(dockerNetworkGetMacvlanConnection, func(_ string) *v1.Interface_L2 {
+		dockerCalled = true
+		return nil
+	})
+	patches.ApplyFunc(getLabelForInterface, func(_ string) (string, error) {
+		labelCalled = true
+		return "", nil
+	})
+	patches.ApplyFunc(listConnections, func(_ nm.DeviceWired) []nm.Connection {
+		listCalled = true
+		return nil
+	})
+
+	result := DBusToProto(mockDeviceWired, mask)
+
+	assert.Equal(t, "eth0", result.InterfaceName)
+	assert.Empty(t, result.MacAddress, "DBusToProto should clear fields which are not selected")
+	assert.Nil(t, result.L2Conf)
+	assert.False(t, dockerCalled, "DBusToProto should not query docker when L2Conf is not selected")
+	assert.False(t, labelCalled, "DBusToProto should not read the label file when Label is not selected")
+	assert.False(t, listCalled, "DBusToProto should not walk the connections when no IP field is selected")
+	mockDeviceWired.AssertNotCalled(t, "GetPropertyActiveConnection")
+}
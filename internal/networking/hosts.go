@@ -0,0 +1,148 @@
+/*
+ * Copyright © Siemens 2025. ALL RIGHTS RESERVED.
+ * Licensed under the MIT license
+ * See LICENSE file in the top-level directory
+ */
+
+package networking
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	v1 "networkservice/api/siemens_iedge_dmapi_v1"
+	"os"
+	"strings"
+	"syscall"
+)
+
+const (
+	// hostsBlockBegin and hostsBlockEnd enclose the host entries of this service in the hosts file.
+	hostsBlockBegin = "# BEGIN " + OwnerServiceName + " host entries"
+	hostsBlockEnd   = "# END " + OwnerServiceName + " host entries"
+)
+
+// ErrInvalidHostEntries is returned for host entries, which can not be written to the hosts file.
+var ErrInvalidHostEntries = errors.New("invalid host entries")
+
+// SetHostEntries replaces the host entries of this service in the hosts file, e.g: {"Entries": [{"IP": "192.168.0.10",
+// "Names": ["plc-line1", "plc-line1.plant.local"]}]}. The lines of other tools are kept.
+func (nc *NetworkConfigurator) SetHostEntries(entries *v1.HostEntries) error {
+	if err := validateHostEntries(entries); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidHostEntries, err)
+	}
+	nc.resolver.mu.Lock()
+	defer nc.resolver.mu.Unlock()
+
+	if err := writeHostEntries(HostsFileName, entries.GetEntries()); err != nil {
+		return fmt.Errorf("could not write %v: %w", HostsFileName, err)
+	}
+	log.Printf("%d host entries written to %v", len(entries.GetEntries()), HostsFileName)
+	return nil
+}
+
+// validateHostEntries checks that every entry has a valid address and valid host names, and that no address is given twice.
+func validateHostEntries(entries *v1.HostEntries) error {
+	addresses := make(map[string]bool)
+	for _, entry := range entries.GetEntries() {
+		ip := net.ParseIP(entry.IP)
+		if ip == nil {
+			return fmt.Errorf("wrong address %v", entry.IP)
+		}
+		if addresses[ip.String()] {
+			return fmt.Errorf("address %v is given twice", entry.IP)
+		}
+		addresses[ip.String()] = true
+		if len(entry.Names) == 0 {
+			return fmt.Errorf("address %v has no names", entry.IP)
+		}
+		for _, name := range entry.Names {
+			if !validHostname(name) {
+				return fmt.Errorf("wrong host name %v of %v", name, entry.IP)
+			}
+		}
+	}
+	return nil
+}
+
+// readHostEntries reads the entries of this service from the given hosts file, a missing file has none.
+func readHostEntries(fileName string) ([]*v1.HostEntry, error) {
+	buffer, err := os.ReadFile(fileName)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return parseHostsBlock(string(buffer)), nil
+}
+
+// writeHostEntries replaces the entries of this service in the given hosts file. The file is replaced atomically,
+// unless it is a mount point, e.g. the hosts file of a container, which can only be written in place.
+func writeHostEntries(fileName string, entries []*v1.HostEntry) error {
+	buffer, err := os.ReadFile(fileName)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	content := []byte(replaceHostsBlock(string(buffer), entries))
+
+	err = writeFileAtomic(fileName, content, 0644)
+	if errors.Is(err, syscall.EBUSY) {
+		log.Printf("%v can not be replaced, it is written in place", fileName)
+		return os.WriteFile(fileName, content, 0644)
+	}
+	return err
+}
+
+// replaceHostsBlock returns the content of a hosts file with the block of this service replaced by the given entries.
+// The block is removed if there are no entries.
+func replaceHostsBlock(content string, entries []*v1.HostEntry) string {
+	var lines []string
+	inBlock := false
+	for _, line := range strings.Split(strings.TrimRight(content, "\n"), "\n") {
+		switch {
+		case strings.TrimSpace(line) == hostsBlockBegin:
+			inBlock = true
+		case strings.TrimSpace(line) == hostsBlockEnd:
+			inBlock = false
+		case !inBlock:
+			lines = append(lines, line)
+		}
+	}
+	for len(lines) > 0 && strings.TrimSpace(lines[len(lines)-1]) == "" {
+		lines = lines[:len(lines)-1]
+	}
+
+	if len(entries) > 0 {
+		lines = append(lines, hostsBlockBegin)
+		for _, entry := range entries {
+			lines = append(lines, entry.IP+"\t"+strings.Join(entry.Names, " "))
+		}
+		lines = append(lines, hostsBlockEnd)
+	}
+	if len(lines) == 0 {
+		return ""
+	}
+	return strings.Join(lines, "\n") + "\n"
+}
+
+// parseHostsBlock returns the entries in the block of this service of the given hosts file content.
+func parseHostsBlock(content string) []*v1.HostEntry {
+	var entries []*v1.HostEntry
+	inBlock := false
+	for _, line := range strings.Split(content, "\n") {
+		line = strings.TrimSpace(line)
+		switch {
+		case line == hostsBlockBegin:
+			inBlock = true
+		case line == hostsBlockEnd:
+			inBlock = false
+		case inBlock && line != "" && !strings.HasPrefix(line, "#"):
+			if fields := strings.Fields(line); len(fields) > 1 {
+				entries = append(entries, &v1.HostEntry{IP: fields[0], Names: fields[1:]})
+			}
+		}
+	}
+	return entries
+}
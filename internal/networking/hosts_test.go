@@ -0,0 +1,64 @@
+/*
+ * Copyright © Siemens 2025. ALL RIGHTS RESERVED.
+ * Licensed under the MIT license
+ * See LICENSE file in the top-level directory
+ */
+
+package networking
+
+import (
+	v1 "networkservice/api/siemens_iedge_dmapi_v1"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func getMockHostEntries() []*v1.HostEntry {
+	return []*v1.HostEntry{{IP: "192.168.0.10", Names: []string{"plc-line1", "plc-line1.plant.local"}}, {IP: "fd00::10", Names: []string{"hmi-line1"}}}
+}
+
+func Test_writeHostEntries(t *testing.T) {
+	fileName := filepath.Join(t.TempDir(), "hosts")
+	system := "127.0.0.1\tlocalhost\n::1\tlocalhost ip6-localhost\n"
+	assert.NoError(t, os.WriteFile(fileName, []byte(system), 0644))
+
+	assert.NoError(t, writeHostEntries(fileName, getMockHostEntries()))
+	entries, err := readHostEntries(fileName)
+	assert.NoError(t, err)
+	assert.Equal(t, getMockHostEntries(), entries)
+
+	content, _ := os.ReadFile(fileName)
+	assert.Equal(t, system+hostsBlockBegin+"\n192.168.0.10\tplc-line1 plc-line1.plant.local\nfd00::10\thmi-line1\n"+hostsBlockEnd+"\n", string(content))
+	info, _ := os.Stat(fileName)
+	assert.Equal(t, os.FileMode(0644), info.Mode().Perm())
+
+	// lines added by other tools after the block are kept
+	assert.NoError(t, os.WriteFile(fileName, append(content, []byte("10.0.0.1\tgateway\n")...), 0644))
+	assert.NoError(t, writeHostEntries(fileName, getMockHostEntries()[:1]))
+	content, _ = os.ReadFile(fileName)
+	assert.Equal(t, system+"10.0.0.1\tgateway\n"+hostsBlockBegin+"\n192.168.0.10\tplc-line1 plc-line1.plant.local\n"+hostsBlockEnd+"\n", string(content))
+
+	assert.NoError(t, writeHostEntries(fileName, nil))
+	content, _ = os.ReadFile(fileName)
+	assert.Equal(t, system+"10.0.0.1\tgateway\n", string(content), "The block should be removed without entries")
+
+	entries, err = readHostEntries(filepath.Join(t.TempDir(), "missing"))
+	assert.NoError(t, err)
+	assert.Empty(t, entries)
+}
+
+func Test_validateHostEntries(t *testing.T) {
+	assert.NoError(t, validateHostEntries(&v1.HostEntries{Entries: getMockHostEntries()}))
+	assert.NoError(t, validateHostEntries(&v1.HostEntries{}), "Without entries all entries are removed")
+
+	for name, entry := range map[string]*v1.HostEntry{
+		"address":   {IP: "192.168.0.256", Names: []string{"plc"}},
+		"no names":  {IP: "192.168.0.11"},
+		"host name": {IP: "192.168.0.11", Names: []string{"plc line1"}},
+		"twice":     {IP: "192.168.0.10", Names: []string{"plc"}},
+	} {
+		assert.Error(t, validateHostEntries(&v1.HostEntries{Entries: append(getMockHostEntries(), entry)}), name)
+	}
+}
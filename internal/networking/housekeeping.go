@@ -0,0 +1,298 @@
+/*
+ * Copyright © Siemens 2025. ALL RIGHTS RESERVED.
+ * Licensed under the MIT license
+ * See LICENSE file in the top-level directory
+ */
+
+package networking
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+	v1 "networkservice/api/siemens_iedge_dmapi_v1"
+	"strings"
+	"time"
+
+	nm "github.com/Wifx/gonetworkmanager/v2"
+)
+
+// housekeepingInterval is the interval of the periodic profile audit.
+const housekeepingInterval = time.Hour
+
+// profileInfo holds the settings of a connection profile, which bind it to a device.
+type profileInfo struct {
+	connection    nm.Connection
+	id            string
+	uuid          string
+	mac           string
+	interfaceName string
+	timestamp     int64
+}
+
+// housekeepingDevice holds the ethernet device data needed to check the connection profiles.
+type housekeepingDevice struct {
+	mac           string
+	interfaceName string
+	activeUUID    string
+}
+
+// profileFinding is a finding of the housekeeping together with the profile it refers to.
+type profileFinding struct {
+	connection nm.Connection
+	finding    *v1.ProfileFinding
+}
+
+// listAllConnections returns all connection profiles stored in NetworkManager, not only the ones available for a device.
+func listAllConnections() ([]nm.Connection, error) {
+	settings, err := nm.NewSettings()
+	if err != nil {
+		return nil, err
+	}
+	return settings.ListConnections()
+}
+
+func newProfileInfo(connection nm.Connection, settings nm.ConnectionSettings) profileInfo {
+	profile := profileInfo{
+		connection:    connection,
+		id:            settingString(settings[ConnectionKey][IDKey]),
+		uuid:          settingString(settings[ConnectionKey][UUIDKey]),
+		interfaceName: settingString(settings[ConnectionKey][InterfaceNameKey]),
+		timestamp:     settingInt(settings[ConnectionKey][TimeStampKey], 0),
+	}
+	if mac, ok := settings[EthernetType][MACAddressKey].([]byte); ok && len(mac) > 0 {
+		profile.mac = strings.ToUpper(net.HardwareAddr(mac).String())
+	}
+	return profile
+}
+
+// newFinding creates the report entry of the given profile.
+func (p profileInfo) newFinding(kind v1.ProfileFinding_Reason, active bool, detail string) profileFinding {
+	return profileFinding{
+		connection: p.connection,
+		finding: &v1.ProfileFinding{
+			Kind:          kind,
+			ID:            p.id,
+			UUID:          p.uuid,
+			MacAddress:    p.mac,
+			InterfaceName: p.interfaceName,
+			Detail:        detail,
+			Active:        active,
+		},
+	}
+}
+
+// profileLabel returns the label the profile was created for, or an empty string if it was created for a MAC address.
+func profileLabel(id string) string {
+	if !legacyProfileID.MatchString(id) {
+		return ""
+	}
+	identifier := id[:strings.LastIndex(id, "_")]
+	if _, err := net.ParseMAC(identifier); err == nil {
+		return ""
+	}
+	return identifier
+}
+
+// housekeepingDevices returns the ethernet devices with their active connection profile.
+func (nc *NetworkConfigurator) housekeepingDevices() []housekeepingDevice {
+	var devices []housekeepingDevice
+	for _, device := range nc.getAllEthernetDevices() {
+		mac, _ := deviceMac(device)
+		interfaceName, _ := device.GetPropertyInterface()
+		entry := housekeepingDevice{mac: strings.ToUpper(mac), interfaceName: interfaceName}
+		if activeConnection, err := device.GetPropertyActiveConnection(); err == nil && activeConnection != nil {
+			entry.activeUUID, _ = activeConnection.GetPropertyUUID()
+		}
+		devices = append(devices, entry)
+	}
+	return devices
+}
+
+// isActive reports whether the profile is the active connection profile of the device.
+func (d housekeepingDevice) isActive(profile profileInfo) bool {
+	return d.activeUUID != "" && profile.uuid == d.activeUUID
+}
+
+// findDevice returns the index of the device the profile is bound to, or -1.
+// A profile with a MAC address is bound by it, other profiles are bound by their interface name.
+func findDevice(devices []housekeepingDevice, profile profileInfo) int {
+	for i, device := range devices {
+		if profile.mac != "" && profile.mac == device.mac {
+			return i
+		}
+		if profile.mac == "" && profile.interfaceName != "" && profile.interfaceName == device.interfaceName {
+			return i
+		}
+	}
+	return -1
+}
+
+// labelConflict returns why the label based profile does not match the label map, or an empty string.
+// Without a label map nothing is reported, the profiles can not be checked then.
+func labelConflict(profile profileInfo, device housekeepingDevice, labelMap map[string]string) string {
+	label := profileLabel(profile.id)
+	if label == "" || labelMap == nil {
+		return ""
+	}
+	expected, ok := labelMap[strings.ToUpper(label)]
+	if !ok {
+		return fmt.Sprintf("label %v is not in the label map", label)
+	}
+	if !strings.EqualFold(expected, device.interfaceName) {
+		return fmt.Sprintf("label %v is mapped to %v, but the profile is bound to %v", label, expected, device.interfaceName)
+	}
+	return ""
+}
+
+// duplicateProfiles returns the findings for all profiles of a device except the one to keep.
+// The active profile is kept, without an active profile the most recently used one.
+func duplicateProfiles(profiles []profileInfo, device housekeepingDevice) []profileFinding {
+	if len(profiles) < 2 {
+		return nil
+	}
+
+	kept := 0
+	for i, profile := range profiles {
+		if device.isActive(profile) {
+			kept = i
+			break
+		}
+		if profile.timestamp > profiles[kept].timestamp {
+			kept = i
+		}
+	}
+
+	var findings []profileFinding
+	for i, profile := range profiles {
+		if i != kept {
+			detail := fmt.Sprintf("duplicate of %v (%v) on %v", profiles[kept].id, profiles[kept].uuid, device.interfaceName)
+			findings = append(findings, profile.newFinding(v1.ProfileFinding_DUPLICATE, false, detail))
+		}
+	}
+	return findings
+}
+
+// auditProfiles checks the ethernet connection profiles of this service. Foreign profiles are not reported,
+// they are handled by the foreign profile policy of ApplySettings.
+func (nc *NetworkConfigurator) auditProfiles() ([]profileFinding, error) {
+	connections, err := listAllConnections()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list connection profiles: %w", err)
+	}
+
+	devices := nc.housekeepingDevices()
+	labelMap, err := readMapFromFile(LabelMapFileName)
+	if err != nil {
+		log.Println("label map could not be read, label based profiles are not checked: ", err)
+	}
+
+	var findings []profileFinding
+	profilesOfDevice := make([][]profileInfo, len(devices))
+	for _, connection := range connections {
+		settings, err := connection.GetSettings()
+		if err != nil || settings[ConnectionKey][TypeKey] != EthernetType || !isServiceProfile(settings) {
+			continue
+		}
+
+		profile := newProfileInfo(connection, settings)
+		index := findDevice(devices, profile)
+		if index < 0 {
+			findings = append(findings, profile.newFinding(v1.ProfileFinding_ORPHAN, false, "no device exists for the profile"))
+			continue
+		}
+
+		device := devices[index]
+		if conflict := labelConflict(profile, device, labelMap); conflict != "" {
+			findings = append(findings, profile.newFinding(v1.ProfileFinding_LABEL_CONFLICT, device.isActive(profile), conflict))
+			continue
+		}
+		profilesOfDevice[index] = append(profilesOfDevice[index], profile)
+	}
+
+	for i, profiles := range profilesOfDevice {
+		findings = append(findings, duplicateProfiles(profiles, devices[i])...)
+	}
+	return findings, nil
+}
+
+// AuditProfiles returns the duplicate, orphaned and label conflicting connection profiles of this service.
+func (nc *NetworkConfigurator) AuditProfiles() (*v1.ProfileReport, error) {
+	findings, err := nc.auditProfiles()
+	if err != nil {
+		return nil, err
+	}
+
+	report := &v1.ProfileReport{DryRun: true}
+	for _, entry := range findings {
+		report.Findings = append(report.Findings, entry.finding)
+	}
+	return report, nil
+}
+
+// CleanupProfiles removes the profiles reported by AuditProfiles. Active profiles are never removed,
+// a profile which can not be removed is reported with the error and the remaining profiles are still removed.
+func (nc *NetworkConfigurator) CleanupProfiles(dryRun bool) (*v1.ProfileReport, error) {
+	findings, err := nc.auditProfiles()
+	if err != nil {
+		return nil, err
+	}
+
+	report := &v1.ProfileReport{DryRun: dryRun}
+	removed := false
+	for _, entry := range findings {
+		report.Findings = append(report.Findings, entry.finding)
+		if dryRun || entry.finding.Active {
+			continue
+		}
+
+		if err := entry.connection.Delete(); err != nil {
+			log.Printf("Failed to delete connection %v: %v", entry.finding.ID, err)
+			entry.finding.Detail = fmt.Sprintf("%v, could not be removed: %v", entry.finding.Detail, err)
+			continue
+		}
+		log.Printf("Connection %v (%v) has been removed: %v", entry.finding.ID, entry.finding.UUID, entry.finding.Detail)
+		entry.finding.Removed = true
+		removed = true
+	}
+
+	if removed {
+		nc.InvalidateSnapshot()
+	}
+	return report, nil
+}
+
+// StartHousekeeping audits the connection profiles of this service periodically until the given context is done.
+// The findings are only logged, they are removed with CleanupProfiles.
+func (nc *NetworkConfigurator) StartHousekeeping(ctx context.Context) {
+	go nc.runHousekeeping(ctx)
+	log.Println("housekeeping started")
+}
+
+func (nc *NetworkConfigurator) runHousekeeping(ctx context.Context) {
+	ticker := time.NewTicker(housekeepingInterval)
+	defer ticker.Stop()
+
+	for {
+		nc.housekeep()
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// housekeep logs the findings of the profile audit.
+func (nc *NetworkConfigurator) housekeep() {
+	findings, err := nc.auditProfiles()
+	if err != nil {
+		log.Println("connection profiles could not be audited: ", err)
+		return
+	}
+
+	for _, entry := range findings {
+		log.Printf("housekeeping found %v profile %v (%v): %v", entry.finding.Kind, entry.finding.ID, entry.finding.UUID, entry.finding.Detail)
+	}
+}
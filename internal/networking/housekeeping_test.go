@@ -0,0 +1,206 @@
+/*
+ * Copyright © Siemens 2025. ALL RIGHTS RESERVED.
+ * Licensed under the MIT license
+ * See LICENSE file in the top-level directory
+ */
+
+package networking
+
+import (
+	"bytes"
+	"errors"
+	"log"
+	v1 "networkservice/api/siemens_iedge_dmapi_v1"
+	mockgnm "networkservice/internal/networking/mocks/gonetworkmanager"
+	"os"
+	"reflect"
+	"testing"
+
+	nm "github.com/Wifx/gonetworkmanager/v2"
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func getMockProfile(id, uuid, interfaceName string, mac []byte, timestamp uint64) *mockgnm.MockConnection {
+	settings := nm.ConnectionSettings{
+		ConnectionKey: {IDKey: id, UUIDKey: uuid, TypeKey: EthernetType, InterfaceNameKey: interfaceName, TimeStampKey: timestamp},
+		EthernetType:  {},
+		IPV4Key:       {MethodKey: Auto},
+	}
+	if mac != nil {
+		settings[EthernetType][MACAddressKey] = mac
+	}
+	mockConnection := &mockgnm.MockConnection{}
+	mockConnection.On("GetSettings").Return(settings, nil)
+	return mockConnection
+}
+
+// getMockTaggedProfile returns a profile like getMockProfile, which is tagged by this service.
+func getMockTaggedProfile(id, uuid, interfaceName string, mac []byte, timestamp uint64) *mockgnm.MockConnection {
+	mockConnection := getMockProfile(id, uuid, interfaceName, mac, timestamp)
+	settings, _ := mockConnection.GetSettings()
+	tagOwnership(settings)
+	return mockConnection
+}
+
+// housekeepingTestSetup holds the profiles of the housekeeping tests: an active profile and a stale duplicate on eth0,
+// an orphan of a removed device, a profile of a label removed from the label map, a valid label based profile on eth1,
+// which is not active, and a foreign profile.
+type housekeepingTestSetup struct {
+	active, duplicate, orphan, conflict, labelled, foreign *mockgnm.MockConnection
+}
+
+func applyHousekeepingPatches(t *testing.T, nc *NetworkConfigurator) (*gomonkey.Patches, housekeepingTestSetup) {
+	setup := housekeepingTestSetup{
+		active:    getMockProfile("00:0A:95:9D:68:16_static", testActiveUUID, "eth0", []byte{0x00, 0x0A, 0x95, 0x9D, 0x68, 0x16}, 1700000000),
+		duplicate: getMockProfile("00:0A:95:9D:68:16_dhcp", "dup-uuid", "eth0", []byte{0x00, 0x0A, 0x95, 0x9D, 0x68, 0x16}, 1700000100),
+		orphan:    getMockProfile("00:0A:95:9D:68:99_static", "orphan-uuid", "eth9", []byte{0x00, 0x0A, 0x95, 0x9D, 0x68, 0x99}, 0),
+		conflict:  getMockTaggedProfile("x3_dhcp", "conflict-uuid", "eth1", nil, 0),
+		labelled:  getMockProfile("x2_dhcp", "label-uuid", "eth1", nil, 0),
+		foreign:   getMockProfile("Wired connection 1", "foreign-uuid", "eth0", nil, 0),
+	}
+
+	mockDevice1 := &mockgnm.MockDeviceWired{}
+	mockDevice1.On("GetPropertyHwAddress").Return("00:0a:95:9d:68:16", nil)
+	mockDevice1.On("GetPropertyPermHwAddress").Return("00:0a:95:9d:68:16", nil)
+	mockDevice1.On("GetPropertyInterface").Return("eth0", nil)
+	mockActiveConnection := &mockgnm.MockActiveConnection{}
+	mockActiveConnection.On("GetPropertyUUID").Return(testActiveUUID, nil)
+	mockDevice1.On("GetPropertyActiveConnection").Return(mockActiveConnection, nil)
+	mockDevice2 := &mockgnm.MockDeviceWired{}
+	mockDevice2.On("GetPropertyHwAddress").Return("00:0a:95:9d:68:17", nil)
+	mockDevice2.On("GetPropertyPermHwAddress").Return("00:0a:95:9d:68:17", nil)
+	mockDevice2.On("GetPropertyInterface").Return("eth1", nil)
+	mockOtherConnection := &mockgnm.MockActiveConnection{}
+	mockOtherConnection.On("GetPropertyUUID").Return("external-uuid", nil)
+	mockDevice2.On("GetPropertyActiveConnection").Return(mockOtherConnection, nil)
+
+	patches := gomonkey.NewPatches()
+	patches.ApplyFunc(listAllConnections, func() ([]nm.Connection, error) {
+		return []nm.Connection{setup.active, setup.duplicate, setup.orphan, setup.conflict, setup.labelled, setup.foreign}, nil
+	})
+	patches.ApplyPrivateMethod(reflect.TypeOf(nc), "getAllEthernetDevices", func(_ *NetworkConfigurator) []nm.DeviceWired {
+		return []nm.DeviceWired{mockDevice1, mockDevice2}
+	})
+	patches.ApplyFunc(readMapFromFile, func(_ string) (map[string]string, error) {
+		return map[string]string{"X1": "ETH0", "X2": "ETH1"}, nil
+	})
+	useLegacyLabels(t, map[string]string{"X1": "ETH0", "X2": "ETH1"})
+	return patches, setup
+}
+
+func Test_AuditProfiles_ReportsDuplicatesOrphansAndLabelConflicts(t *testing.T) {
+	nc := &NetworkConfigurator{}
+	patches, _ := applyHousekeepingPatches(t, nc)
+	defer patches.Reset()
+
+	report, err := nc.AuditProfiles()
+
+	assert.NoError(t, err)
+	assert.True(t, report.DryRun)
+	assert.Len(t, report.Findings, 3)
+	kinds := make(map[string]v1.ProfileFinding_Reason)
+	for _, finding := range report.Findings {
+		kinds[finding.UUID] = finding.Kind
+		assert.False(t, finding.Active)
+		assert.False(t, finding.Removed)
+	}
+	assert.Equal(t, map[string]v1.ProfileFinding_Reason{
+		"orphan-uuid":   v1.ProfileFinding_ORPHAN,
+		"conflict-uuid": v1.ProfileFinding_LABEL_CONFLICT,
+		"dup-uuid":      v1.ProfileFinding_DUPLICATE,
+	}, kinds, "The active profile should be kept, even though the duplicate was used more recently")
+}
+
+func Test_AuditProfiles_ReturnsErrorWhenProfilesCanNotBeListed(t *testing.T) {
+	nc := &NetworkConfigurator{}
+	patches := gomonkey.ApplyFunc(listAllConnections, func() ([]nm.Connection, error) {
+		return nil, errors.New("dbus error")
+	})
+	defer patches.Reset()
+
+	report, err := nc.AuditProfiles()
+
+	assert.Error(t, err)
+	assert.Nil(t, report)
+}
+
+func Test_CleanupProfiles_DryRunRemovesNothing(t *testing.T) {
+	nc := &NetworkConfigurator{}
+	patches, setup := applyHousekeepingPatches(t, nc)
+	defer patches.Reset()
+
+	report, err := nc.CleanupProfiles(true)
+
+	assert.NoError(t, err)
+	assert.True(t, report.DryRun)
+	assert.Len(t, report.Findings, 3)
+	for _, connection := range []*mockgnm.MockConnection{setup.duplicate, setup.orphan, setup.conflict} {
+		connection.AssertNotCalled(t, "Delete")
+	}
+}
+
+func Test_CleanupProfiles_RemovesReportedProfiles(t *testing.T) {
+	nc := &NetworkConfigurator{}
+	patches, setup := applyHousekeepingPatches(t, nc)
+	defer patches.Reset()
+	setup.duplicate.On("Delete").Return(nil)
+	setup.orphan.On("Delete").Return(nil)
+	setup.conflict.On("Delete").Return(errors.New("permission denied"))
+
+	report, err := nc.CleanupProfiles(false)
+
+	assert.NoError(t, err)
+	assert.False(t, report.DryRun)
+	for _, finding := range report.Findings {
+		assert.Equal(t, finding.UUID != "conflict-uuid", finding.Removed, "Only profiles, which could be deleted, are reported as removed")
+	}
+	setup.duplicate.AssertCalled(t, "Delete")
+	setup.orphan.AssertCalled(t, "Delete")
+	for _, connection := range []*mockgnm.MockConnection{setup.active, setup.labelled, setup.foreign} {
+		connection.AssertNotCalled(t, "Delete")
+	}
+}
+
+func Test_housekeep_LogsFindingsWithoutRemoving(t *testing.T) {
+	nc := &NetworkConfigurator{}
+	patches, setup := applyHousekeepingPatches(t, nc)
+	defer patches.Reset()
+
+	var logOutput bytes.Buffer
+	log.SetOutput(&logOutput)
+	defer log.SetOutput(os.Stderr)
+
+	nc.housekeep()
+
+	assert.Contains(t, logOutput.String(), "ORPHAN profile 00:0A:95:9D:68:99_static (orphan-uuid)")
+	assert.Contains(t, logOutput.String(), "DUPLICATE profile 00:0A:95:9D:68:16_dhcp (dup-uuid)")
+	for _, connection := range []*mockgnm.MockConnection{setup.duplicate, setup.orphan, setup.conflict} {
+		connection.AssertNotCalled(t, "Delete")
+	}
+}
+
+func Test_labelConflict(t *testing.T) {
+	device := housekeepingDevice{mac: "00:0A:95:9D:68:16", interfaceName: "eth0"}
+	labelMap := map[string]string{"X1": "ETH0", "X2": "ETH1"}
+
+	assert.Empty(t, labelConflict(profileInfo{id: "x1_static"}, device, labelMap))
+	assert.Empty(t, labelConflict(profileInfo{id: "00:0A:95:9D:68:16_dhcp"}, device, labelMap), "MAC based profiles have no label")
+	assert.NotEmpty(t, labelConflict(profileInfo{id: "X2_static"}, device, labelMap), "The label is mapped to another interface")
+	assert.NotEmpty(t, labelConflict(profileInfo{id: "x3_dhcp"}, device, labelMap), "The label is not in the label map")
+	assert.Empty(t, labelConflict(profileInfo{id: "x3_dhcp"}, device, nil), "Without a label map nothing should be reported")
+}
+
+func Test_duplicateProfiles_KeepsMostRecentWithoutActiveProfile(t *testing.T) {
+	device := housekeepingDevice{interfaceName: "eth0"}
+	profiles := []profileInfo{{id: "old", timestamp: 1}, {id: "new", timestamp: 3}, {id: "older", timestamp: 0}}
+
+	findings := duplicateProfiles(profiles, device)
+
+	assert.Len(t, findings, 2)
+	for _, entry := range findings {
+		assert.NotEqual(t, "new", entry.finding.ID)
+		assert.Equal(t, v1.ProfileFinding_DUPLICATE, entry.finding.Kind)
+	}
+	assert.Nil(t, duplicateProfiles(profiles[:1], device))
+}
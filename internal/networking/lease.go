@@ -0,0 +1,159 @@
+/*
+ * Copyright © Siemens 2025. ALL RIGHTS RESERVED.
+ * Licensed under the MIT license
+ * See LICENSE file in the top-level directory
+ */
+
+package networking
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	v1 "networkservice/api/siemens_iedge_dmapi_v1"
+	"strconv"
+	"time"
+
+	nm "github.com/Wifx/gonetworkmanager/v2"
+)
+
+// leaseTimeout is the time a renewed DHCP lease has to be obtained in.
+const leaseTimeout = 30 * time.Second
+
+var (
+	// ErrInterfaceNotFound is returned if the interface of a lease request does not exist.
+	ErrInterfaceNotFound = errors.New("interface not found")
+	// ErrNoDHCP is returned if the profile of the interface does not use DHCP.
+	ErrNoDHCP = errors.New("interface does not use DHCP")
+	// ErrNoLease is returned if the interface has no DHCP lease, or did not obtain one in time.
+	ErrNoLease = errors.New("no DHCP lease")
+)
+
+// parseDHCPLease converts the DHCP configuration of an active connection, it returns nil without lease.
+// NetworkManager reports the received options by name, e.g. "ntp_servers", and adds "expiry".
+func parseDHCPLease(config nm.DHCP4Config) *v1.DHCPLease {
+	if config == nil {
+		return nil
+	}
+	options, err := config.GetPropertyOptions()
+	if err != nil || options[IPAddressKey] == nil {
+		return nil
+	}
+
+	lease := &v1.DHCPLease{Options: make(map[string]string, len(options))}
+	for key, value := range options {
+		lease.Options[key] = fmt.Sprint(value)
+	}
+	lease.Server = lease.Options[DHCPServerIdentifierKey]
+	if leaseTime, err := strconv.ParseUint(lease.Options[DHCPLeaseTimeKey], 10, 32); err == nil {
+		lease.LeaseTime = uint32(leaseTime)
+	}
+	if expiry, err := strconv.ParseInt(lease.Options[DHCPExpiryKey], 10, 64); err == nil {
+		lease.Expiry = expiry
+	}
+	return lease
+}
+
+// RenewDHCPLease restarts the DHCP client of the interface and waits for the new lease. NetworkManager has no call
+// to renew a lease, so the profile is activated again, its DHCP client then requests the previous address.
+func (nc *NetworkConfigurator) RenewDHCPLease(element *v1.Interface) (*v1.DHCPLease, error) {
+	unlock := nc.LockForApply(&v1.NetworkSettings{Interfaces: []*v1.Interface{element}})
+	defer unlock()
+	defer nc.InvalidateSnapshot()
+
+	device, connection, err := nc.dhcpProfile(element)
+	if err != nil {
+		return nil, err
+	}
+	active, err := nc.gnm.ActivateConnection(connection, device, nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not activate connection: %w", err)
+	}
+	nc.fallback.setReleased(desiredKey(element), false)
+
+	var lease *v1.DHCPLease
+	err = poll(leaseTimeout, func(_ time.Time) error {
+		if state, err := active.GetPropertyState(); err != nil || state != nm.NmActiveConnectionStateActivated {
+			return ErrNoLease
+		}
+		config, _ := active.GetPropertyDHCP4Config()
+		if lease = parseDHCPLease(config); lease == nil {
+			return ErrNoLease
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%w within %v: %v", ErrNoLease, leaseTimeout, interfaceIdentifier(element))
+	}
+	log.Printf("DHCP lease of %v renewed: %v from %v", interfaceIdentifier(element), lease.Options[IPAddressKey], lease.Server)
+	return lease, nil
+}
+
+// ReleaseDHCPLease sends a DHCPRELEASE to the server of the lease and deactivates the profile, NetworkManager then
+// stops its DHCP client and removes the address. The interface stays without address until the lease is renewed
+// or settings are applied, the fallback supervisor leaves it alone meanwhile.
+func (nc *NetworkConfigurator) ReleaseDHCPLease(element *v1.Interface) error {
+	unlock := nc.LockForApply(&v1.NetworkSettings{Interfaces: []*v1.Interface{element}})
+	defer unlock()
+	defer nc.InvalidateSnapshot()
+
+	device, _, err := nc.dhcpProfile(element)
+	if err != nil {
+		return err
+	}
+	active, err := device.GetPropertyActiveConnection()
+	if err != nil || active == nil {
+		return fmt.Errorf("%w: %v is not active", ErrNoLease, interfaceIdentifier(element))
+	}
+
+	config, _ := active.GetPropertyDHCP4Config()
+	if lease := parseDHCPLease(config); lease != nil {
+		interfaceName, _ := device.GetPropertyInterface()
+		hwAddress, _ := device.GetPropertyHwAddress()
+		mac, _ := net.ParseMAC(hwAddress)
+		client, server := net.ParseIP(lease.Options[IPAddressKey]), net.ParseIP(lease.Server)
+		if err := sendDHCPRelease(interfaceName, mac, client, server); err != nil {
+			log.Printf("DHCP release of %v could not be sent: %v", interfaceIdentifier(element), err)
+		}
+	}
+
+	nc.fallback.setReleased(desiredKey(element), true)
+	if err := nc.gnm.DeactivateConnection(active); err != nil {
+		nc.fallback.setReleased(desiredKey(element), false)
+		return fmt.Errorf("could not deactivate connection: %w", err)
+	}
+	log.Printf("DHCP lease of %v released", interfaceIdentifier(element))
+	return nil
+}
+
+// dhcpProfile returns the device of the interface and its profile, the active one or the one of this service.
+// It fails if the profile does not use DHCP.
+func (nc *NetworkConfigurator) dhcpProfile(element *v1.Interface) (nm.DeviceWired, nm.Connection, error) {
+	device, err := nc.getDeviceBy(element)
+	if err != nil {
+		return nil, nil, err
+	}
+	if device == nil {
+		return nil, nil, fmt.Errorf("%w: %v", ErrInterfaceNotFound, interfaceIdentifier(element))
+	}
+
+	var connection nm.Connection
+	if active, err := device.GetPropertyActiveConnection(); err == nil && active != nil {
+		connection, _ = active.GetPropertyConnection()
+	}
+	if connection == nil {
+		connection, _ = serviceProfile(device)
+	}
+	if connection == nil {
+		return nil, nil, fmt.Errorf("%w: %v has no profile", ErrNoDHCP, interfaceIdentifier(element))
+	}
+	settings, err := connection.GetSettings()
+	if err != nil {
+		return nil, nil, err
+	}
+	if settings[IPV4Key][MethodKey] != Auto {
+		return nil, nil, fmt.Errorf("%w: %v", ErrNoDHCP, interfaceIdentifier(element))
+	}
+	return device, connection, nil
+}
@@ -0,0 +1,111 @@
+/*
+ * Copyright © Siemens 2025. ALL RIGHTS RESERVED.
+ * Licensed under the MIT license
+ * See LICENSE file in the top-level directory
+ */
+
+package networking
+
+import (
+	"net"
+	v1 "networkservice/api/siemens_iedge_dmapi_v1"
+	mockgnm "networkservice/internal/networking/mocks/gonetworkmanager"
+	"testing"
+
+	nm "github.com/Wifx/gonetworkmanager/v2"
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func getMockLeaseOptions() nm.DHCP4Options {
+	return nm.DHCP4Options{IPAddressKey: "192.168.0.20", DHCPServerIdentifierKey: "192.168.0.1", DHCPLeaseTimeKey: "3600",
+		DHCPExpiryKey: "1760000000", "ntp_servers": "192.168.0.5", "vendor_encapsulated_options": "01:04:0a:00:00:01"}
+}
+
+// getMockLeaseDevice returns a device with a DHCP profile, which is active with the given DHCP options.
+func getMockLeaseDevice(options nm.DHCP4Options) (*mockgnm.MockDeviceWired, *mockgnm.MockConnection, *mockgnm.MockActiveConnection) {
+	device := new(mockgnm.MockDeviceWired)
+	connection := new(mockgnm.MockConnection)
+	active := new(mockgnm.MockActiveConnection)
+	device.On("GetPropertyActiveConnection").Return(active, nil)
+	device.On("GetPropertyInterface").Return("ens18", nil)
+	device.On("GetPropertyHwAddress").Return("00:11:22:33:44:55", nil)
+	active.On("GetPropertyConnection").Return(connection, nil)
+	active.On("GetPropertyState").Return(nm.NmActiveConnectionStateActivated, nil)
+	active.On("GetPropertyDHCP4Config").Return(mockDHCP4Config{options}, nil)
+	connection.On("GetSettings").Return(newSettingsFromProto(&v1.Interface{MacAddress: "00:11:22:33:44:55", DHCP: Enabled}, "ens18"), nil)
+	return device, connection, active
+}
+
+func Test_parseDHCPLease(t *testing.T) {
+	lease := parseDHCPLease(mockDHCP4Config{getMockLeaseOptions()})
+
+	assert.Equal(t, "192.168.0.1", lease.Server)
+	assert.Equal(t, uint32(3600), lease.LeaseTime)
+	assert.Equal(t, int64(1760000000), lease.Expiry)
+	assert.Equal(t, "192.168.0.5", lease.Options["ntp_servers"])
+	assert.Equal(t, "01:04:0a:00:00:01", lease.Options["vendor_encapsulated_options"], "Option 43 should be reported")
+
+	assert.Nil(t, parseDHCPLease(mockDHCP4Config{nm.DHCP4Options{}}), "A DHCP client without address has no lease")
+	assert.Nil(t, parseDHCPLease(nil))
+}
+
+func Test_RenewDHCPLease(t *testing.T) {
+	device, connection, active := getMockLeaseDevice(getMockLeaseOptions())
+	mockNetworkManager := &mockgnm.MockNetworkManager{}
+	mockNetworkManager.On("ActivateConnection", connection, device, mock.Anything).Return(active, nil)
+	nc := &NetworkConfigurator{gnm: mockNetworkManager, locks: newApplyLocks(), fallback: newFallbackSupervisor()}
+	nc.fallback.setReleased("00:11:22:33:44:55", true)
+	patches := gomonkey.ApplyPrivateMethod(nc, "getDeviceBy", func(_ *NetworkConfigurator, _ *v1.Interface) (nm.DeviceWired, error) {
+		return device, nil
+	})
+	defer patches.Reset()
+
+	lease, err := nc.RenewDHCPLease(&v1.Interface{MacAddress: "00:11:22:33:44:55"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "192.168.0.1", lease.Server)
+	assert.False(t, nc.fallback.isReleased("00:11:22:33:44:55"), "A renewed interface should be supervised again")
+}
+
+func Test_ReleaseDHCPLease(t *testing.T) {
+	device, _, active := getMockLeaseDevice(getMockLeaseOptions())
+	mockNetworkManager := &mockgnm.MockNetworkManager{}
+	mockNetworkManager.On("DeactivateConnection", active).Return(nil)
+	nc := &NetworkConfigurator{gnm: mockNetworkManager, locks: newApplyLocks(), fallback: newFallbackSupervisor()}
+	var releasedAddress, server net.IP
+	patches := gomonkey.ApplyPrivateMethod(nc, "getDeviceBy", func(_ *NetworkConfigurator, _ *v1.Interface) (nm.DeviceWired, error) {
+		return device, nil
+	})
+	patches.ApplyFunc(sendDHCPRelease, func(_ string, _ net.HardwareAddr, client, serverIP net.IP) error {
+		releasedAddress, server = client, serverIP
+		return nil
+	})
+	defer patches.Reset()
+
+	assert.NoError(t, nc.ReleaseDHCPLease(&v1.Interface{MacAddress: "00:11:22:33:44:55"}))
+	assert.Equal(t, "192.168.0.20", releasedAddress.String())
+	assert.Equal(t, "192.168.0.1", server.String())
+	assert.True(t, nc.fallback.isReleased("00:11:22:33:44:55"), "The fallback supervisor should leave a released interface alone")
+}
+
+func Test_dhcpProfile_Static(t *testing.T) {
+	device, connection, _ := getMockLeaseDevice(nil)
+	connection.ExpectedCalls = nil
+	connection.On("GetSettings").Return(newSettingsFromProto(&v1.Interface{MacAddress: "00:11:22:33:44:55", DHCP: Disabled,
+		Static: &v1.Interface_StaticConf{IPv4: "192.168.0.20", NetMask: "255.255.255.0"}}, "ens18"), nil)
+	nc := &NetworkConfigurator{}
+	patches := gomonkey.ApplyPrivateMethod(nc, "getDeviceBy", func(_ *NetworkConfigurator, element *v1.Interface) (nm.DeviceWired, error) {
+		if element.Label == "X9" {
+			return nil, nil
+		}
+		return device, nil
+	})
+	defer patches.Reset()
+
+	_, _, err := nc.dhcpProfile(&v1.Interface{MacAddress: "00:11:22:33:44:55"})
+	assert.ErrorIs(t, err, ErrNoDHCP)
+	_, _, err = nc.dhcpProfile(&v1.Interface{Label: "X9"})
+	assert.ErrorIs(t, err, ErrInterfaceNotFound)
+}
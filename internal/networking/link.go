@@ -0,0 +1,179 @@
+/*
+ * Copyright © Siemens 2025. ALL RIGHTS RESERVED.
+ * Licensed under the MIT license
+ * See LICENSE file in the top-level directory
+ */
+
+package networking
+
+import (
+	"fmt"
+	"net"
+	v1 "networkservice/api/siemens_iedge_dmapi_v1"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	nm "github.com/Wifx/gonetworkmanager/v2"
+	"google.golang.org/protobuf/proto"
+)
+
+// Flags of the wake-on-lan setting of NetworkManager.
+const (
+	wakeOnLanDefault = 0x1
+	wakeOnLanIgnore  = 0x8000
+)
+
+// wakeOnLanFlags maps the wake-on-lan values of the API to the flags of NetworkManager.
+var wakeOnLanFlags = map[string]uint32{"default": wakeOnLanDefault, "phy": 0x2, "unicast": 0x4, "multicast": 0x8,
+	"broadcast": 0x10, "arp": 0x20, "magic": 0x40, "ignore": wakeOnLanIgnore}
+
+// wakeOnLanOrder is the order the wake-on-lan values are read back in.
+var wakeOnLanOrder = []string{"default", "phy", "unicast", "multicast", "broadcast", "arp", "magic", "ignore"}
+
+// clonedMACKeywords are the values of ClonedMAC, which NetworkManager resolves itself.
+var clonedMACKeywords = []string{"preserve", "permanent", "random", "stable"}
+
+// linkSpeeds are the Ethernet speeds in Mbit/s, which can be forced or advertised.
+var linkSpeeds = []uint32{10, 100, 1000, 2500, 5000, 10000, 25000, 40000, 50000, 100000}
+
+// putLink puts the Ethernet link settings which are given.
+func putLink(protoData *v1.Interface, connection nm.ConnectionSettings) {
+	link := protoData.GetLink()
+	if link == nil {
+		return
+	}
+	ethernet := connection[EthernetType]
+	if link.MTU != 0 {
+		ethernet[MTUKey] = link.MTU
+	}
+	if link.Speed != 0 {
+		ethernet[SpeedKey] = link.Speed
+	}
+	if link.Duplex != "" {
+		ethernet[DuplexKey] = link.Duplex
+	}
+	if link.AutoNegotiate != "" {
+		ethernet[AutoNegotiateKey] = link.AutoNegotiate == Enabled
+	}
+	if link.ClonedMAC != "" {
+		ethernet[ClonedMACAddressKey] = link.ClonedMAC
+	}
+	if len(link.WakeOnLan) > 0 {
+		ethernet[WakeOnLanKey] = wakeOnLanMask(link.WakeOnLan)
+	}
+}
+
+// wakeOnLanMask returns the NetworkManager flags of the given wake-on-lan values, without values the default one.
+func wakeOnLanMask(values []string) uint32 {
+	if len(values) == 0 {
+		return wakeOnLanDefault
+	}
+	var flags uint32
+	for _, value := range values {
+		flags |= wakeOnLanFlags[value]
+	}
+	return flags
+}
+
+// parseLink reads the Ethernet link settings of the profile, it returns nil if the profile keeps all defaults.
+// NetworkManager leaves the link as it is without speed and duplex, so auto-negotiate is only reported with them
+// or if it is turned on.
+func parseLink(connection nm.ConnectionSettings) *v1.Interface_LinkConf {
+	ethernet := connection[EthernetType]
+	link := &v1.Interface_LinkConf{
+		MTU:       uint32(settingInt(ethernet[MTUKey], 0)),
+		Speed:     uint32(settingInt(ethernet[SpeedKey], 0)),
+		Duplex:    settingString(ethernet[DuplexKey]),
+		ClonedMAC: settingString(ethernet[ClonedMACAddressKey]),
+	}
+	if autoNegotiate := settingInt(ethernet[AutoNegotiateKey], 0) != 0; autoNegotiate || link.Speed != 0 || link.Duplex != "" {
+		link.AutoNegotiate = enabledString(autoNegotiate)
+	}
+	if flags := uint32(settingInt(ethernet[WakeOnLanKey], wakeOnLanDefault)); flags != wakeOnLanDefault {
+		for _, value := range wakeOnLanOrder {
+			if flags&wakeOnLanFlags[value] != 0 {
+				link.WakeOnLan = append(link.WakeOnLan, value)
+			}
+		}
+	}
+	if proto.Equal(link, &v1.Interface_LinkConf{}) {
+		return nil
+	}
+	return link
+}
+
+// readLinkStatus reads the live link values of the interface from the given sysfs directory, e.g: /sys/class/net.
+// It returns nil if the interface is not there. Speed and duplex can not be read without link.
+func readLinkStatus(root, interfaceName string) *v1.Interface_LinkState {
+	if interfaceName == "" {
+		return nil
+	}
+	read := func(name string) string {
+		value, _ := os.ReadFile(filepath.Join(root, interfaceName, name))
+		return strings.TrimSpace(string(value))
+	}
+	hwAddress := read("address")
+	if hwAddress == "" {
+		return nil
+	}
+
+	status := &v1.Interface_LinkState{HwAddress: strings.ToUpper(hwAddress), Carrier: read("carrier") == "1", Duplex: read("duplex")}
+	if mtu, err := strconv.ParseUint(read("mtu"), 10, 32); err == nil {
+		status.MTU = uint32(mtu)
+	}
+	if speed, err := strconv.ParseInt(read("speed"), 10, 64); err == nil && speed > 0 {
+		status.Speed = uint32(speed)
+	}
+	if !status.Carrier || status.Duplex == "" {
+		status.Duplex = "unknown"
+	}
+	return status
+}
+
+// verifyLink checks the Ethernet link settings of the interface.
+func verifyLink(element *v1.Interface, result *verifyResult) {
+	link := element.GetLink()
+	if link == nil {
+		return
+	}
+	identifier := interfaceIdentifier(element)
+	fail := func(format string, args ...interface{}) {
+		result.retVal = false
+		result.builder.WriteString(fmt.Sprintf(format+" \n", args...))
+	}
+
+	if link.MTU != 0 && (link.MTU < 68 || link.MTU > 65535) {
+		fail("MTU of %s has to be between 68 and 65535, not %d", identifier, link.MTU)
+	}
+	if link.Speed != 0 && !slices.Contains(linkSpeeds, link.Speed) {
+		fail("speed of %s has to be one of %v, not %d", identifier, linkSpeeds, link.Speed)
+	}
+	if link.Duplex != "" && link.Duplex != Full && link.Duplex != Half {
+		fail("duplex of %s has to be %s or %s, not %s", identifier, Full, Half, link.Duplex)
+	}
+	if (link.Speed != 0) != (link.Duplex != "") {
+		fail("speed and duplex of %s have to be given together", identifier)
+	}
+	if link.AutoNegotiate != "" && link.AutoNegotiate != Enabled && link.AutoNegotiate != Disabled {
+		fail("AutoNegotiate of %s has to be %s or %s, not %s", identifier, Enabled, Disabled, link.AutoNegotiate)
+	}
+	if link.AutoNegotiate == Disabled && link.Speed == 0 {
+		fail("a link of %s without auto-negotiation needs speed and duplex", identifier)
+	}
+	if link.ClonedMAC != "" && !slices.Contains(clonedMACKeywords, link.ClonedMAC) {
+		if mac, err := net.ParseMAC(link.ClonedMAC); err != nil || len(mac) != 6 || mac[0]&1 != 0 {
+			fail("wrong cloned MAC address %s of %s", link.ClonedMAC, identifier)
+		}
+	}
+	for _, value := range link.WakeOnLan {
+		if _, ok := wakeOnLanFlags[value]; !ok {
+			fail("unknown wake-on-lan value %s of %s", value, identifier)
+		}
+		if (value == "default" || value == "ignore") && len(link.WakeOnLan) > 1 {
+			fail("wake-on-lan value %s of %s can not be combined", value, identifier)
+		}
+	}
+}
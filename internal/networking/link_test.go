@@ -0,0 +1,96 @@
+/*
+ * Copyright © Siemens 2025. ALL RIGHTS RESERVED.
+ * Licensed under the MIT license
+ * See LICENSE file in the top-level directory
+ */
+
+package networking
+
+import (
+	v1 "networkservice/api/siemens_iedge_dmapi_v1"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+func Test_Link_RoundTrip(t *testing.T) {
+	link := &v1.Interface_LinkConf{MTU: 9000, Speed: 100, Duplex: Full, AutoNegotiate: Disabled, ClonedMAC: "02:11:22:33:44:55",
+		WakeOnLan: []string{"magic", "broadcast"}}
+	settings := newSettingsFromProto(&v1.Interface{MacAddress: "00:0A:95:9D:68:16", DHCP: Enabled, Link: link}, "eth0")
+
+	assert.Equal(t, uint32(9000), settings[EthernetType][MTUKey])
+	assert.Equal(t, false, settings[EthernetType][AutoNegotiateKey])
+	assert.Equal(t, uint32(0x50), settings[EthernetType][WakeOnLanKey])
+
+	result := convertToProto(settings, nil, "00:0A:95:9D:68:16", &InterfaceMask{paths: maskTree{FieldLink: {}}})
+	link.WakeOnLan = []string{"broadcast", "magic"}
+	assert.True(t, proto.Equal(link, result.Link), "Link settings should be read back: %v", result.Link)
+
+	// NetworkManager returns the defaults of the 802-3-ethernet setting
+	defaults := newSettingsFromProto(&v1.Interface{MacAddress: "00:0A:95:9D:68:16", DHCP: Enabled}, "eth0")
+	defaults[EthernetType][MTUKey], defaults[EthernetType][AutoNegotiateKey], defaults[EthernetType][WakeOnLanKey] = uint32(0), false, uint32(1)
+	assert.Nil(t, parseLink(defaults), "Defaults should not be reported as link settings")
+}
+
+func Test_mergeSettings_Link(t *testing.T) {
+	current := getMockCustomizedSettings()
+	desired := newSettingsFromProto(&v1.Interface{MacAddress: "00:0A:95:9D:68:16", DHCP: Enabled,
+		Link: &v1.Interface_LinkConf{ClonedMAC: "permanent"}}, "eth0")
+
+	merged := mergeSettings(current, desired)
+	assert.Equal(t, uint32(1400), merged[EthernetType][MTUKey], "The MTU should be kept, it is not given")
+	assert.Equal(t, "permanent", merged[EthernetType][ClonedMACAddressKey])
+	assert.True(t, equivalentSettings(merged, desired))
+
+	desired[EthernetType][MTUKey] = uint32(9000)
+	assert.False(t, equivalentSettings(merged, desired), "A changed MTU should be applied")
+}
+
+func Test_readLinkStatus(t *testing.T) {
+	root := t.TempDir()
+	device := filepath.Join(root, "ens18")
+	assert.NoError(t, os.Mkdir(device, 0755))
+	for name, value := range map[string]string{"address": "02:11:22:33:44:55\n", "carrier": "1\n", "speed": "100\n", "duplex": "full\n", "mtu": "9000\n"} {
+		assert.NoError(t, os.WriteFile(filepath.Join(device, name), []byte(value), 0644))
+	}
+
+	status := readLinkStatus(root, "ens18")
+	assert.Equal(t, &v1.Interface_LinkState{Carrier: true, Speed: 100, Duplex: Full, MTU: 9000, HwAddress: "02:11:22:33:44:55"}, status)
+
+	// without link the kernel reports speed -1, or fails to read it
+	assert.NoError(t, os.WriteFile(filepath.Join(device, "carrier"), []byte("0\n"), 0644))
+	assert.NoError(t, os.WriteFile(filepath.Join(device, "speed"), []byte("-1\n"), 0644))
+	status = readLinkStatus(root, "ens18")
+	assert.False(t, status.Carrier)
+	assert.Zero(t, status.Speed)
+	assert.Equal(t, "unknown", status.Duplex)
+
+	assert.Nil(t, readLinkStatus(root, "ens19"))
+}
+
+func Test_verifyLink(t *testing.T) {
+	result := createMockVerifyResult(true)
+	verifyLink(&v1.Interface{Label: "X1", Link: &v1.Interface_LinkConf{MTU: 9000, Speed: 100, Duplex: Full, AutoNegotiate: Disabled,
+		ClonedMAC: "02:11:22:33:44:55", WakeOnLan: []string{"magic"}}}, result)
+	verifyLink(&v1.Interface{Label: "X2", Link: &v1.Interface_LinkConf{AutoNegotiate: Enabled, ClonedMAC: "stable", WakeOnLan: []string{"ignore"}}}, result)
+	assert.True(t, result.retVal, result.builder.String())
+
+	for name, link := range map[string]*v1.Interface_LinkConf{
+		"mtu":                  {MTU: 65536},
+		"speed":                {Speed: 1500, Duplex: Full},
+		"duplex":               {Speed: 100, Duplex: "auto"},
+		"speed only":           {Speed: 100},
+		"auto negotiate":       {AutoNegotiate: "on"},
+		"forced without speed": {AutoNegotiate: Disabled},
+		"cloned mac":           {ClonedMAC: "01:11:22:33:44:55"},
+		"wake on lan":          {WakeOnLan: []string{"pattern"}},
+		"wake on lan default":  {WakeOnLan: []string{"default", "magic"}},
+	} {
+		result := createMockVerifyResult(true)
+		verifyLink(&v1.Interface{Label: "X1", Link: link}, result)
+		assert.False(t, result.retVal, name)
+	}
+}
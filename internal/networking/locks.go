@@ -0,0 +1,130 @@
+/*
+ * Copyright © Siemens 2025. ALL RIGHTS RESERVED.
+ * Licensed under the MIT license
+ * See LICENSE file in the top-level directory
+ */
+
+package networking
+
+import (
+	"log"
+	v1 "networkservice/api/siemens_iedge_dmapi_v1"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// macAddressMask selects only the MAC address of an interface.
+var macAddressMask = &InterfaceMask{paths: maskTree{FieldMacAddress: {}}}
+
+// applyLocks serialises applies which touch the same device.
+// Applies to disjoint devices hold the global lock shared and run in parallel, applies which change
+// all devices, e.g. the gateway metric or the label map, hold the global lock exclusively.
+type applyLocks struct {
+	global  sync.RWMutex
+	mu      sync.Mutex
+	devices map[string]*sync.Mutex
+}
+
+func newApplyLocks() *applyLocks {
+	return &applyLocks{devices: make(map[string]*sync.Mutex)}
+}
+
+// device returns the lock of the device with the given MAC address, creating it if needed.
+func (l *applyLocks) device(mac string) *sync.Mutex {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	lock, ok := l.devices[mac]
+	if !ok {
+		lock = &sync.Mutex{}
+		l.devices[mac] = lock
+	}
+	return lock
+}
+
+// LockForApply acquires the locks needed to apply the given settings and returns the function releasing them.
+// Reads are not blocked by these locks, they are served from the snapshot.
+func (nc *NetworkConfigurator) LockForApply(newSettings *v1.NetworkSettings) func() {
+	macs, exclusive := nc.devicesToLock(newSettings)
+	if exclusive {
+		log.Println("Acquiring exclusive apply lock")
+		return nc.LockAll()
+	}
+
+	nc.locks.global.RLock()
+	// Devices are always locked in the same order to avoid deadlocks between concurrent applies.
+	sort.Strings(macs)
+	deviceLocks := make([]*sync.Mutex, 0, len(macs))
+	for _, mac := range macs {
+		lock := nc.locks.device(mac)
+		lock.Lock()
+		deviceLocks = append(deviceLocks, lock)
+	}
+	log.Println("Acquired apply lock for devices: ", macs)
+
+	return func() {
+		for i := len(deviceLocks) - 1; i >= 0; i-- {
+			deviceLocks[i].Unlock()
+		}
+		nc.locks.global.RUnlock()
+	}
+}
+
+// LockAll acquires the global apply lock exclusively and returns the function releasing it.
+// It is used for changes to the profiles of all devices, e.g. the profile cleanup.
+func (nc *NetworkConfigurator) LockAll() func() {
+	nc.locks.global.Lock()
+	return nc.locks.global.Unlock
+}
+
+// devicesToLock returns the MAC addresses of the devices changed by the given settings.
+// exclusive is true if the settings change all devices or a device can not be resolved.
+func (nc *NetworkConfigurator) devicesToLock(newSettings *v1.NetworkSettings) (macs []string, exclusive bool) {
+	// The resource version of the whole settings covers all devices and the label map.
+	if len(newSettings.LabelMap) != 0 || newSettings.ResourceVersion != "" {
+		return nil, true
+	}
+	// Bonds change the profiles of all their members.
+	if newSettings.Bonds != nil {
+		return nil, true
+	}
+	// A failed connectivity check rolls back the checkpoint of all devices, including concurrent applies.
+	if len(newSettings.GetOptions().GetChecks()) != 0 {
+		return nil, true
+	}
+
+	seen := make(map[string]bool)
+	for _, element := range newSettings.Interfaces {
+		// ConfigureExistingGatewayInterfacesExceptProtoData changes the route metric of every device.
+		if element.GatewayInterface {
+			return nil, true
+		}
+
+		mac := nc.resolveMac(element)
+		if mac == "" {
+			return nil, true
+		}
+		if !seen[mac] {
+			seen[mac] = true
+			macs = append(macs, mac)
+		}
+	}
+	return macs, false
+}
+
+// resolveMac returns the upper case MAC address of the device the given interface settings refer to.
+func (nc *NetworkConfigurator) resolveMac(element *v1.Interface) string {
+	if element.MacAddress != "" {
+		return strings.ToUpper(element.MacAddress)
+	}
+	if element.Label == "" {
+		return ""
+	}
+
+	iface := nc.GetInterfaceWithLabel(element.Label, macAddressMask)
+	if iface == nil {
+		return ""
+	}
+	return strings.ToUpper(iface.MacAddress)
+}
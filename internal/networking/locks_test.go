@@ -0,0 +1,95 @@
+/*
+ * Copyright © Siemens 2025. ALL RIGHTS RESERVED.
+ * Licensed under the MIT license
+ * See LICENSE file in the top-level directory
+ */
+
+package networking
+
+import (
+	v1 "networkservice/api/siemens_iedge_dmapi_v1"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const lockWaitTimeout = 100 * time.Millisecond
+
+func getLockConfigurator() *NetworkConfigurator {
+	nc := getSnapshotConfigurator(getMockSnapshot())
+	nc.locks = newApplyLocks()
+	return nc
+}
+
+// acquiredWithin reports whether LockForApply returns within lockWaitTimeout, the lock is released afterwards.
+func acquiredWithin(nc *NetworkConfigurator, settings *v1.NetworkSettings) bool {
+	acquired := make(chan func(), 1)
+	go func() {
+		acquired <- nc.LockForApply(settings)
+	}()
+
+	select {
+	case unlock := <-acquired:
+		unlock()
+		return true
+	case <-time.After(lockWaitTimeout):
+		// Release the lock once the pending apply gets it.
+		go func() { (<-acquired)() }()
+		return false
+	}
+}
+
+func Test_LockForApply_DisjointDevicesRunInParallel(t *testing.T) {
+	nc := getLockConfigurator()
+
+	unlock := nc.LockForApply(&v1.NetworkSettings{Interfaces: []*v1.Interface{{MacAddress: "00:0A:95:9D:68:16"}}})
+	defer unlock()
+
+	assert.True(t, acquiredWithin(nc, &v1.NetworkSettings{Interfaces: []*v1.Interface{{MacAddress: "00:0A:95:9D:68:17"}}}),
+		"Applies to different devices should not block each other")
+}
+
+func Test_LockForApply_SameDeviceIsSerialised(t *testing.T) {
+	nc := getLockConfigurator()
+
+	unlock := nc.LockForApply(&v1.NetworkSettings{Interfaces: []*v1.Interface{{MacAddress: "00:0A:95:9D:68:16"}}})
+
+	assert.False(t, acquiredWithin(nc, &v1.NetworkSettings{Interfaces: []*v1.Interface{{Label: "X1"}}}),
+		"An apply to the same device by label should wait for the running apply")
+	unlock()
+	assert.True(t, acquiredWithin(nc, &v1.NetworkSettings{Interfaces: []*v1.Interface{{Label: "X1"}}}))
+}
+
+func Test_LockForApply_GatewayInterfaceTakesGlobalLock(t *testing.T) {
+	nc := getLockConfigurator()
+
+	unlock := nc.LockForApply(&v1.NetworkSettings{Interfaces: []*v1.Interface{{MacAddress: "00:0A:95:9D:68:16", GatewayInterface: true}}})
+
+	assert.False(t, acquiredWithin(nc, &v1.NetworkSettings{Interfaces: []*v1.Interface{{MacAddress: "00:0A:95:9D:68:17"}}}),
+		"Changing the gateway interface should block applies to all devices")
+	assert.NotNil(t, nc.GetInterfaceWithMac("00:0A:95:9D:68:17", nil), "Reads should not be blocked by applies")
+	unlock()
+}
+
+func Test_devicesToLock(t *testing.T) {
+	nc := getLockConfigurator()
+
+	macs, exclusive := nc.devicesToLock(&v1.NetworkSettings{Interfaces: []*v1.Interface{
+		{MacAddress: "00:0a:95:9d:68:17"},
+		{Label: "x1"},
+		{MacAddress: "00:0A:95:9D:68:17"},
+	}})
+	assert.False(t, exclusive)
+	assert.Equal(t, []string{"00:0A:95:9D:68:17", "00:0A:95:9D:68:16"}, macs)
+
+	_, exclusive = nc.devicesToLock(&v1.NetworkSettings{LabelMap: map[string]string{"X1": "eth0"}})
+	assert.True(t, exclusive, "Changing the label map should take the global lock")
+
+	_, exclusive = nc.devicesToLock(&v1.NetworkSettings{Interfaces: []*v1.Interface{{Label: "X3"}}})
+	assert.True(t, exclusive, "Unknown labels should take the global lock")
+
+	_, exclusive = nc.devicesToLock(&v1.NetworkSettings{Interfaces: []*v1.Interface{{Label: "x1"}},
+		Options: &v1.ApplyOptions{Checks: []*v1.ConnectivityCheck{{Type: v1.ConnectivityCheck_GATEWAY}}}})
+	assert.True(t, exclusive, "Connectivity checks should take the global lock, their rollback covers all devices")
+}
@@ -0,0 +1,192 @@
+/*
+ * Copyright © Siemens 2025. ALL RIGHTS RESERVED.
+ * Licensed under the MIT license
+ * See LICENSE file in the top-level directory
+ */
+
+package networking
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	v1 "networkservice/api/siemens_iedge_dmapi_v1"
+	"strings"
+)
+
+// ErrManagementChange is returned for settings, which change the interface the caller is connected through.
+var ErrManagementChange = errors.New("settings change the management interface")
+
+// managementMask selects the fields needed to find the management interface and to check changes of it.
+var managementMask = &InterfaceMask{paths: maskTree{FieldMacAddress: {}, FieldDHCP: {}, FieldStatic: {}, FieldInterfaceName: {},
+	FieldGatewayInterface: {}, FieldVlans: {}, FieldBond: {}}}
+
+// Management is the interface, bond or VLAN, which has the local address of a TCP connection.
+type Management struct {
+	// Name is the interface name of the interface, bond or VLAN.
+	Name string
+	// Settings holds the address settings. The ones of a bond or VLAN are the gateway interface if they have a gateway.
+	Settings *v1.Interface
+	// Device is the Ethernet interface with the address, or the parent of the VLAN. It is nil for a bond.
+	Device *v1.Interface
+	// Bond is the name of the bond with the address.
+	Bond string
+	// Vlan is the VLAN with the address.
+	Vlan *v1.Interface_VlanConf
+}
+
+// ManagementInterface returns the interface, bond or VLAN, which has the local address of a TCP connection. It is nil
+// for unix socket and loopback connections, they do not depend on the network settings.
+func (nc *NetworkConfigurator) ManagementInterface(local net.Addr) *Management {
+	tcp, ok := local.(*net.TCPAddr)
+	if !ok || tcp.IP == nil || tcp.IP.IsLoopback() {
+		return nil
+	}
+	settings := nc.GetNetworkSettings(managementMask)
+	for _, element := range settings.Interfaces {
+		if hasAddress(element.GetStatic(), tcp.IP) {
+			return &Management{Name: element.InterfaceName, Settings: element, Device: element}
+		}
+		for _, vlan := range element.GetVlans().GetInterfaces() {
+			if hasAddress(vlan.GetStatic(), tcp.IP) {
+				return &Management{Name: vlan.Name, Settings: addressSettings(vlan.DHCP, vlan.Static), Device: element, Vlan: vlan}
+			}
+		}
+	}
+	for _, bond := range settings.GetBonds().GetInterfaces() {
+		if hasAddress(bond.GetStatic(), tcp.IP) {
+			return &Management{Name: bond.Name, Settings: addressSettings(bond.DHCP, bond.Static), Bond: bond.Name}
+		}
+	}
+	return nil
+}
+
+// hasAddress checks if the static settings have the given address.
+func hasAddress(static *v1.Interface_StaticConf, address net.IP) bool {
+	ip := net.ParseIP(static.GetIPv4())
+	return ip != nil && ip.Equal(address)
+}
+
+// addressSettings returns the address settings of a bond or VLAN like the ones of an interface. Their settings are
+// always given completely, without DHCP they are static.
+func addressSettings(dhcp string, static *v1.Interface_StaticConf) *v1.Interface {
+	if dhcp == "" {
+		dhcp = Disabled
+	}
+	return &v1.Interface{DHCP: dhcp, Static: static, GatewayInterface: static.GetGateway() != ""}
+}
+
+// ManagementChanges returns a description of every change of the new settings, which removes or changes the address
+// of the management interface, bond or VLAN, or takes away its default route. The default route is only needed, if
+// the client with the remote address is not in the network of the management interface.
+func (nc *NetworkConfigurator) ManagementChanges(newSettings *v1.NetworkSettings, management *Management, remote net.Addr) []string {
+	if management == nil {
+		return nil
+	}
+	needsGateway := management.Settings.GatewayInterface && !onLink(management.Settings, remote)
+	labelMap := importLabelMap(newSettings)
+
+	var changes []string
+	for _, element := range newSettings.Interfaces {
+		onDevice := management.Device != nil && isManagementInterface(element, management.Device, labelMap)
+		if onDevice && management.Vlan == nil {
+			changes = append(changes, settingsChanges(element, management, needsGateway)...)
+			continue
+		}
+		if onDevice && element.Vlans != nil {
+			changes = append(changes, vlanChanges(element.Vlans, management, needsGateway)...)
+		}
+		if element.GatewayInterface && needsGateway {
+			changes = append(changes, fmt.Sprintf("the default route would move from management interface %s to %s",
+				management.Name, interfaceIdentifier(element)))
+		}
+	}
+
+	// bonds are only changed if they are given
+	if bonds := newSettings.GetBonds(); bonds != nil {
+		kept := false
+		for _, bond := range bonds.Interfaces {
+			if bond.Name == management.Bond {
+				kept = true
+				changes = append(changes, settingsChanges(addressSettings(bond.DHCP, bond.Static), management, needsGateway)...)
+			}
+			if management.Device == nil || management.Device.Bond == bond.Name {
+				continue
+			}
+			for _, member := range bond.Members {
+				if isManagementInterface(memberInterface(member), management.Device, labelMap) {
+					changes = append(changes, fmt.Sprintf("management interface %s would become a member of bond %s",
+						management.Name, bond.Name))
+				}
+			}
+		}
+		if management.Bond != "" && !kept {
+			changes = append(changes, fmt.Sprintf("management bond %s would be removed", management.Name))
+		}
+	}
+	return changes
+}
+
+// vlanChanges describes how the VLANs of the parent change the management VLAN.
+func vlanChanges(vlans *v1.Interface_VlanList, management *Management, needsGateway bool) []string {
+	for _, vlan := range vlans.Interfaces {
+		if vlan.ID == management.Vlan.ID {
+			return settingsChanges(addressSettings(vlan.DHCP, vlan.Static), management, needsGateway)
+		}
+	}
+	return []string{fmt.Sprintf("management VLAN %s would be removed", management.Name)}
+}
+
+// settingsChanges describes how the settings change the address and the default route of the management interface.
+func settingsChanges(element *v1.Interface, management *Management, needsGateway bool) []string {
+	var changes []string
+	if change := addressChange(element, management); change != "" {
+		changes = append(changes, change)
+	}
+	if needsGateway && !usesDHCP(element.DHCP) && element.Static != nil && element.Static.Gateway == "" {
+		changes = append(changes, fmt.Sprintf("the default route of management interface %s would be removed", management.Name))
+	}
+	return changes
+}
+
+// addressChange describes how the settings change the address of the management interface, it is empty if they keep it.
+func addressChange(element *v1.Interface, management *Management) string {
+	address := management.Settings.GetStatic().GetIPv4()
+	switch {
+	case usesDHCP(element.DHCP) && !usesDHCP(management.Settings.DHCP):
+		return fmt.Sprintf("management interface %s would get an address by DHCP instead of %s", management.Name, address)
+	case element.DHCP == Disabled && element.Static.GetIPv4() == "":
+		return fmt.Sprintf("address %s of management interface %s would be removed", address, management.Name)
+	case !usesDHCP(element.DHCP) && element.Static.GetIPv4() != "" && !net.ParseIP(element.Static.IPv4).Equal(net.ParseIP(address)):
+		return fmt.Sprintf("address %s of management interface %s would change to %s", address, management.Name, element.Static.IPv4)
+	}
+	return ""
+}
+
+// isManagementInterface checks if the settings are applied to the management interface, by MAC address or by label.
+func isManagementInterface(element, management *v1.Interface, labelMap map[string]string) bool {
+	if element.MacAddress != "" {
+		return strings.EqualFold(element.MacAddress, management.MacAddress)
+	}
+	interfaceName := labelMap[strings.ToUpper(element.Label)]
+	return interfaceName != "" && strings.EqualFold(interfaceName, management.InterfaceName)
+}
+
+// onLink checks if the remote address is in the network of the interface.
+func onLink(element *v1.Interface, remote net.Addr) bool {
+	tcp, ok := remote.(*net.TCPAddr)
+	address := net.ParseIP(element.GetStatic().GetIPv4()).To4()
+	netMask := net.ParseIP(element.GetStatic().GetNetMask()).To4()
+	if !ok || address == nil || netMask == nil || tcp.IP.To4() == nil {
+		return false
+	}
+	mask := net.IPMask(netMask)
+	return address.Mask(mask).Equal(tcp.IP.To4().Mask(mask))
+}
+
+func interfaceIdentifier(element *v1.Interface) string {
+	if element.Label != "" {
+		return element.Label
+	}
+	return element.MacAddress
+}
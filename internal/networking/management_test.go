@@ -0,0 +1,156 @@
+/*
+ * Copyright © Siemens 2025. ALL RIGHTS RESERVED.
+ * Licensed under the MIT license
+ * See LICENSE file in the top-level directory
+ */
+
+package networking
+
+import (
+	"net"
+	v1 "networkservice/api/siemens_iedge_dmapi_v1"
+	"testing"
+
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+func getMockManagementInterface() *v1.Interface {
+	return &v1.Interface{MacAddress: "00:11:22:33:44:55", InterfaceName: "ens18", DHCP: Disabled, GatewayInterface: true,
+		Static: &v1.Interface_StaticConf{IPv4: "192.168.1.10", NetMask: "255.255.255.0", Gateway: "192.168.1.1"}}
+}
+
+func Test_ManagementChanges(t *testing.T) {
+	nc := &NetworkConfigurator{}
+	device := getMockManagementInterface()
+	management := &Management{Name: "ens18", Settings: device, Device: device}
+	remote := &net.TCPAddr{IP: net.ParseIP("10.0.0.5"), Port: 40000}
+	static := func(ipv4, gateway string) *v1.Interface_StaticConf {
+		return &v1.Interface_StaticConf{IPv4: ipv4, NetMask: "255.255.255.0", Gateway: gateway}
+	}
+
+	for name, test := range map[string]struct {
+		element *v1.Interface
+		changes int
+	}{
+		"same address":     {&v1.Interface{MacAddress: "00:11:22:33:44:55", DHCP: Disabled, Static: static("192.168.1.10", "192.168.1.1")}, 0},
+		"other interface":  {&v1.Interface{MacAddress: "00:11:22:33:44:66", DHCP: Enabled}, 0},
+		"partial update":   {&v1.Interface{MacAddress: "00:11:22:33:44:55", DNSConfig: &v1.Interface_Dns{PrimaryDNS: "1.1.1.1"}}, 0},
+		"readdress":        {&v1.Interface{MacAddress: "00:11:22:33:44:55", DHCP: Disabled, Static: static("192.168.1.11", "192.168.1.1")}, 1},
+		"by label":         {&v1.Interface{Label: "X1", DHCP: Disabled, Static: static("192.168.1.11", "192.168.1.1")}, 1},
+		"dhcp":             {&v1.Interface{MacAddress: "00:11:22:33:44:55", DHCP: Enabled}, 1},
+		"no address":       {&v1.Interface{MacAddress: "00:11:22:33:44:55", DHCP: Disabled}, 1},
+		"no default route": {&v1.Interface{MacAddress: "00:11:22:33:44:55", DHCP: Disabled, Static: static("192.168.1.10", "")}, 1},
+		"moved gateway":    {&v1.Interface{MacAddress: "00:11:22:33:44:66", GatewayInterface: true}, 1},
+	} {
+		settings := &v1.NetworkSettings{Interfaces: []*v1.Interface{test.element}, LabelMap: map[string]string{"X1": "ens18"}}
+		assert.Len(t, nc.ManagementChanges(settings, management, remote), test.changes, name)
+	}
+
+	onLink := &net.TCPAddr{IP: net.ParseIP("192.168.1.50"), Port: 40000}
+	settings := &v1.NetworkSettings{Interfaces: []*v1.Interface{
+		{MacAddress: "00:11:22:33:44:55", DHCP: Disabled, Static: static("192.168.1.10", "")},
+		{MacAddress: "00:11:22:33:44:66", GatewayInterface: true},
+	}}
+	assert.Empty(t, nc.ManagementChanges(settings, management, onLink), "A client in the network of the interface does not need the default route")
+	assert.Empty(t, nc.ManagementChanges(settings, nil, remote), "Settings can not cut off unix socket clients")
+}
+
+func Test_ManagementChanges_Vlan(t *testing.T) {
+	nc := &NetworkConfigurator{}
+	parent := &v1.Interface{MacAddress: "00:11:22:33:44:55", InterfaceName: "ens18", DHCP: Enabled}
+	vlan := &v1.Interface_VlanConf{ID: 100, Name: "ens18.100", DHCP: Disabled,
+		Static: &v1.Interface_StaticConf{IPv4: "192.168.1.10", NetMask: "255.255.255.0", Gateway: "192.168.1.1"}}
+	management := &Management{Name: "ens18.100", Settings: addressSettings(vlan.DHCP, vlan.Static), Device: parent, Vlan: vlan}
+	remote := &net.TCPAddr{IP: net.ParseIP("10.0.0.5"), Port: 40000}
+	vlans := func(vlans ...*v1.Interface_VlanConf) *v1.Interface_VlanList {
+		return &v1.Interface_VlanList{Interfaces: vlans}
+	}
+
+	for name, test := range map[string]struct {
+		element *v1.Interface
+		changes int
+	}{
+		"parent address":   {&v1.Interface{MacAddress: "00:11:22:33:44:55", DHCP: Disabled}, 0},
+		"vlans kept":       {&v1.Interface{MacAddress: "00:11:22:33:44:55", DHCP: Enabled}, 0},
+		"same vlan":        {&v1.Interface{MacAddress: "00:11:22:33:44:55", Vlans: vlans(vlan)}, 0},
+		"vlan removed":     {&v1.Interface{MacAddress: "00:11:22:33:44:55", Vlans: vlans()}, 1},
+		"vlan readdressed": {&v1.Interface{Label: "X1", Vlans: vlans(&v1.Interface_VlanConf{ID: 100, DHCP: Enabled})}, 1},
+		"no default route": {&v1.Interface{MacAddress: "00:11:22:33:44:55", Vlans: vlans(&v1.Interface_VlanConf{ID: 100,
+			Static: &v1.Interface_StaticConf{IPv4: "192.168.1.10", NetMask: "255.255.255.0"}})}, 1},
+		"moved gateway": {&v1.Interface{MacAddress: "00:11:22:33:44:55", GatewayInterface: true}, 1},
+	} {
+		settings := &v1.NetworkSettings{Interfaces: []*v1.Interface{test.element}, LabelMap: map[string]string{"X1": "ens18"}}
+		assert.Len(t, nc.ManagementChanges(settings, management, remote), test.changes, name)
+	}
+
+	bonds := &v1.BondList{Interfaces: []*v1.Bond{{Name: "bond0", Members: []string{"X1", "X2"}, DHCP: Enabled}}}
+	changes := nc.ManagementChanges(&v1.NetworkSettings{Bonds: bonds, LabelMap: map[string]string{"X1": "ens18"}}, management, remote)
+	assert.Equal(t, []string{"management interface ens18.100 would become a member of bond bond0"}, changes)
+}
+
+func Test_ManagementChanges_Bond(t *testing.T) {
+	nc := &NetworkConfigurator{}
+	bond := &v1.Bond{Name: "bond0", Members: []string{"X1", "X2"}, DHCP: Disabled,
+		Static: &v1.Interface_StaticConf{IPv4: "192.168.1.10", NetMask: "255.255.255.0", Gateway: "192.168.1.1"}}
+	management := &Management{Name: "bond0", Settings: addressSettings(bond.DHCP, bond.Static), Bond: "bond0"}
+	remote := &net.TCPAddr{IP: net.ParseIP("10.0.0.5"), Port: 40000}
+	readdressed := proto.Clone(bond).(*v1.Bond)
+	readdressed.Static.IPv4 = "192.168.1.11"
+
+	for name, test := range map[string]struct {
+		settings *v1.NetworkSettings
+		changes  int
+	}{
+		"bonds kept":     {&v1.NetworkSettings{Interfaces: []*v1.Interface{{Label: "X3", DHCP: Enabled}}}, 0},
+		"same bond":      {&v1.NetworkSettings{Bonds: &v1.BondList{Interfaces: []*v1.Bond{bond}}}, 0},
+		"bond removed":   {&v1.NetworkSettings{Bonds: &v1.BondList{}}, 1},
+		"bond readdress": {&v1.NetworkSettings{Bonds: &v1.BondList{Interfaces: []*v1.Bond{readdressed}}}, 1},
+		"moved gateway":  {&v1.NetworkSettings{Interfaces: []*v1.Interface{{Label: "X3", GatewayInterface: true}}}, 1},
+	} {
+		assert.Len(t, nc.ManagementChanges(test.settings, management, remote), test.changes, name)
+	}
+}
+
+func Test_ManagementInterface(t *testing.T) {
+	nc := &NetworkConfigurator{}
+	patches := gomonkey.ApplyMethod(nc, "GetNetworkSettings", func(_ *NetworkConfigurator, _ *InterfaceMask) *v1.NetworkSettings {
+		return &v1.NetworkSettings{Interfaces: []*v1.Interface{
+			{MacAddress: "00:11:22:33:44:66", DHCP: Enabled},
+			getMockManagementInterface(),
+		}}
+	})
+	defer patches.Reset()
+
+	management := nc.ManagementInterface(&net.TCPAddr{IP: net.ParseIP("192.168.1.10"), Port: 50006})
+	assert.Equal(t, "ens18", management.Name)
+
+	assert.Nil(t, nc.ManagementInterface(&net.TCPAddr{IP: net.ParseIP("127.0.0.1"), Port: 50006}))
+	assert.Nil(t, nc.ManagementInterface(&net.UnixAddr{Name: "/tmp/devicemodel/network.socket", Net: "unix"}))
+	assert.Nil(t, nc.ManagementInterface(&net.TCPAddr{IP: net.ParseIP("172.17.0.1"), Port: 50006}))
+}
+
+func Test_ManagementInterface_BondAndVlan(t *testing.T) {
+	nc := &NetworkConfigurator{}
+	parent := &v1.Interface{MacAddress: "00:11:22:33:44:66", InterfaceName: "ens19", DHCP: Enabled,
+		Vlans: &v1.Interface_VlanList{Interfaces: []*v1.Interface_VlanConf{{ID: 100, Name: "ens19.100", DHCP: Disabled,
+			Static: &v1.Interface_StaticConf{IPv4: "192.168.100.10", NetMask: "255.255.255.0"}}}}}
+	patches := gomonkey.ApplyMethod(nc, "GetNetworkSettings", func(_ *NetworkConfigurator, mask *InterfaceMask) *v1.NetworkSettings {
+		assert.True(t, mask.Includes(FieldBond) && mask.Includes(FieldVlans))
+		return &v1.NetworkSettings{Interfaces: []*v1.Interface{parent},
+			Bonds: &v1.BondList{Interfaces: []*v1.Bond{{Name: "bond0", Members: []string{"X1", "X2"},
+				Static: &v1.Interface_StaticConf{IPv4: "192.168.2.10", NetMask: "255.255.255.0", Gateway: "192.168.2.1"}}}}}
+	})
+	defer patches.Reset()
+
+	vlan := nc.ManagementInterface(&net.TCPAddr{IP: net.ParseIP("192.168.100.10"), Port: 50006})
+	assert.Equal(t, "ens19.100", vlan.Name)
+	assert.Equal(t, parent, vlan.Device)
+	assert.Equal(t, uint32(100), vlan.Vlan.GetID())
+	assert.False(t, vlan.Settings.GatewayInterface)
+
+	bond := nc.ManagementInterface(&net.TCPAddr{IP: net.ParseIP("192.168.2.10"), Port: 50006})
+	assert.Equal(t, &Management{Name: "bond0", Bond: "bond0", Settings: &v1.Interface{DHCP: Disabled, GatewayInterface: true,
+		Static: &v1.Interface_StaticConf{IPv4: "192.168.2.10", NetMask: "255.255.255.0", Gateway: "192.168.2.1"}}}, bond)
+}
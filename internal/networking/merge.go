@@ -0,0 +1,137 @@
+/*
+ * Copyright © Siemens 2025. ALL RIGHTS RESERVED.
+ * Licensed under the MIT license
+ * See LICENSE file in the top-level directory
+ */
+
+package networking
+
+import (
+	"net"
+
+	nm "github.com/Wifx/gonetworkmanager/v2"
+)
+
+// Deprecated NetworkManager properties. They are returned next to their replacements (address-data, route-data)
+// and overrule them on update, so they are never written back.
+const (
+	deprecatedAddressesKey = "addresses"
+	deprecatedRoutesKey    = "routes"
+)
+
+// ownedKeys lists the keys of each setting which are managed by this service. All other keys of a profile,
+// e.g. MTU, IPv6, 802-1x, autoconnect priority or the DHCP client ID, are preserved on update.
+var ownedKeys = map[string][]string{
+	ConnectionKey: {IDKey, TypeKey, InterfaceNameKey},
+	EthernetType:  {MACAddressKey},
+	IPV4Key:       {MethodKey, GatewayKey, AddressDataKey, DNSKey, DNSSearchKey, DNSPriorityKey, DNSOptionsKey, DNSIgnoreAutoKey, RouteMetricKey, DHCPTimeoutKey},
+}
+
+// dhcpOptionKeys lists the options of the DHCP client, which are only replaced when the desired settings contain them.
+var dhcpOptionKeys = []string{DHCPHostnameKey, DHCPSendHostnameKey, DHCPClientIDKey, DHCPVendorClassKey, MayFailKey}
+
+// linkKeys lists the Ethernet link settings, which are only replaced when the desired settings contain them.
+var linkKeys = []string{MTUKey, SpeedKey, DuplexKey, AutoNegotiateKey, ClonedMACAddressKey, WakeOnLanKey}
+
+// mergeSettings returns the current connection settings updated with the desired settings.
+// Only the owned keys are taken from the desired settings, owned keys which are not set there are removed.
+// Everything else, including the connection UUID and other user data, is kept from the current settings.
+func mergeSettings(current, desired nm.ConnectionSettings) nm.ConnectionSettings {
+	merged := copySettings(current)
+
+	for name, keys := range ownedKeys {
+		if merged[name] == nil {
+			merged[name] = make(map[string]interface{})
+		}
+		for _, key := range keys {
+			if value := desired[name][key]; isSet(value) {
+				merged[name][key] = value
+			} else {
+				delete(merged[name], key)
+			}
+		}
+	}
+	// routes are only replaced when the desired settings contain routes
+	if isSet(desired[IPV4Key][RouteDataKey]) {
+		merged[IPV4Key][RouteDataKey] = desired[IPV4Key][RouteDataKey]
+	}
+	// DHCP options, e.g. a client ID the address is reserved for, are kept unless new ones are given
+	for _, key := range dhcpOptionKeys {
+		if value := desired[IPV4Key][key]; isSet(value) {
+			merged[IPV4Key][key] = value
+		}
+	}
+	// link settings, e.g. the MTU set by the device builder, are kept unless new ones are given
+	for _, key := range linkKeys {
+		if value := desired[EthernetType][key]; isSet(value) {
+			merged[EthernetType][key] = value
+		}
+	}
+	// label based settings do not carry a MAC address, keep the one the profile is bound to
+	if !isSet(desired[EthernetType][MACAddressKey]) && isSet(current[EthernetType][MACAddressKey]) {
+		merged[EthernetType][MACAddressKey] = current[EthernetType][MACAddressKey]
+	}
+
+	if isServiceProfile(desired) {
+		tagOwnership(merged)
+		mergeFallbackTags(merged, desired)
+	}
+
+	removeDeprecatedKeys(merged)
+	return merged
+}
+
+// isSet reports whether the given setting value is present, an unparsable MAC address is stored as empty value.
+func isSet(value interface{}) bool {
+	switch typed := value.(type) {
+	case nil:
+		return false
+	case []byte:
+		return len(typed) > 0
+	case net.HardwareAddr:
+		return len(typed) > 0
+	}
+	return true
+}
+
+// copySettings returns a copy of the given settings, the values themselves are shared.
+func copySettings(settings nm.ConnectionSettings) nm.ConnectionSettings {
+	copied := make(nm.ConnectionSettings)
+	for name, values := range settings {
+		copied[name] = make(map[string]interface{})
+		for key, value := range values {
+			copied[name][key] = value
+		}
+	}
+	return copied
+}
+
+// removeDeprecatedKeys removes the deprecated address and route properties, their data is kept in
+// address-data and route-data.
+func removeDeprecatedKeys(settings nm.ConnectionSettings) {
+	for _, name := range []string{IPV4Key, IPV6Key} {
+		delete(settings[name], deprecatedAddressesKey)
+		delete(settings[name], deprecatedRoutesKey)
+	}
+}
+
+// profileFromExisting returns the settings for a new profile, which replaces the given connections.
+// Unmanaged settings are taken over from the active profile, or the first one if none is active.
+func profileFromExisting(device nm.DeviceWired, connections []nm.Connection, desired nm.ConnectionSettings) nm.ConnectionSettings {
+	base := activeProfile(device, connections)
+	if base == nil && len(connections) > 0 {
+		base = connections[0]
+	}
+	if base == nil {
+		return desired
+	}
+
+	current, err := base.GetSettings()
+	if err != nil {
+		return desired
+	}
+	merged := mergeSettings(current, desired)
+	merged[ConnectionKey][UUIDKey] = desired[ConnectionKey][UUIDKey]
+	merged[ConnectionKey][TimeStampKey] = desired[ConnectionKey][TimeStampKey]
+	return merged
+}
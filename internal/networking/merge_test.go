@@ -0,0 +1,129 @@
+/*
+ * Copyright © Siemens 2025. ALL RIGHTS RESERVED.
+ * Licensed under the MIT license
+ * See LICENSE file in the top-level directory
+ */
+
+package networking
+
+import (
+	v1 "networkservice/api/siemens_iedge_dmapi_v1"
+	mockgnm "networkservice/internal/networking/mocks/gonetworkmanager"
+	"testing"
+
+	nm "github.com/Wifx/gonetworkmanager/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+// getMockCustomizedSettings returns static settings with additional settings made by an integrator with nmcli.
+func getMockCustomizedSettings() nm.ConnectionSettings {
+	settings := getMockActiveStaticSettings()
+	settings[ConnectionKey]["autoconnect-priority"] = int32(10)
+	settings[EthernetType]["mtu"] = uint32(1400)
+	settings[IPV4Key]["dhcp-client-id"] = "mac"
+	settings[IPV4Key][deprecatedRoutesKey] = [][]uint32{{167772160, 8, 0, 0}}
+	settings[IPV4Key]["route-data"] = []map[string]interface{}{{"dest": "10.0.0.0", "prefix": uint32(8)}}
+	settings[IPV6Key] = map[string]interface{}{
+		MethodKey:              Manual,
+		AddressDataKey:         []map[string]interface{}{{AddressKey: "fd00::1", PrefixKey: uint32(64)}},
+		deprecatedAddressesKey: []interface{}{},
+	}
+	settings["802-1x"] = map[string]interface{}{"eap": []string{"tls"}, "identity": "edge"}
+	return settings
+}
+
+func Test_mergeSettings_PreservesUnmanagedSettings(t *testing.T) {
+	desired := newSettingsFromProto(&v1.Interface{MacAddress: "00:0A:95:9D:68:16", DHCP: Enabled}, "eth0")
+
+	merged := mergeSettings(getMockCustomizedSettings(), desired)
+
+	assert.Equal(t, int32(10), merged[ConnectionKey]["autoconnect-priority"])
+	assert.Equal(t, uint32(1400), merged[EthernetType]["mtu"])
+	assert.Equal(t, "mac", merged[IPV4Key]["dhcp-client-id"])
+	assert.NotNil(t, merged[IPV4Key]["route-data"], "Static routes should be preserved")
+	assert.Equal(t, Manual, merged[IPV6Key][MethodKey])
+	assert.NotNil(t, merged[IPV6Key][AddressDataKey], "IPv6 addresses should be preserved")
+	assert.Equal(t, "edge", merged["802-1x"]["identity"])
+	assert.Equal(t, testActiveUUID, merged[ConnectionKey][UUIDKey])
+}
+
+func Test_mergeSettings_ReplacesOwnedSettings(t *testing.T) {
+	desired := newSettingsFromProto(&v1.Interface{MacAddress: "00:0A:95:9D:68:16", DHCP: Enabled}, "eth0")
+
+	merged := mergeSettings(getMockCustomizedSettings(), desired)
+
+	assert.Equal(t, Auto, merged[IPV4Key][MethodKey])
+	assert.NotContains(t, merged[IPV4Key], AddressDataKey, "Static addresses should be removed when switching to DHCP")
+	assert.NotContains(t, merged[IPV4Key], GatewayKey)
+	assert.NotContains(t, merged[IPV4Key], DNSKey)
+	assert.NotContains(t, merged[IPV4Key], deprecatedAddressesKey)
+	assert.NotContains(t, merged[IPV4Key], deprecatedRoutesKey)
+	assert.NotContains(t, merged[IPV6Key], deprecatedAddressesKey)
+	assert.Equal(t, "00:0A:95:9D:68:16_dhcp", merged[ConnectionKey][IDKey])
+}
+
+func Test_mergeSettings_KeepsBoundMacForLabelBasedSettings(t *testing.T) {
+	desired := newSettingsFromProto(&v1.Interface{Label: "X1", DHCP: Enabled}, "eth0")
+
+	merged := mergeSettings(getMockCustomizedSettings(), desired)
+
+	assert.Equal(t, []byte{0x00, 0x0A, 0x95, 0x9D, 0x68, 0x16}, merged[EthernetType][MACAddressKey])
+	assert.Equal(t, "X1_dhcp", merged[ConnectionKey][IDKey])
+}
+
+func Test_RetrieveSettingsFromBackup_PreservesAllSettings(t *testing.T) {
+	backup := getMockCustomizedSettings()
+
+	connection := retrieveSettingsFromBackup(backup)
+
+	assert.NotEqual(t, testActiveUUID, connection[ConnectionKey][UUIDKey])
+	assert.Equal(t, uint32(1400), connection[EthernetType]["mtu"])
+	assert.Equal(t, int32(10), connection[ConnectionKey]["autoconnect-priority"])
+	assert.Equal(t, backup[IPV6Key][AddressDataKey], connection[IPV6Key][AddressDataKey])
+	assert.Equal(t, "edge", connection["802-1x"]["identity"])
+	assert.NotContains(t, connection[IPV4Key], deprecatedAddressesKey)
+	assert.Equal(t, testActiveUUID, backup[ConnectionKey][UUIDKey], "The backup should not be modified")
+}
+
+func Test_profileFromExisting_TakesOverUnmanagedSettings(t *testing.T) {
+	mockConnection := &mockgnm.MockConnection{}
+	mockConnection.On("GetSettings").Return(getMockCustomizedSettings(), nil)
+	desired := newSettingsFromProto(getMockStaticProto(), "eth0")
+
+	profile := profileFromExisting(getMockActiveDevice(), []nm.Connection{mockConnection}, desired)
+
+	assert.Equal(t, desired[ConnectionKey][UUIDKey], profile[ConnectionKey][UUIDKey], "The new profile should get a new UUID")
+	assert.Equal(t, uint32(1400), profile[EthernetType]["mtu"])
+	assert.Equal(t, desired[IPV4Key][AddressDataKey], profile[IPV4Key][AddressDataKey])
+
+	assert.Equal(t, desired, profileFromExisting(&mockgnm.MockDeviceWired{}, nil, desired),
+		"Without existing profiles the desired settings should be used")
+}
+
+func Test_mergeSettings_ReplacesRoutesOnlyWhenDesired(t *testing.T) {
+	desired := newSettingsFromProto(&v1.Interface{
+		MacAddress: "00:0A:95:9D:68:16",
+		DHCP:       Enabled,
+		Routes:     []*v1.Interface_Route{{Destination: "172.16.0.0/12", NextHop: "192.168.1.253", Metric: 50}},
+	}, "eth0")
+
+	merged := mergeSettings(getMockCustomizedSettings(), desired)
+
+	assert.Equal(t, []string{"172.16.0.0/12 via 192.168.1.253 metric 50"}, settingRoutes(merged[IPV4Key][RouteDataKey]))
+	assert.False(t, equivalentSettings(getMockCustomizedSettings(), desired), "Changed routes should be applied")
+	assert.True(t, equivalentSettings(merged, desired))
+}
+
+func Test_mergeSettings_DHCPOptions(t *testing.T) {
+	current := getMockCustomizedSettings()
+	current[IPV4Key][DHCPVendorClassKey] = "SIEMENS-IPC"
+	desired := newSettingsFromProto(&v1.Interface{MacAddress: "00:0A:95:9D:68:16", DHCP: Enabled,
+		DHCPOptions: &v1.Interface_DHCPConf{ClientID: "01:00:0a:95:9d:68:16"}}, "eth0")
+
+	merged := mergeSettings(current, desired)
+
+	assert.Equal(t, "01:00:0a:95:9d:68:16", merged[IPV4Key][DHCPClientIDKey], "The given client ID should replace the current one")
+	assert.Equal(t, "SIEMENS-IPC", merged[IPV4Key][DHCPVendorClassKey], "Options which are not given should be kept")
+	assert.True(t, equivalentSettings(merged, desired))
+	assert.False(t, equivalentSettings(current, desired))
+}
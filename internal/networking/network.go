@@ -19,11 +19,11 @@ import (
 
 // Network interface that can perform
 type Network interface {
-	GetEthernetInterfaces() []*v1.Interface
+	GetEthernetInterfaces(mask *InterfaceMask) []*v1.Interface
 	ArePreconditionsOk(newSettings *v1.NetworkSettings) (bool, error)
 	Apply(newSettings []*v1.Interface) error
-	GetInterfaceWithMac(mac string) *v1.Interface
-	GetInterfaceWithLabel(Label string) *v1.Interface
+	GetInterfaceWithMac(mac string, mask *InterfaceMask) *v1.Interface
+	GetInterfaceWithLabel(Label string, mask *InterfaceMask) *v1.Interface
 
 	getDeviceWithMac(mac string) nm.DeviceWired
 	getDeviceWithLabel(label string) nm.DeviceWired
@@ -32,42 +32,66 @@ type Network interface {
 
 // NetworkConfigurator implements Network Interface.
 type NetworkConfigurator struct {
-	gnm nm.NetworkManager
+	gnm          nm.NetworkManager
+	cache        *snapshotCache
+	locks        *applyLocks
+	reconciler   *reconciler
+	revisions    *revisionStore
+	policy       *policyStore
+	confirmation *confirmationStore
+	fallback     *fallbackSupervisor
+	resolver     *resolverStore
 }
 
 // NewNetworkConfiguratorWithNM creates new NetworkConfigurator instance
 func NewNetworkConfiguratorWithNM(wifxNetworkManager nm.NetworkManager) *NetworkConfigurator {
-	return &NetworkConfigurator{gnm: wifxNetworkManager}
+	return &NetworkConfigurator{gnm: wifxNetworkManager, locks: newApplyLocks(), reconciler: newReconciler(),
+		revisions: newRevisionStore(), policy: newPolicyStore(), confirmation: newConfirmationStore(),
+		fallback: newFallbackSupervisor(), resolver: newResolverStore()}
 }
 
 // NewNetworkConfigurator creates new NetworkConfigurator instance
 func NewNetworkConfigurator() *NetworkConfigurator {
 	val, _ := nm.NewNetworkManager()
-	return &NetworkConfigurator{gnm: val}
+	return &NetworkConfigurator{gnm: val, locks: newApplyLocks(), reconciler: newReconciler(),
+		revisions: newRevisionStore(), policy: newPolicyStore(), confirmation: newConfirmationStore(),
+		fallback: newFallbackSupervisor(), resolver: newResolverStore()}
 }
 
 //### PUBLIC FUNCTIONS
 
 // GetInterfaceWithMac Returns All Ethernet typed interfaces on a device
-func (nc *NetworkConfigurator) GetInterfaceWithMac(mac string) *v1.Interface {
+func (nc *NetworkConfigurator) GetInterfaceWithMac(mac string, mask *InterfaceMask) *v1.Interface {
+	if snapshot := nc.Snapshot(); snapshot != nil {
+		return snapshot.InterfaceWithMac(mac, mask)
+	}
+
 	device := nc.getDeviceWithMac(mac)
 	if device == nil {
 		log.Println("Device is not found: ", mac)
 	}
-	return DBusToProto(device)
+	return DBusToProto(device, mask)
 }
 
 // GetInterfaceWithLabel Returns All Ethernet typed interfaces on a device
-func (nc *NetworkConfigurator) GetInterfaceWithLabel(Label string) *v1.Interface {
+func (nc *NetworkConfigurator) GetInterfaceWithLabel(Label string, mask *InterfaceMask) *v1.Interface {
+	if snapshot := nc.Snapshot(); snapshot != nil {
+		return snapshot.InterfaceWithLabel(Label, mask)
+	}
+
 	device := nc.getDeviceWithLabel(Label)
 	if device == nil {
 		log.Println("Device is not found: ", Label)
 	}
-	return DBusToProto(device)
+	return DBusToProto(device, mask)
 }
 
 // IsGatewayInterface checks if the interface with the given MAC address is the gateway interface.
 func (nc *NetworkConfigurator) IsGatewayInterface(mac string) bool {
+	if snapshot := nc.Snapshot(); snapshot != nil {
+		return snapshot.IsGatewayInterface(mac)
+	}
+
 	devices := nc.getAllEthernetDevices()
 
 	gatewayMAC := nc.findGatewayMAC(devices)
@@ -134,7 +158,7 @@ func (nc *NetworkConfigurator) getDeviceGatewayMACAndMetric(device nm.DeviceWire
 	for _, route := range routeData {
 		log.Printf("Inspecting route: Destination=%v, Prefix=%v, Metric=%v\n", route.Destination, route.Prefix, route.Metric)
 		if route.Destination == OutgoingRouteDestination && route.Prefix == OutgoingRoutePrefix {
-			mac, err := device.GetPropertyHwAddress()
+			mac, err := deviceMac(device)
 			log.Printf("Hardware address retrieved for device %s: %s, error: %v\n", device, mac, err)
 			return mac, route.Metric, nil
 		}
@@ -145,7 +169,12 @@ func (nc *NetworkConfigurator) getDeviceGatewayMACAndMetric(device nm.DeviceWire
 }
 
 // GetEthernetInterfaces returns all Ethernet typed interfaces on a device.
-func (nc *NetworkConfigurator) GetEthernetInterfaces() []*v1.Interface {
+// Only the fields selected by the mask are resolved, a nil mask selects all fields.
+func (nc *NetworkConfigurator) GetEthernetInterfaces(mask *InterfaceMask) []*v1.Interface {
+	if snapshot := nc.Snapshot(); snapshot != nil {
+		return snapshot.Interfaces(mask)
+	}
+
 	log.Println("Starting GetEthernetInterfaces: Fetching all Ethernet interfaces.")
 
 	devices := nc.getAllEthernetDevices()
@@ -155,22 +184,42 @@ func (nc *NetworkConfigurator) GetEthernetInterfaces() []*v1.Interface {
 	var interfaces []*v1.Interface
 	for _, device := range devices {
 		log.Printf("Converting device %v to proto representation.", device)
-		proto := DBusToProto(device)
+		proto := DBusToProto(device, mask)
 		interfaces = append(interfaces, proto)
 	}
 
 	// Identify the gateway interface.
-	log.Println("Identifying the gateway interface.")
-	gatewayInterface := nc.findGatewayInterface(devices, interfaces)
-	if gatewayInterface != nil {
-		log.Printf("Gateway interface identified: %v\n", gatewayInterface)
-		gatewayInterface.GatewayInterface = true
+	if mask.Includes(FieldGatewayInterface) {
+		log.Println("Identifying the gateway interface.")
+		gatewayInterface := nc.findGatewayInterface(devices, interfaces)
+		if gatewayInterface != nil {
+			log.Printf("Gateway interface identified: %v\n", gatewayInterface)
+			gatewayInterface.GatewayInterface = true
+		}
 	}
 
 	log.Printf("Returning %d interfaces: %v\n", len(interfaces), interfaces)
 	return interfaces
 }
 
+// GetNetworkSettings returns all Ethernet typed interfaces together with the generation and resource version they were read at.
+// The bonds are only returned if the mask selects the Bond field.
+func (nc *NetworkConfigurator) GetNetworkSettings(mask *InterfaceMask) *v1.NetworkSettings {
+	if snapshot := nc.Snapshot(); snapshot != nil {
+		settings := &v1.NetworkSettings{Interfaces: snapshot.Interfaces(mask), Generation: snapshot.Generation,
+			ResourceVersion: snapshot.ResourceVersion()}
+		if mask.Includes(FieldBond) {
+			settings.Bonds = snapshot.Bonds()
+		}
+		return settings
+	}
+	settings := &v1.NetworkSettings{Interfaces: nc.GetEthernetInterfaces(mask), ResourceVersion: nc.currentSettingsResourceVersion()}
+	if mask.Includes(FieldBond) {
+		settings.Bonds = readBonds()
+	}
+	return settings
+}
+
 // findGatewayInterface identifies the gateway interface with the lowest metric.
 func (nc *NetworkConfigurator) findGatewayInterface(devices []nm.DeviceWired, interfaces []*v1.Interface) *v1.Interface {
 	log.Println("Starting findGatewayInterface: Identifying gateway interface.")
@@ -201,52 +250,93 @@ func (nc *NetworkConfigurator) findGatewayInterface(devices []nm.DeviceWired, in
 	return gatewayInterface
 }
 
-// ArePreconditionsOk Checks all preconditions before applying any settings.
+// ArePreconditionsOk Checks all preconditions before applying any settings, including the policy of the device builder.
 func (nc *NetworkConfigurator) ArePreconditionsOk(newSettings *v1.NetworkSettings) (bool, error) {
 	return verify(newSettings, nc)
 }
 
 // Apply Applies given settings, if any error occures all Interfaces in system will be restored to original states.
+// The VLAN profiles of the interfaces and the bonds are restored as well.
 func (nc *NetworkConfigurator) Apply(newSettings *v1.NetworkSettings) error {
 	log.Println("new settings request -- ", newSettings)
+	defer nc.InvalidateSnapshot()
 	var backups []nm.ConnectionSettings
+	var vlanBackups []*vlanBackup
 
 	//iterate through all interfaces in given new Settings
 	for _, element := range newSettings.Interfaces {
 
 		//try APPLY new settings to each network interface
-		backup, err := nc.applyAndBackupSettings(element)
+		backup, err := nc.applyAndBackupSettings(element, newSettings.GetOptions().GetForeignProfiles())
 
 		//add backup if any active connections exists before
 		if backup != nil {
 			backups = append(backups, backup)
 			log.Println("backup  : > ", backup)
 		}
+		//VLANs are only changed if they are given
+		if err == nil && element.Vlans != nil {
+			var vlanBackup *vlanBackup
+			vlanBackup, err = nc.applyVlans(element, newSettings.GetOptions().GetForeignProfiles())
+			if vlanBackup != nil {
+				vlanBackups = append(vlanBackups, vlanBackup)
+			}
+		}
 		//if any error occurs, all interfaces will be RESTOREd to original
 		if err != nil {
 			log.Println("applying new settings failed for:", err)
-			log.Println("Restoring all settings:")
-			for _, data := range backups {
-				if data != nil {
-					_ = nc.restoreConnection(data)
-
-				}
-			}
+			nc.restoreBackups(backups, vlanBackups, nil)
 			//return error to caller since new settings could not apply,but restored.
 			return err
 		}
 	}
+	//bonds are only changed if they are given
+	if newSettings.Bonds != nil {
+		bondBackup, err := nc.applyBonds(newSettings.Bonds, newSettings.GetOptions().GetForeignProfiles())
+		if err != nil {
+			log.Println("applying bonds failed for:", err)
+			nc.restoreBackups(backups, vlanBackups, bondBackup)
+			return err
+		}
+	}
 	log.Println("all interface(s) configured successfully")
 	return nil
 }
 
+// restoreBackups restores all interfaces, VLANs and bonds to the backups taken by Apply.
+func (nc *NetworkConfigurator) restoreBackups(backups []nm.ConnectionSettings, vlanBackups []*vlanBackup, bondBackup *bondBackup) {
+	log.Println("Restoring all settings:")
+	if bondBackup != nil {
+		_ = nc.restoreBonds(bondBackup)
+	}
+	for _, data := range backups {
+		if data != nil {
+			_ = nc.restoreConnection(data)
+
+		}
+	}
+	for _, vlanBackup := range vlanBackups {
+		_ = nc.restoreVlans(vlanBackup)
+	}
+}
+
 //### PRIVATE functions
 //#####################
 
+// deviceMac returns the MAC address, which identifies the device: its permanent one. The MAC address in use changes
+// with a cloned MAC address or when the device becomes a bond member. Devices without permanent MAC address are
+// identified by the one in use.
+func deviceMac(device nm.DeviceWired) (string, error) {
+	if permanent, err := device.GetPropertyPermHwAddress(); err == nil && permanent != "" {
+		return permanent, nil
+	}
+	return device.GetPropertyHwAddress()
+}
+
 func (nc *NetworkConfigurator) getDeviceWithMac(mac string) nm.DeviceWired {
 	var retVal nm.DeviceWired
 	for _, device := range nc.getAllEthernetDevices() {
-		hw, _ := device.GetPropertyHwAddress()
+		hw, _ := deviceMac(device)
 		if strings.ToUpper(hw) == strings.ToUpper(mac) {
 			retVal = device
 
@@ -295,19 +385,21 @@ func (nc *NetworkConfigurator) getAllEthernetDevices() []nm.DeviceWired {
 
 // applyAndBackupSettings applies the provided network settings to the device
 // and creates a backup of the existing settings before applying the new ones.
-func (nc *NetworkConfigurator) applyAndBackupSettings(protoData *v1.Interface) (nm.ConnectionSettings, error) {
+// Connection profiles of other tools are handled according to the given policy.
+func (nc *NetworkConfigurator) applyAndBackupSettings(protoData *v1.Interface,
+	policy v1.ApplyOptions_ForeignProfilePolicy) (nm.ConnectionSettings, error) {
 	device, err := nc.getDeviceBy(protoData)
 	if err != nil {
 		return nil, err
 	}
 
-	backup := nc.createBackupFromExisting(device)
+	backup := nc.createBackupFromExisting(device, policy)
 	settings, err := nc.prepareSettings(protoData, device)
 	if err != nil {
 		return backup, err
 	}
 
-	if err := nc.updateConnections(device, settings); err != nil {
+	if err := nc.updateConnections(device, settings, policy); err != nil {
 		return backup, err
 	}
 
@@ -339,16 +431,40 @@ func (nc *NetworkConfigurator) prepareSettings(protoData *v1.Interface, device n
 	return newSettingsFromProto(protoData, deviceName), nil
 }
 
-// updateConnections updates the connections for the given Ethernet device
-// by deleting old connections and adding the new settings.
-func (nc *NetworkConfigurator) updateConnections(device nm.DeviceWired, settings nm.ConnectionSettings) error {
-	connections := listConnections(device)
+// updateConnections updates the connections for the given Ethernet device.
+// If the device has an active profile, it is updated in place and reapplied, nothing is changed when it already
+// has the given settings. Otherwise old connections are deleted and the new settings are added.
+// In both cases only the settings owned by this service are changed, see mergeSettings.
+// Unless the policy is DELETE, profiles of other tools are not replaced, but left or disabled.
+func (nc *NetworkConfigurator) updateConnections(device nm.DeviceWired, settings nm.ConnectionSettings,
+	policy v1.ApplyOptions_ForeignProfilePolicy) error {
+	connections, foreign := managedConnections(device, policy)
+	if err := handleForeignProfiles(foreign, policy); err != nil {
+		return err
+	}
+
+	if active := activeProfile(device, connections); active != nil {
+		// inactive profiles are not needed anymore, removing them does not affect the link
+		if err := nc.deleteOldConnections(exceptConnection(connections, active)); err != nil {
+			log.Println("could not delete connection: ", err)
+			return err
+		}
+		connections = []nm.Connection{active}
+
+		updated, err := nc.updateConnectionInPlace(device, active, settings)
+		if updated || err != nil {
+			return err
+		}
+	}
+
+	// unmanaged settings of the replaced profile are taken over to the new one
+	settings = profileFromExisting(device, connections, settings)
 	if err := nc.deleteOldConnections(connections); err != nil {
 		log.Println("could not delete connection: ", err)
 		return err
 	}
 
-	mac, err := device.GetPropertyHwAddress()
+	mac, err := deviceMac(device)
 	if err != nil {
 		return err
 	}
@@ -375,10 +491,10 @@ func setMACAddressInBackup(backup nm.ConnectionSettings, wired nm.DeviceWired) e
 }
 
 // createBackupFromExisting creates a backup of the existing connection settings
-// for the given Ethernet device.
-func (nc *NetworkConfigurator) createBackupFromExisting(wired nm.DeviceWired) nm.ConnectionSettings {
+// for the given Ethernet device. Foreign profiles, which are not replaced with the given policy, are not backed up.
+func (nc *NetworkConfigurator) createBackupFromExisting(wired nm.DeviceWired, policy v1.ApplyOptions_ForeignProfilePolicy) nm.ConnectionSettings {
 
-	list := listConnections(wired)
+	list, _ := managedConnections(wired, policy)
 
 	if list == nil || len(list) < 1 {
 		log.Printf("there is not any connection found on device to create backup ")
@@ -141,6 +141,7 @@ func Test_getDeviceGatewayMACAndMetric_ReturnsCorrectMACAndMetric(t *testing.T)
 
     // Mock method returns
     mockDevice.On("GetPropertyHwAddress").Return("F7:2B:A1:D5:97:4E", nil)
+    mockDevice.On("GetPropertyPermHwAddress").Return("F7:2B:A1:D5:97:4E", nil)
     mockDevice.On("GetPropertyActiveConnection").Return(mockConn, nil)
     mockConn.On("GetPropertyIP4Config").Return(mockIPv4Config, nil)
     mockIPv4Config.On("GetPropertyRouteData").Return(mockRouteData, nil)
@@ -167,12 +168,12 @@ func Test_GetInterfaceWithMac_ReturnsCorrectInterface(t *testing.T) {
 		return mockDevice
 	})
 
-	patches.ApplyFunc(DBusToProto, func(device nm.DeviceWired) *v1.Interface {
+	patches.ApplyFunc(DBusToProto, func(device nm.DeviceWired, _ *InterfaceMask) *v1.Interface {
 		actualDeviceType = reflect.TypeOf(device)
 		return &v1.Interface{MacAddress: testMac}
 	})
 
-	result := nc.GetInterfaceWithMac(testMac)
+	result := nc.GetInterfaceWithMac(testMac, nil)
 
 	assert.Equal(t, reflect.TypeOf(mockDevice), actualDeviceType, "Expected device type should be the same as mockDevice")
 	assert.NotNil(t, result, "GetInterfaceWithMac should return a non-nil Interface instance")
@@ -190,7 +191,7 @@ func Test_GetInterfaceWithMac_ReturnsNilWhenDeviceIsNil(t *testing.T) {
 		return nil
 	})
 
-	result := nc.GetInterfaceWithMac(testMac)
+	result := nc.GetInterfaceWithMac(testMac, nil)
 
 	assert.Nil(t, result, "GetInterfaceWithMac should return a nil Interface instance when device is nil")
 }
@@ -207,12 +208,12 @@ func Test_GetInterfaceWithLabel_ReturnsCorrectInterface(t *testing.T) {
 	patches.ApplyPrivateMethod(reflect.TypeOf(nc), "getDeviceWithLabel",
 		func(_ *NetworkConfigurator, label string) nm.DeviceWired { return mockDevice })
 
-	patches.ApplyFunc(DBusToProto, func(device nm.DeviceWired) *v1.Interface {
+	patches.ApplyFunc(DBusToProto, func(device nm.DeviceWired, _ *InterfaceMask) *v1.Interface {
 		actualDeviceType = reflect.TypeOf(device)
 		return &v1.Interface{Label: testLabel}
 	})
 
-	result := nc.GetInterfaceWithLabel(testLabel)
+	result := nc.GetInterfaceWithLabel(testLabel, nil)
 
 	assert.Equal(t, reflect.TypeOf(mockDevice), actualDeviceType, "Expected device type should be the same as mockDevice")
 	assert.NotNil(t, result, "GetInterfaceWithLabel should return a non-nil Interface instance")
@@ -229,7 +230,7 @@ func Test_GetInterfaceWithLabel_ReturnsNilWhenDeviceNotFound(t *testing.T) {
 	patches.ApplyPrivateMethod(reflect.TypeOf(nc), "getDeviceWithLabel",
 		func(_ *NetworkConfigurator, label string) nm.DeviceWired { return nil })
 
-	result := nc.GetInterfaceWithLabel(testLabel)
+	result := nc.GetInterfaceWithLabel(testLabel, nil)
 
 	assert.Nil(t, result, "GetInterfaceWithLabel should return a nil Interface instance when device is not found")
 }
@@ -254,6 +255,7 @@ func Test_GetEthernetInterfaces_ReturnsAllAvailableNetworkDevices(t *testing.T)
 
 	mockDevice.On("GetPropertyInterface").Return("eth0", nil)
 	mockDevice.On("GetPropertyHwAddress").Return("F7:2B:A1:D5:97:4E", nil)
+	mockDevice.On("GetPropertyPermHwAddress").Return("F7:2B:A1:D5:97:4E", nil)
 	mockDevice.On("GetPropertyActiveConnection").Return(mockConn, nil)
 
 	mockConn.On("GetPropertyIP4Config").Return(mockIPv4Config, nil)
@@ -266,7 +268,7 @@ func Test_GetEthernetInterfaces_ReturnsAllAvailableNetworkDevices(t *testing.T)
 		return []nm.DeviceWired{mockDevice, mockDevice}
 	})
 
-	patches.ApplyFunc(DBusToProto, func(device nm.DeviceWired) *v1.Interface {
+	patches.ApplyFunc(DBusToProto, func(device nm.DeviceWired, _ *InterfaceMask) *v1.Interface {
 		actualDeviceType = reflect.TypeOf(device)
 		return &v1.Interface{
 			InterfaceName: "eth0",
@@ -274,7 +276,7 @@ func Test_GetEthernetInterfaces_ReturnsAllAvailableNetworkDevices(t *testing.T)
 		}
 	})
 
-	result := nc.GetEthernetInterfaces()
+	result := nc.GetEthernetInterfaces(nil)
 
 	assert.Equal(t, reflect.TypeOf(mockDevice), actualDeviceType, "Expected device type should be the same as mockDevice")
 	assert.NotNil(t, result, "GetEthernetInterfaces should return non-nil Interface instances")
@@ -324,7 +326,9 @@ func Test_GetEthernetInterfaces_EnsuresGatewayInterfaceIsMarked(t *testing.T) {
 	mockIPv4Config2.On("GetPropertyRouteData").Return(mockRouteData2, nil)
 
 	mockDevice1.On("GetPropertyHwAddress").Return("F7:2B:A1:D5:97:4E", nil)
+	mockDevice1.On("GetPropertyPermHwAddress").Return("F7:2B:A1:D5:97:4E", nil)
 	mockDevice2.On("GetPropertyHwAddress").Return("F7:2B:A1:D5:97:4", nil)
+	mockDevice2.On("GetPropertyPermHwAddress").Return("F7:2B:A1:D5:97:4", nil)
 	
 	patches := gomonkey.NewPatches()
 	defer patches.Reset()
@@ -333,7 +337,7 @@ func Test_GetEthernetInterfaces_EnsuresGatewayInterfaceIsMarked(t *testing.T) {
 		return []nm.DeviceWired{mockDevice1, mockDevice2}
 	})
 
-	patches.ApplyFunc(DBusToProto, func(device nm.DeviceWired) *v1.Interface {
+	patches.ApplyFunc(DBusToProto, func(device nm.DeviceWired, _ *InterfaceMask) *v1.Interface {
 		name, _ := device.GetPropertyInterface()
 		return &v1.Interface{
 			InterfaceName: name,
@@ -341,7 +345,7 @@ func Test_GetEthernetInterfaces_EnsuresGatewayInterfaceIsMarked(t *testing.T) {
 		}
 	})
 
-	result := nc.GetEthernetInterfaces()
+	result := nc.GetEthernetInterfaces(nil)
 
 	// Assert that at least one interface has GatewayInterface set to true
 	gatewayInterfaceFound := false
@@ -403,7 +407,7 @@ func Test_Apply_ReturnsNilWhenNoBackup(t *testing.T) {
 	defer patches.Reset()
 
 	patches.ApplyPrivateMethod(reflect.TypeOf(nc), "applyAndBackupSettings",
-		func(_ *NetworkConfigurator, _ *v1.Interface) (nm.ConnectionSettings, error) {
+		func(_ *NetworkConfigurator, _ *v1.Interface, _ v1.ApplyOptions_ForeignProfilePolicy) (nm.ConnectionSettings, error) {
 			return nil, nil // return nil backup
 		})
 
@@ -433,7 +437,7 @@ func Test_Apply_ReturnsErrorWhenTryApplyFails(t *testing.T) {
 	defer patches.Reset()
 
 	patches.ApplyPrivateMethod(reflect.TypeOf(nc), "applyAndBackupSettings",
-		func(_ *NetworkConfigurator, _ *v1.Interface) (nm.ConnectionSettings, error) {
+		func(_ *NetworkConfigurator, _ *v1.Interface, _ v1.ApplyOptions_ForeignProfilePolicy) (nm.ConnectionSettings, error) {
 			return mockBackup, expectedError
 		})
 
@@ -453,6 +457,7 @@ func Test_GetDeviceWithMac_ReturnsDeviceWithCorrectMacAddress(t *testing.T) {
 	mockDevice := &mockgnm.MockDeviceWired{}
 
 	mockDevice.On("GetPropertyHwAddress").Return(testMac, nil)
+	mockDevice.On("GetPropertyPermHwAddress").Return(testMac, nil)
 
 	patches := gomonkey.NewPatches()
 	defer patches.Reset()
@@ -484,6 +489,45 @@ func Test_GetDeviceWithMac_ReturnsNilWhenNoMatchingDevice(t *testing.T) {
 	assert.Nil(t, result, "getDeviceWithMac should return a nil DeviceWired instance when no device with the matching MAC address is found")
 }
 
+func Test_Apply_FindsDeviceWithClonedMAC(t *testing.T) {
+	nc := &NetworkConfigurator{}
+	mockDevice := &mockgnm.MockDeviceWired{}
+	mockDevice.On("GetPropertyPermHwAddress").Return("00:0A:95:9D:68:16", nil)
+	// the MAC address in use is the cloned one, once the settings are applied
+	mockDevice.On("GetPropertyHwAddress").Return("02:11:22:33:44:55", nil)
+	mockDevice.On("GetPropertyInterface").Return("eth0", nil)
+	newSettings := &v1.NetworkSettings{
+		Interfaces: []*v1.Interface{{
+			MacAddress: "00:0a:95:9d:68:16",
+			DHCP:       Enabled,
+			Link:       &v1.Interface_LinkConf{ClonedMAC: "02:11:22:33:44:55"},
+		}},
+	}
+
+	patches := gomonkey.NewPatches()
+	defer patches.Reset()
+	patches.ApplyPrivateMethod(reflect.TypeOf(nc), "getAllEthernetDevices",
+		func(_ *NetworkConfigurator) []nm.DeviceWired { return []nm.DeviceWired{mockDevice} })
+	patches.ApplyPrivateMethod(reflect.TypeOf(nc), "createBackupFromExisting",
+		func(_ *NetworkConfigurator, _ nm.DeviceWired, _ v1.ApplyOptions_ForeignProfilePolicy) nm.ConnectionSettings {
+			return nil
+		})
+	var updated []string
+	patches.ApplyPrivateMethod(reflect.TypeOf(nc), "updateConnections",
+		func(_ *NetworkConfigurator, device nm.DeviceWired, _ nm.ConnectionSettings, _ v1.ApplyOptions_ForeignProfilePolicy) error {
+			mac, _ := deviceMac(device)
+			updated = append(updated, mac)
+			return nil
+		})
+	patches.ApplyFunc(ConfigureExistingGatewayInterfacesExceptProtoData, func(_ *v1.Interface, _ NetworkConfigurator) error {
+		return nil
+	})
+
+	assert.NoError(t, nc.Apply(newSettings))
+	assert.NoError(t, nc.Apply(newSettings), "The device should still be found by its permanent MAC address")
+	assert.Equal(t, []string{"00:0A:95:9D:68:16", "00:0A:95:9D:68:16"}, updated)
+}
+
 func Test_GetDeviceWithLabel_ReturnsDeviceWithCorrectLabel(t *testing.T) {
 	testLabel := "eth0"
 	otherLabel := "eth1"
@@ -595,7 +639,7 @@ func Test_ApplyAndBackupSettings_ReturnsErrorWhenGetDeviceFails(t *testing.T) {
 		return nil, errors.New("getDeviceBy error")
 	})
 
-	backup, err := nc.applyAndBackupSettings(protoData)
+	backup, err := nc.applyAndBackupSettings(protoData, v1.ApplyOptions_DELETE)
 
 	assert.Nil(t, backup, "applyAndBackupSettings should return a nil backup when getDeviceBy fails")
 	assert.NotNil(t, err, "applyAndBackupSettings should return an error when getDeviceBy fails")
@@ -615,7 +659,7 @@ func Test_ApplyAndBackupSettings_ReturnsErrorWhenPrepareSettingsFails(t *testing
 		return mockDevice, nil
 	})
 
-	patches.ApplyPrivateMethod(reflect.TypeOf(nc), "createBackupFromExisting", func(_ *NetworkConfigurator, _ nm.DeviceWired) nm.ConnectionSettings {
+	patches.ApplyPrivateMethod(reflect.TypeOf(nc), "createBackupFromExisting", func(_ *NetworkConfigurator, _ nm.DeviceWired, _ v1.ApplyOptions_ForeignProfilePolicy) nm.ConnectionSettings {
 		return expectedBackup
 	})
 
@@ -623,7 +667,7 @@ func Test_ApplyAndBackupSettings_ReturnsErrorWhenPrepareSettingsFails(t *testing
 		return nil, errors.New("prepareSettings error")
 	})
 
-	backup, err := nc.applyAndBackupSettings(protoData)
+	backup, err := nc.applyAndBackupSettings(protoData, v1.ApplyOptions_DELETE)
 
 	assert.Equal(t, expectedBackup, backup, "applyAndBackupSettings should return the correct backup when prepareSettings fails")
 	assert.NotNil(t, err, "applyAndBackupSettings should return an error when prepareSettings fails")
@@ -644,7 +688,7 @@ func Test_ApplyAndBackupSettings_ReturnsErrorWhenUpdateConnectionsFails(t *testi
 		return mockDevice, nil
 	})
 
-	patches.ApplyPrivateMethod(reflect.TypeOf(nc), "createBackupFromExisting", func(_ *NetworkConfigurator, _ nm.DeviceWired) nm.ConnectionSettings {
+	patches.ApplyPrivateMethod(reflect.TypeOf(nc), "createBackupFromExisting", func(_ *NetworkConfigurator, _ nm.DeviceWired, _ v1.ApplyOptions_ForeignProfilePolicy) nm.ConnectionSettings {
 		return expectedBackup
 	})
 
@@ -652,11 +696,11 @@ func Test_ApplyAndBackupSettings_ReturnsErrorWhenUpdateConnectionsFails(t *testi
 		return mockSettings, nil
 	})
 
-	patches.ApplyPrivateMethod(reflect.TypeOf(nc), "updateConnections", func(_ *NetworkConfigurator, _ nm.DeviceWired, _ nm.ConnectionSettings) error {
+	patches.ApplyPrivateMethod(reflect.TypeOf(nc), "updateConnections", func(_ *NetworkConfigurator, _ nm.DeviceWired, _ nm.ConnectionSettings, _ v1.ApplyOptions_ForeignProfilePolicy) error {
 		return errors.New("updateConnections error")
 	})
 
-	backup, err := nc.applyAndBackupSettings(protoData)
+	backup, err := nc.applyAndBackupSettings(protoData, v1.ApplyOptions_DELETE)
 
 	assert.Equal(t, expectedBackup, backup, "applyAndBackupSettings should return the correct backup when updateConnections fails")
 	assert.NotNil(t, err, "applyAndBackupSettings should return an error when updateConnections fails")
@@ -677,7 +721,7 @@ func Test_ApplyAndBackupSettings_ReturnsErrorWhenSetRouteMetricFails(t *testing.
 		return mockDevice, nil
 	})
 
-	patches.ApplyPrivateMethod(reflect.TypeOf(nc), "createBackupFromExisting", func(_ *NetworkConfigurator, _ nm.DeviceWired) nm.ConnectionSettings {
+	patches.ApplyPrivateMethod(reflect.TypeOf(nc), "createBackupFromExisting", func(_ *NetworkConfigurator, _ nm.DeviceWired, _ v1.ApplyOptions_ForeignProfilePolicy) nm.ConnectionSettings {
 		return expectedBackup
 	})
 
@@ -685,7 +729,7 @@ func Test_ApplyAndBackupSettings_ReturnsErrorWhenSetRouteMetricFails(t *testing.
 		return mockSettings, nil
 	})
 
-	patches.ApplyPrivateMethod(reflect.TypeOf(nc), "updateConnections", func(_ *NetworkConfigurator, _ nm.DeviceWired, _ nm.ConnectionSettings) error {
+	patches.ApplyPrivateMethod(reflect.TypeOf(nc), "updateConnections", func(_ *NetworkConfigurator, _ nm.DeviceWired, _ nm.ConnectionSettings, _ v1.ApplyOptions_ForeignProfilePolicy) error {
 		return nil
 	})
 
@@ -693,7 +737,7 @@ func Test_ApplyAndBackupSettings_ReturnsErrorWhenSetRouteMetricFails(t *testing.
 		return errors.New("ConfigureExistingGatewayInterfacesExceptProtoData error")
 	})
 
-	backup, err := nc.applyAndBackupSettings(protoData)
+	backup, err := nc.applyAndBackupSettings(protoData, v1.ApplyOptions_DELETE)
 
 	assert.Equal(t, expectedBackup, backup, "applyAndBackupSettings should return the correct backup when ConfigureExistingGatewayInterfacesExceptProtoData fails")
 	assert.NotNil(t, err, "applyAndBackupSettings should return an error when ConfigureExistingGatewayInterfacesExceptProtoData fails")
@@ -714,7 +758,7 @@ func Test_ApplyAndBackupSettings_Success(t *testing.T) {
 		return mockDevice, nil
 	})
 
-	patches.ApplyPrivateMethod(reflect.TypeOf(nc), "createBackupFromExisting", func(_ *NetworkConfigurator, _ nm.DeviceWired) nm.ConnectionSettings {
+	patches.ApplyPrivateMethod(reflect.TypeOf(nc), "createBackupFromExisting", func(_ *NetworkConfigurator, _ nm.DeviceWired, _ v1.ApplyOptions_ForeignProfilePolicy) nm.ConnectionSettings {
 		return expectedBackup
 	})
 
@@ -722,7 +766,7 @@ func Test_ApplyAndBackupSettings_Success(t *testing.T) {
 		return mockSettings, nil
 	})
 
-	patches.ApplyPrivateMethod(reflect.TypeOf(nc), "updateConnections", func(_ *NetworkConfigurator, _ nm.DeviceWired, _ nm.ConnectionSettings) error {
+	patches.ApplyPrivateMethod(reflect.TypeOf(nc), "updateConnections", func(_ *NetworkConfigurator, _ nm.DeviceWired, _ nm.ConnectionSettings, _ v1.ApplyOptions_ForeignProfilePolicy) error {
 		return nil
 	})
 
@@ -730,7 +774,7 @@ func Test_ApplyAndBackupSettings_Success(t *testing.T) {
 		return nil
 	})
 
-	backup, err := nc.applyAndBackupSettings(protoData)
+	backup, err := nc.applyAndBackupSettings(protoData, v1.ApplyOptions_DELETE)
 
 	assert.Equal(t, expectedBackup, backup, "applyAndBackupSettings should return the correct backup on success")
 	assert.Nil(t, err, "applyAndBackupSettings should not return an error on success")
@@ -800,6 +844,9 @@ func Test_UpdateConnections_Success(t *testing.T) {
 		return nil
 	})
 
+	patches.ApplyMethod(reflect.TypeOf(mockDevice), "GetPropertyPermHwAddress", func(_ nm.DeviceWired) (string, error) {
+		return "", nil
+	})
 	patches.ApplyMethod(reflect.TypeOf(mockDevice), "GetPropertyHwAddress", func(_ nm.DeviceWired) (string, error) {
 		return "00:0a:95:9d:68:16", nil
 	})
@@ -808,7 +855,7 @@ func Test_UpdateConnections_Success(t *testing.T) {
 		return nil
 	})
 
-	err := nc.updateConnections(mockDevice, mockSettings)
+	err := nc.updateConnections(mockDevice, mockSettings, v1.ApplyOptions_DELETE)
 
 	assert.Nil(t, err, "updateConnections should not return an error on success")
 }
@@ -831,7 +878,7 @@ func Test_UpdateConnections_DeleteOldConnectionsFails(t *testing.T) {
 		return expectedError
 	})
 
-	err := nc.updateConnections(mockDevice, mockSettings)
+	err := nc.updateConnections(mockDevice, mockSettings, v1.ApplyOptions_DELETE)
 
 	assert.NotNil(t, err, "updateConnections should return an error when deleteOldConnections fails")
 	assert.Equal(t, expectedError, err, "updateConnections should return the correct error message")
@@ -855,11 +902,14 @@ func Test_UpdateConnections_GetPropertyHwAddressFails(t *testing.T) {
 		return nil
 	})
 
+	patches.ApplyMethod(reflect.TypeOf(mockDevice), "GetPropertyPermHwAddress", func(_ nm.DeviceWired) (string, error) {
+		return "", nil
+	})
 	patches.ApplyMethod(reflect.TypeOf(mockDevice), "GetPropertyHwAddress", func(_ nm.DeviceWired) (string, error) {
 		return "", expectedError
 	})
 
-	err := nc.updateConnections(mockDevice, mockSettings)
+	err := nc.updateConnections(mockDevice, mockSettings, v1.ApplyOptions_DELETE)
 
 	assert.NotNil(t, err, "updateConnections should return an error when GetPropertyHwAddress fails")
 	assert.Equal(t, expectedError, err, "updateConnections should return the correct error message")
@@ -883,6 +933,9 @@ func Test_UpdateConnections_AddConnectionFails(t *testing.T) {
 		return nil
 	})
 
+	patches.ApplyMethod(reflect.TypeOf(mockDevice), "GetPropertyPermHwAddress", func(_ nm.DeviceWired) (string, error) {
+		return "", nil
+	})
 	patches.ApplyMethod(reflect.TypeOf(mockDevice), "GetPropertyHwAddress", func(_ nm.DeviceWired) (string, error) {
 		return "00:0a:95:9d:68:16", nil
 	})
@@ -891,7 +944,7 @@ func Test_UpdateConnections_AddConnectionFails(t *testing.T) {
 		return expectedError
 	})
 
-	err := nc.updateConnections(mockDevice, mockSettings)
+	err := nc.updateConnections(mockDevice, mockSettings, v1.ApplyOptions_DELETE)
 
 	assert.NotNil(t, err, "updateConnections should return an error when addConnection fails")
 	assert.Equal(t, expectedError, err, "updateConnections should return the correct error message")
@@ -1037,7 +1090,7 @@ func Test_CreateBackupFromExisting_ReturnsBackupWithValidConnection(t *testing.T
 		return errors.New("error from setMACAddressInBackup")
 	})
 
-	result := nc.createBackupFromExisting(testDevice)
+	result := nc.createBackupFromExisting(testDevice, v1.ApplyOptions_DELETE)
 
 	assert.Equal(t, expectedBackup["connection"]["id"], result["connection"]["id"], "ID should match")
 	assert.Equal(t, expectedBackup["connection"]["type"], result["connection"]["type"], "Type should match")
@@ -1054,7 +1107,7 @@ func Test_CreateBackupFromExisting_ReturnsNilWhenNoConnections(t *testing.T) {
 	// Mock listConnections function to return an empty list
 	patches.ApplyFunc(listConnections, func(_ nm.Device) []nm.Connection { return []nm.Connection{} })
 
-	result := nc.createBackupFromExisting(testDevice)
+	result := nc.createBackupFromExisting(testDevice, v1.ApplyOptions_DELETE)
 
 	assert.Nil(t, result, "createBackupFromExisting should return a nil ConnectionSettings instance when no connections are found")
 }
@@ -0,0 +1,158 @@
+/*
+ * Copyright © Siemens 2025. ALL RIGHTS RESERVED.
+ * Licensed under the MIT license
+ * See LICENSE file in the top-level directory
+ */
+
+package networking
+
+import (
+	"fmt"
+	"log"
+	"net"
+	v1 "networkservice/api/siemens_iedge_dmapi_v1"
+	"regexp"
+	"strings"
+	"sync"
+
+	nm "github.com/Wifx/gonetworkmanager/v2"
+)
+
+// legacyProfileID matches the IDs of profiles created before they were tagged, e.g. "x1_static" or "00:0A:95:9D:68:16_dhcp".
+var legacyProfileID = regexp.MustCompile(fmt.Sprintf("^(.+)_(%s|%s)$", Static, DHCP))
+
+// labelMapCache holds the label map for classifying untagged profiles, so it is not read for every profile.
+// WriteMapToFile invalidates it.
+type labelMapCache struct {
+	mu       sync.Mutex
+	loaded   bool
+	labelMap map[string]string
+}
+
+// legacyLabels is the label map used by isLegacyProfile.
+var legacyLabels = &labelMapCache{}
+
+// get returns the cached label map, reading it on first use. A label map which can not be read is cached as nil.
+func (c *labelMapCache) get() map[string]string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if !c.loaded {
+		labelMap, err := readMapFromFile(LabelMapFileName)
+		if err != nil {
+			labelMap = nil
+		}
+		c.labelMap = GetMapWithUppercase(labelMap)
+		c.loaded = true
+	}
+	return c.labelMap
+}
+
+// invalidate makes the next get read the label map again.
+func (c *labelMapCache) invalidate() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.loaded = false
+	c.labelMap = nil
+}
+
+// profileOwner returns OwnerServiceName if the profile was created by this service, OwnerForeign otherwise.
+func profileOwner(settings nm.ConnectionSettings) string {
+	if isServiceProfile(settings) {
+		return OwnerServiceName
+	}
+	return OwnerForeign
+}
+
+// isServiceProfile reports whether the profile is tagged by this service, or has the ID scheme of this service.
+func isServiceProfile(settings nm.ConnectionSettings) bool {
+	if userData(settings)[OwnerTagKey] == OwnerServiceName {
+		return true
+	}
+	return isLegacyProfile(settings)
+}
+
+// isLegacyProfile reports whether the untagged profile has the ID scheme of this service. Only IDs prefixed with
+// a label of the label map or with the MAC address of the profile qualify, so foreign profiles like
+// "maintenance_static" are not taken over.
+func isLegacyProfile(settings nm.ConnectionSettings) bool {
+	id, _ := settings[ConnectionKey][IDKey].(string)
+	match := legacyProfileID.FindStringSubmatch(id)
+	if match == nil {
+		return false
+	}
+
+	prefix := match[1]
+	if mac, ok := settings[EthernetType][MACAddressKey].([]byte); ok && len(mac) > 0 &&
+		strings.EqualFold(prefix, net.HardwareAddr(mac).String()) {
+		return true
+	}
+	_, ok := legacyLabels.get()[strings.ToUpper(prefix)]
+	return ok
+}
+
+// userData returns the user data of the profile, or nil.
+func userData(settings nm.ConnectionSettings) map[string]string {
+	data, _ := settings[UserKey][UserDataKey].(map[string]string)
+	return data
+}
+
+// tagOwnership marks the profile as created by this service. Other user data of the profile is kept.
+func tagOwnership(settings nm.ConnectionSettings) {
+	data := map[string]string{OwnerTagKey: OwnerServiceName}
+	for key, value := range userData(settings) {
+		if key != OwnerTagKey {
+			data[key] = value
+		}
+	}
+	if settings[UserKey] == nil {
+		settings[UserKey] = make(dict)
+	}
+	settings[UserKey][UserDataKey] = data
+}
+
+// managedConnections returns the connections of the device, which may be replaced by this service, and the
+// foreign connections, which have to be handled according to the given policy.
+func managedConnections(device nm.DeviceWired, policy v1.ApplyOptions_ForeignProfilePolicy) (managed, foreign []nm.Connection) {
+	connections := listConnections(device)
+	if policy == v1.ApplyOptions_DELETE {
+		return connections, nil
+	}
+
+	for _, connection := range connections {
+		settings, err := connection.GetSettings()
+		if err == nil && !isServiceProfile(settings) {
+			foreign = append(foreign, connection)
+		} else {
+			managed = append(managed, connection)
+		}
+	}
+	return managed, foreign
+}
+
+// handleForeignProfiles applies the given policy to the foreign connections of a device.
+func handleForeignProfiles(foreign []nm.Connection, policy v1.ApplyOptions_ForeignProfilePolicy) error {
+	if policy != v1.ApplyOptions_DISABLE {
+		return nil
+	}
+
+	for _, connection := range foreign {
+		settings, err := connection.GetSettings()
+		if err != nil {
+			return fmt.Errorf("failed to get settings for connection: %w", err)
+		}
+		if autoconnect, ok := settings[ConnectionKey][AutoconnectKey].(bool); ok && !autoconnect {
+			continue
+		}
+
+		disabled := copySettings(settings)
+		disabled[ConnectionKey][AutoconnectKey] = false
+		removeDeprecatedKeys(disabled)
+		if err := connection.Update(disabled); err != nil {
+			return fmt.Errorf("failed to disable foreign connection: %w", err)
+		}
+		log.Printf("autoconnect of foreign connection %v has been disabled", settings[ConnectionKey][IDKey])
+	}
+	return nil
+}
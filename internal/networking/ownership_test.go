@@ -0,0 +1,187 @@
+/*
+ * Copyright © Siemens 2025. ALL RIGHTS RESERVED.
+ * Licensed under the MIT license
+ * See LICENSE file in the top-level directory
+ */
+
+package networking
+
+import (
+	"errors"
+	"io/fs"
+	"io/ioutil"
+	v1 "networkservice/api/siemens_iedge_dmapi_v1"
+	mockgnm "networkservice/internal/networking/mocks/gonetworkmanager"
+	"reflect"
+	"testing"
+
+	nm "github.com/Wifx/gonetworkmanager/v2"
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// getMockForeignSettings returns a standby profile created with nmcli.
+func getMockForeignSettings() nm.ConnectionSettings {
+	return nm.ConnectionSettings{
+		ConnectionKey: {IDKey: "maintenance", UUIDKey: "f0e1d2c3-0000-4000-8000-000000000001", TypeKey: EthernetType},
+		IPV4Key:       {MethodKey: Manual},
+		UserKey:       {UserDataKey: map[string]string{"org.example.purpose": "standby"}},
+	}
+}
+
+// useLegacyLabels makes isLegacyProfile use the given label map until the end of the test.
+func useLegacyLabels(t *testing.T, labelMap map[string]string) {
+	legacyLabels.mu.Lock()
+	legacyLabels.loaded = true
+	legacyLabels.labelMap = labelMap
+	legacyLabels.mu.Unlock()
+	t.Cleanup(legacyLabels.invalidate)
+}
+
+func Test_profileOwner(t *testing.T) {
+	tagged := getMockForeignSettings()
+	tagOwnership(tagged)
+
+	assert.Equal(t, OwnerServiceName, profileOwner(tagged))
+	assert.Equal(t, OwnerServiceName, profileOwner(getMockActiveStaticSettings()), "Profiles with the legacy ID scheme are owned by the service")
+	assert.Equal(t, OwnerServiceName, profileOwner(newSettingsFromProto(getMockStaticProto(), "eth0")))
+	assert.Equal(t, OwnerForeign, profileOwner(getMockForeignSettings()))
+	assert.Equal(t, OwnerForeign, profileOwner(nm.ConnectionSettings{ConnectionKey: {IDKey: "Wired connection 1"}}))
+}
+
+func Test_isServiceProfile_LegacyIDs(t *testing.T) {
+	useLegacyLabels(t, map[string]string{"X1": "ETH0"})
+
+	mac := []byte{0x00, 0x0A, 0x95, 0x9D, 0x68, 0x16}
+	assert.True(t, isServiceProfile(nm.ConnectionSettings{ConnectionKey: {IDKey: "x1_static"}}), "The prefix is a label of the label map")
+	assert.True(t, isServiceProfile(nm.ConnectionSettings{ConnectionKey: {IDKey: "00:0A:95:9D:68:16_dhcp"},
+		EthernetType: {MACAddressKey: mac}}), "The prefix is the MAC address of the profile")
+	assert.False(t, isServiceProfile(nm.ConnectionSettings{ConnectionKey: {IDKey: "maintenance_static"}}))
+	assert.False(t, isServiceProfile(nm.ConnectionSettings{ConnectionKey: {IDKey: "00:0A:95:9D:68:17_dhcp"},
+		EthernetType: {MACAddressKey: mac}}), "The prefix is the MAC address of another device")
+}
+
+func Test_isLegacyProfile_ReadsLabelMapOnceUntilWritten(t *testing.T) {
+	t.Cleanup(legacyLabels.invalidate)
+	legacyLabels.invalidate()
+	reads := 0
+	patches := gomonkey.ApplyFunc(readMapFromFile, func(_ string) (map[string]string, error) {
+		reads++
+		return map[string]string{"x1": "eth0"}, nil
+	})
+	defer patches.Reset()
+	patches.ApplyFunc(ioutil.WriteFile, func(_ string, _ []byte, _ fs.FileMode) error {
+		return nil
+	})
+
+	assert.True(t, isLegacyProfile(nm.ConnectionSettings{ConnectionKey: {IDKey: "x1_static"}}))
+	assert.False(t, isLegacyProfile(nm.ConnectionSettings{ConnectionKey: {IDKey: "x2_dhcp"}}))
+	assert.Equal(t, 1, reads, "The label map should be read once")
+
+	assert.NoError(t, WriteMapToFile(map[string]string{"X2": "ETH1"}, LabelMapFileName))
+	assert.True(t, isLegacyProfile(nm.ConnectionSettings{ConnectionKey: {IDKey: "x1_static"}}))
+	assert.Equal(t, 2, reads, "Writing the label map should invalidate the cached one")
+}
+
+func Test_tagOwnership_KeepsUserData(t *testing.T) {
+	settings := getMockForeignSettings()
+
+	tagOwnership(settings)
+
+	assert.Equal(t, map[string]string{OwnerTagKey: OwnerServiceName, "org.example.purpose": "standby"}, userData(settings))
+}
+
+func Test_mergeSettings_TagsProfile(t *testing.T) {
+	desired := newSettingsFromProto(getMockStaticProto(), "eth0")
+
+	merged := mergeSettings(getMockForeignSettings(), desired)
+
+	assert.Equal(t, OwnerServiceName, userData(merged)[OwnerTagKey])
+	assert.Equal(t, "standby", userData(merged)["org.example.purpose"], "Other user data should be preserved")
+}
+
+func Test_managedConnections(t *testing.T) {
+	mockOwned := &mockgnm.MockConnection{}
+	mockOwned.On("GetSettings").Return(getMockActiveStaticSettings(), nil)
+	mockForeign := &mockgnm.MockConnection{}
+	mockForeign.On("GetSettings").Return(getMockForeignSettings(), nil)
+
+	patches := gomonkey.NewPatches()
+	defer patches.Reset()
+	patches.ApplyFunc(listConnections, func(_ nm.DeviceWired) []nm.Connection {
+		return []nm.Connection{mockOwned, mockForeign}
+	})
+
+	managed, foreign := managedConnections(&mockgnm.MockDeviceWired{}, v1.ApplyOptions_LEAVE)
+	assert.Equal(t, []nm.Connection{mockOwned}, managed)
+	assert.Equal(t, []nm.Connection{mockForeign}, foreign)
+
+	managed, foreign = managedConnections(&mockgnm.MockDeviceWired{}, v1.ApplyOptions_DELETE)
+	assert.Equal(t, []nm.Connection{mockOwned, mockForeign}, managed, "All connections should be replaced with DELETE")
+	assert.Nil(t, foreign)
+}
+
+func Test_handleForeignProfiles_DisablesAutoconnect(t *testing.T) {
+	mockForeign := &mockgnm.MockConnection{}
+	mockForeign.On("GetSettings").Return(getMockForeignSettings(), nil)
+	mockForeign.On("Update", mock.Anything).Return(nil)
+	disabled := getMockForeignSettings()
+	disabled[ConnectionKey][AutoconnectKey] = false
+	mockDisabled := &mockgnm.MockConnection{}
+	mockDisabled.On("GetSettings").Return(disabled, nil)
+
+	err := handleForeignProfiles([]nm.Connection{mockForeign, mockDisabled}, v1.ApplyOptions_DISABLE)
+
+	assert.NoError(t, err)
+	mockForeign.AssertCalled(t, "Update", mock.MatchedBy(func(settings nm.ConnectionSettings) bool {
+		return settings[ConnectionKey][AutoconnectKey] == false && settings[ConnectionKey][IDKey] == "maintenance"
+	}))
+	mockDisabled.AssertNotCalled(t, "Update", mock.Anything)
+
+	assert.NoError(t, handleForeignProfiles([]nm.Connection{&mockgnm.MockConnection{}}, v1.ApplyOptions_LEAVE),
+		"Foreign profiles should not be touched with LEAVE")
+}
+
+func Test_handleForeignProfiles_ReturnsUpdateError(t *testing.T) {
+	mockForeign := &mockgnm.MockConnection{}
+	mockForeign.On("GetSettings").Return(getMockForeignSettings(), nil)
+	mockForeign.On("Update", mock.Anything).Return(errors.New("update error"))
+
+	err := handleForeignProfiles([]nm.Connection{mockForeign}, v1.ApplyOptions_DISABLE)
+
+	assert.Error(t, err)
+}
+
+func Test_UpdateConnections_LeavesForeignProfiles(t *testing.T) {
+	nc := &NetworkConfigurator{}
+	mockForeign := &mockgnm.MockConnection{}
+	mockForeign.On("GetSettings").Return(getMockForeignSettings(), nil)
+	mockDeviceWired := &mockgnm.MockDeviceWired{}
+	mockDeviceWired.On("GetPropertyHwAddress").Return("00:0a:95:9d:68:16", nil)
+	mockDeviceWired.On("GetPropertyPermHwAddress").Return("00:0a:95:9d:68:16", nil)
+
+	patches := gomonkey.NewPatches()
+	defer patches.Reset()
+	patches.ApplyFunc(listConnections, func(_ nm.DeviceWired) []nm.Connection {
+		return []nm.Connection{mockForeign}
+	})
+	var deleted []nm.Connection
+	patches.ApplyPrivateMethod(reflect.TypeOf(nc), "deleteOldConnections", func(_ *NetworkConfigurator, connections []nm.Connection) error {
+		deleted = append(deleted, connections...)
+		return nil
+	})
+	var added nm.ConnectionSettings
+	patches.ApplyPrivateMethod(reflect.TypeOf(nc), "addConnection", func(_ *NetworkConfigurator, _ string, settings nm.ConnectionSettings) error {
+		added = settings
+		return nil
+	})
+
+	err := nc.updateConnections(mockDeviceWired, newSettingsFromProto(getMockStaticProto(), "eth0"), v1.ApplyOptions_LEAVE)
+
+	assert.NoError(t, err)
+	assert.Empty(t, deleted, "Foreign profiles should not be deleted with LEAVE")
+	assert.Equal(t, OwnerServiceName, profileOwner(added))
+	assert.NotContains(t, userData(added), "org.example.purpose", "The foreign profile should not be taken over")
+	mockForeign.AssertNotCalled(t, "Update", mock.Anything)
+}
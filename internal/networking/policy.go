@@ -0,0 +1,269 @@
+/*
+ * Copyright © Siemens 2025. ALL RIGHTS RESERVED.
+ * Licensed under the MIT license
+ * See LICENSE file in the top-level directory
+ */
+
+package networking
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	v1 "networkservice/api/siemens_iedge_dmapi_v1"
+	"slices"
+	"strings"
+	"sync"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// ErrInvalidPolicy is returned for policy files, which can not be enforced.
+var ErrInvalidPolicy = errors.New("invalid policy")
+
+// policyStore holds the policy of the device builder loaded at startup.
+type policyStore struct {
+	mu     sync.RWMutex
+	policy *v1.NetworkPolicy
+	err    error
+}
+
+func newPolicyStore() *policyStore {
+	return &policyStore{}
+}
+
+// LoadPolicy reads the policy from PolicyFileName, e.g: {"Rules": [{"Label": "X1", "DHCP": "enabled"},
+// {"Label": "X2", "AllowedNetworks": ["192.168.0.0/16"]}], "GatewayInterfaces": ["X1"], "L2Interfaces": ["X2"]}
+// Without policy file every setting is allowed. A policy, which can not be read or is invalid, rejects every apply
+// until it is fixed, so the constraints are never silently dropped.
+func (nc *NetworkConfigurator) LoadPolicy() error {
+	policy := &v1.NetworkPolicy{}
+	_, err := readProtoFile(PolicyFileName, policy)
+	if err == nil {
+		err = validatePolicy(policy)
+	}
+	if err != nil {
+		policy = nil
+		err = fmt.Errorf("%w: %v", ErrInvalidPolicy, err)
+	}
+
+	nc.policy.mu.Lock()
+	defer nc.policy.mu.Unlock()
+	nc.policy.policy = policy
+	nc.policy.err = err
+	return err
+}
+
+// Policy returns the active policy, it is empty if the device has no policy.
+func (nc *NetworkConfigurator) Policy() (*v1.NetworkPolicy, error) {
+	if nc.policy == nil {
+		return &v1.NetworkPolicy{}, nil
+	}
+	nc.policy.mu.RLock()
+	defer nc.policy.mu.RUnlock()
+	if nc.policy.err != nil {
+		return nil, nc.policy.err
+	}
+	if nc.policy.policy == nil {
+		return &v1.NetworkPolicy{}, nil
+	}
+	return proto.Clone(nc.policy.policy).(*v1.NetworkPolicy), nil
+}
+
+// validatePolicy checks that every constraint of the policy can be evaluated.
+func validatePolicy(policy *v1.NetworkPolicy) error {
+	for _, rule := range policy.Rules {
+		if rule.Label == "" {
+			return errors.New("rule without label")
+		}
+		if rule.DHCP != "" && rule.DHCP != Enabled && rule.DHCP != Disabled && rule.DHCP != Fallback {
+			return fmt.Errorf("DHCP of %v has to be %v, %v or %v, not %v", rule.Label, Enabled, Disabled, Fallback, rule.DHCP)
+		}
+		for _, network := range rule.AllowedNetworks {
+			if _, parsed, err := net.ParseCIDR(network); err != nil || parsed.IP.To4() == nil {
+				return fmt.Errorf("allowed network %v of %v is not an IPv4 network", network, rule.Label)
+			}
+		}
+	}
+	return nil
+}
+
+// verifyPolicy adds a violation to the result for every setting, which is not allowed by the policy. The labels of
+// the policy protect the ports they are mapped to on the device, so a label map of the settings can not move them.
+func verifyPolicy(newSettings *v1.NetworkSettings, result *verifyResult, configurator *NetworkConfigurator) {
+	policy, err := configurator.Policy()
+	if err != nil {
+		result.retVal = false
+		result.builder.WriteString(fmt.Sprintf("policy can not be enforced: %v \n", err))
+		return
+	}
+	if len(policy.Rules) == 0 && len(policy.GatewayInterfaces) == 0 && len(policy.L2Interfaces) == 0 {
+		return
+	}
+
+	policyMap := configurator.policyLabelMap(newSettings)
+	verifyPolicyLabelMap(newSettings, policy, policyMap, result)
+
+	labelMap := importLabelMap(newSettings)
+	for _, element := range newSettings.Interfaces {
+		labels := configurator.policyLabels(element, labelMap, policyMap)
+		identifier := strings.ToUpper(element.Label)
+		if len(labels) > 0 {
+			identifier = labels[0]
+		} else if identifier == "" {
+			identifier = element.MacAddress
+		}
+
+		for _, rule := range policy.Rules {
+			if containsLabel(labels, rule.Label) {
+				verifyRule(element, rule, identifier, result)
+				// VLANs use the port of their parent, they are checked against its rule
+				for _, vlan := range element.GetVlans().GetInterfaces() {
+					vlanInterface := &v1.Interface{DHCP: vlan.DHCP, Static: vlan.Static}
+					verifyRule(vlanInterface, rule, fmt.Sprintf("VLAN %d of %s", vlan.ID, identifier), result)
+				}
+			}
+		}
+		if element.GatewayInterface && len(policy.GatewayInterfaces) > 0 && !containsAnyLabel(policy.GatewayInterfaces, labels) {
+			result.retVal = false
+			result.builder.WriteString(fmt.Sprintf("policy violation: %s may not be the gateway interface \n", identifier))
+		}
+		if element.L2Conf.GetStartingAddressIPv4() != "" && len(policy.L2Interfaces) > 0 && !containsAnyLabel(policy.L2Interfaces, labels) {
+			result.retVal = false
+			result.builder.WriteString(fmt.Sprintf("policy violation: %s may not have an L2Conf \n", identifier))
+		}
+	}
+
+	// Bonds use the ports of their members, they are checked against the rule of every member
+	for _, bond := range newSettings.GetBonds().GetInterfaces() {
+		bondInterface := &v1.Interface{DHCP: bond.DHCP, Static: bond.Static}
+		for _, member := range bond.Members {
+			for _, label := range configurator.policyLabels(memberInterface(member), labelMap, policyMap) {
+				for _, rule := range policy.Rules {
+					if strings.EqualFold(rule.Label, label) {
+						verifyRule(bondInterface, rule, fmt.Sprintf("bond %s of %s", bond.Name, label), result)
+					}
+				}
+			}
+		}
+	}
+}
+
+// verifyPolicyLabelMap adds a violation for every label of the policy, which the label map of the settings maps to
+// another port than the label map of the device.
+func verifyPolicyLabelMap(newSettings *v1.NetworkSettings, policy *v1.NetworkPolicy, policyMap map[string]string, result *verifyResult) {
+	if len(newSettings.LabelMap) == 0 {
+		return
+	}
+	labelMap := GetMapWithUppercase(newSettings.LabelMap)
+	var labels []string
+	for _, rule := range policy.Rules {
+		labels = append(labels, strings.ToUpper(rule.Label))
+	}
+	for _, label := range append(append([]string{}, policy.GatewayInterfaces...), policy.L2Interfaces...) {
+		labels = append(labels, strings.ToUpper(label))
+	}
+	slices.Sort(labels)
+	for _, label := range slices.Compact(labels) {
+		port, ok := policyMap[label]
+		if ok && !strings.EqualFold(labelMap[label], port) {
+			result.retVal = false
+			result.builder.WriteString(fmt.Sprintf("policy violation: the label map may not move %s from %s \n", label, port))
+		}
+	}
+}
+
+// policyLabelMap returns the label map, which resolves the labels of the policy to their ports: the label map of the
+// device, or the one of the factory defaults if the device has none. The label map of the settings is only used
+// if there is neither of them, e.g. for the first provisioning.
+func (nc *NetworkConfigurator) policyLabelMap(newSettings *v1.NetworkSettings) map[string]string {
+	if labelMap, err := readMapFromFile(LabelMapFileName); err == nil && len(labelMap) != 0 {
+		return GetMapWithUppercase(labelMap)
+	}
+	if defaults, err := nc.FactoryDefaults(); err == nil && len(defaults.LabelMap) != 0 {
+		return GetMapWithUppercase(defaults.LabelMap)
+	}
+	return GetMapWithUppercase(newSettings.LabelMap)
+}
+
+// policyLabels returns the labels of policyMap, which are mapped to the port the interface settings are applied to.
+// The port is the device with the MAC address of the interface, or the one its label is mapped to by labelMap, the
+// label map of the apply. An interface, which can not be resolved to a port, only has its own label.
+func (nc *NetworkConfigurator) policyLabels(element *v1.Interface, labelMap, policyMap map[string]string) []string {
+	interfaceName := ""
+	if element.MacAddress != "" {
+		if device := nc.getDeviceWithMac(element.MacAddress); device != nil {
+			var err error
+			if interfaceName, err = device.GetPropertyInterface(); err != nil {
+				log.Println(err)
+			}
+		}
+	} else {
+		interfaceName = labelMap[strings.ToUpper(element.Label)]
+	}
+	if interfaceName == "" {
+		if element.Label == "" {
+			return nil
+		}
+		return []string{strings.ToUpper(element.Label)}
+	}
+
+	var labels []string
+	for label, port := range policyMap {
+		if strings.EqualFold(port, interfaceName) {
+			labels = append(labels, label)
+		}
+	}
+	slices.Sort(labels)
+	return labels
+}
+
+// verifyRule checks the settings of the interface against the rule of its label. An interface without DHCP mode is
+// applied as static interface.
+func verifyRule(element *v1.Interface, rule *v1.NetworkPolicy_InterfaceRule, identifier string, result *verifyResult) {
+	if rule.DHCP != "" && !strings.EqualFold(rule.DHCP, dhcpMode(element.DHCP)) {
+		result.retVal = false
+		result.builder.WriteString(fmt.Sprintf("policy violation: DHCP of %s has to stay %s \n", identifier, rule.DHCP))
+	}
+	if len(rule.AllowedNetworks) == 0 {
+		return
+	}
+	fallback := element.GetFallback().GetStatic()
+	for _, address := range []string{element.GetStatic().GetIPv4(), element.GetStatic().GetGateway(), fallback.GetIPv4(), fallback.GetGateway()} {
+		if address != "" && !inNetworks(address, rule.AllowedNetworks) {
+			result.retVal = false
+			result.builder.WriteString(fmt.Sprintf("policy violation: %s of %s is not in the allowed networks %s \n",
+				address, identifier, strings.Join(rule.AllowedNetworks, ", ")))
+		}
+	}
+}
+
+func containsLabel(labels []string, label string) bool {
+	for _, allowed := range labels {
+		if label != "" && strings.EqualFold(allowed, label) {
+			return true
+		}
+	}
+	return false
+}
+
+// containsAnyLabel reports whether one of the given labels is in the allowed labels.
+func containsAnyLabel(allowed, labels []string) bool {
+	for _, label := range labels {
+		if containsLabel(allowed, label) {
+			return true
+		}
+	}
+	return false
+}
+
+func inNetworks(address string, networks []string) bool {
+	ip := net.ParseIP(address)
+	for _, network := range networks {
+		if _, parsed, err := net.ParseCIDR(network); err == nil && ip != nil && parsed.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
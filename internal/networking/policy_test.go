@@ -0,0 +1,212 @@
+/*
+ * Copyright © Siemens 2025. ALL RIGHTS RESERVED.
+ * Licensed under the MIT license
+ * See LICENSE file in the top-level directory
+ */
+
+package networking
+
+import (
+	"errors"
+	v1 "networkservice/api/siemens_iedge_dmapi_v1"
+	mockgnm "networkservice/internal/networking/mocks/gonetworkmanager"
+	"testing"
+
+	nm "github.com/Wifx/gonetworkmanager/v2"
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+func getMockPolicyConfigurator() *NetworkConfigurator {
+	nc := &NetworkConfigurator{policy: newPolicyStore()}
+	nc.policy.policy = &v1.NetworkPolicy{
+		Rules: []*v1.NetworkPolicy_InterfaceRule{
+			{Label: "X1", DHCP: Enabled},
+			{Label: "x2", AllowedNetworks: []string{"192.168.0.0/16"}},
+		},
+		GatewayInterfaces: []string{"X1"},
+		L2Interfaces:      []string{"X3"},
+	}
+	return nc
+}
+
+func Test_verifyPolicy_AcceptsAllowedSettings(t *testing.T) {
+	nc := getMockPolicyConfigurator()
+	settings := &v1.NetworkSettings{
+		Interfaces: []*v1.Interface{
+			{Label: "X1", DHCP: Enabled, GatewayInterface: true},
+			{Label: "X2", DHCP: Disabled, Static: &v1.Interface_StaticConf{IPv4: "192.168.3.4", NetMask: "255.255.0.0", Gateway: "192.168.0.1"}},
+			{Label: "X3", L2Conf: &v1.Interface_L2{StartingAddressIPv4: "10.0.0.10"}},
+		},
+		LabelMap: map[string]string{"X1": "ens18", "X2": "ens19", "X3": "ens20"},
+	}
+
+	valid, err := verify(settings, nc)
+
+	assert.True(t, valid)
+	assert.NoError(t, err)
+}
+
+func Test_verifyPolicy_ReportsViolations(t *testing.T) {
+	nc := getMockPolicyConfigurator()
+	settings := &v1.NetworkSettings{
+		Interfaces: []*v1.Interface{
+			{Label: "X1", DHCP: Disabled, Static: &v1.Interface_StaticConf{IPv4: "10.0.0.1", NetMask: "255.0.0.0"}},
+			{Label: "X2", DHCP: Disabled, GatewayInterface: true, Static: &v1.Interface_StaticConf{IPv4: "10.0.0.2", NetMask: "255.0.0.0"}},
+			{MacAddress: "00:11:22:33:44:55", L2Conf: &v1.Interface_L2{StartingAddressIPv4: "10.0.0.10"}},
+		},
+		LabelMap: map[string]string{"X1": "ens18", "X2": "ens19", "X4": "ens21"},
+	}
+	device := new(mockgnm.MockDeviceWired)
+	device.On("GetPropertyInterface").Return("ens21", nil)
+	patches := gomonkey.ApplyPrivateMethod(nc, "getDeviceWithMac", func(_ *NetworkConfigurator, _ string) nm.DeviceWired {
+		return device
+	})
+	defer patches.Reset()
+
+	valid, err := verify(settings, nc)
+
+	assert.False(t, valid)
+	assert.ErrorContains(t, err, "DHCP of X1 has to stay enabled")
+	assert.ErrorContains(t, err, "10.0.0.2 of X2 is not in the allowed networks 192.168.0.0/16")
+	assert.ErrorContains(t, err, "X2 may not be the gateway interface")
+	assert.ErrorContains(t, err, "X4 may not have an L2Conf", "Interfaces given by MAC address should be checked by their label")
+}
+
+func Test_verifyPolicy_TreatsMissingDHCPAsStatic(t *testing.T) {
+	nc := getMockPolicyConfigurator()
+	settings := &v1.NetworkSettings{
+		Interfaces: []*v1.Interface{{Label: "X1", Static: &v1.Interface_StaticConf{IPv4: "192.168.0.1", NetMask: "255.255.255.0"}}},
+	}
+
+	valid, err := verify(settings, nc)
+
+	assert.False(t, valid)
+	assert.ErrorContains(t, err, "DHCP of X1 has to stay enabled")
+}
+
+func Test_verifyPolicy_ChecksVlansAgainstTheRuleOfTheirParent(t *testing.T) {
+	nc := getMockPolicyConfigurator()
+	patches := gomonkey.ApplyPrivateMethod(nc, "getAllEthernetDevices", func(_ *NetworkConfigurator) []nm.DeviceWired {
+		return nil
+	})
+	defer patches.Reset()
+	settings := &v1.NetworkSettings{
+		Interfaces: []*v1.Interface{
+			{Label: "X1", DHCP: Enabled, Vlans: &v1.Interface_VlanList{Interfaces: []*v1.Interface_VlanConf{
+				{ID: 100, DHCP: Enabled},
+				{ID: 200, DHCP: Disabled, Static: &v1.Interface_StaticConf{IPv4: "10.0.0.1", NetMask: "255.0.0.0"}},
+			}}},
+			{Label: "X2", DHCP: Enabled, Vlans: &v1.Interface_VlanList{Interfaces: []*v1.Interface_VlanConf{
+				{ID: 300, DHCP: Disabled, Static: &v1.Interface_StaticConf{IPv4: "10.0.0.2", NetMask: "255.0.0.0"}},
+			}}},
+		},
+	}
+
+	valid, err := verify(settings, nc)
+
+	assert.False(t, valid)
+	assert.ErrorContains(t, err, "DHCP of VLAN 200 of X1 has to stay enabled")
+	assert.NotContains(t, err.Error(), "VLAN 100")
+	assert.ErrorContains(t, err, "10.0.0.2 of VLAN 300 of X2 is not in the allowed networks")
+}
+
+func Test_verifyPolicy_ChecksBondsAgainstTheRulesOfTheirMembers(t *testing.T) {
+	nc := getMockPolicyConfigurator()
+	settings := &v1.NetworkSettings{
+		Bonds: &v1.BondList{Interfaces: []*v1.Bond{
+			{Name: "bond0", Members: []string{"X1", "X2"}, DHCP: Disabled, Static: &v1.Interface_StaticConf{IPv4: "10.0.0.1", NetMask: "255.0.0.0"}},
+			{Name: "bond1", Members: []string{"X3", "X4"}, DHCP: Disabled, Static: &v1.Interface_StaticConf{IPv4: "10.0.0.2", NetMask: "255.0.0.0"}},
+		}},
+	}
+
+	result := &verifyResult{retVal: true}
+	verifyPolicy(settings, result, nc)
+
+	assert.False(t, result.retVal)
+	assert.Contains(t, result.builder.String(), "DHCP of bond bond0 of X1 has to stay enabled")
+	assert.Contains(t, result.builder.String(), "10.0.0.1 of bond bond0 of X2 is not in the allowed networks")
+	assert.NotContains(t, result.builder.String(), "bond1", "Members without rule should not restrict the bond")
+}
+
+func Test_verifyPolicy_ChecksRenamedLabelsByTheirPort(t *testing.T) {
+	nc := getMockPolicyConfigurator()
+	patches := gomonkey.ApplyFunc(readMapFromFile, func(_ string) (map[string]string, error) {
+		return map[string]string{"X1": "ENS18", "X2": "ENS19", "X3": "ENS20"}, nil
+	})
+	defer patches.Reset()
+	settings := &v1.NetworkSettings{
+		Interfaces: []*v1.Interface{
+			{Label: "UPLINK", DHCP: Disabled, Static: &v1.Interface_StaticConf{IPv4: "10.0.0.1", NetMask: "255.0.0.0"}},
+			{Label: "FIELD", DHCP: Enabled, GatewayInterface: true, L2Conf: &v1.Interface_L2{StartingAddressIPv4: "10.0.0.10"}},
+		},
+		LabelMap: map[string]string{"X1": "ens18", "X2": "ens19", "X3": "ens20", "UPLINK": "ens18", "FIELD": "ens19"},
+	}
+
+	valid, err := verify(settings, nc)
+
+	assert.False(t, valid)
+	assert.ErrorContains(t, err, "DHCP of X1 has to stay enabled", "The rule of X1 should follow its port, not its label")
+	assert.ErrorContains(t, err, "X2 may not be the gateway interface")
+	assert.ErrorContains(t, err, "X2 may not have an L2Conf")
+	assert.NotContains(t, err.Error(), "label map")
+}
+
+func Test_verifyPolicy_RejectsLabelMapMovingPolicyLabels(t *testing.T) {
+	nc := getMockPolicyConfigurator()
+	patches := gomonkey.ApplyFunc(readMapFromFile, func(_ string) (map[string]string, error) {
+		return map[string]string{"X1": "ENS18", "X2": "ENS19", "X3": "ENS20"}, nil
+	})
+	defer patches.Reset()
+	settings := &v1.NetworkSettings{
+		Interfaces: []*v1.Interface{{Label: "X1", DHCP: Disabled, Static: &v1.Interface_StaticConf{IPv4: "10.0.0.1", NetMask: "255.0.0.0"}}},
+		LabelMap:   map[string]string{"X1": "ens19", "X2": "ens18", "UPLINK": "ens20"},
+	}
+
+	valid, err := verify(settings, nc)
+
+	assert.False(t, valid)
+	assert.ErrorContains(t, err, "the label map may not move X1 from ENS18")
+	assert.ErrorContains(t, err, "the label map may not move X2 from ENS19")
+	assert.ErrorContains(t, err, "the label map may not move X3 from ENS20", "Dropping a policy label should be rejected as well")
+	assert.ErrorContains(t, err, "10.0.0.1 of X2 is not in the allowed networks", "X1 of the new label map is the port of X2")
+}
+
+func Test_verifyPolicy_RejectsEverythingWithInvalidPolicy(t *testing.T) {
+	nc := &NetworkConfigurator{policy: newPolicyStore()}
+	patches := gomonkey.ApplyFunc(readProtoFile, func(_ string, msg proto.Message) (bool, error) {
+		msg.(*v1.NetworkPolicy).Rules = []*v1.NetworkPolicy_InterfaceRule{{Label: "X1", AllowedNetworks: []string{"192.168.0.0"}}}
+		return true, nil
+	})
+	defer patches.Reset()
+
+	err := nc.LoadPolicy()
+	assert.True(t, errors.Is(err, ErrInvalidPolicy))
+	_, err = nc.Policy()
+	assert.Error(t, err)
+
+	valid, err := verify(&v1.NetworkSettings{Interfaces: []*v1.Interface{{Label: "X2", DHCP: Enabled}}}, nc)
+	assert.False(t, valid)
+	assert.ErrorContains(t, err, "policy can not be enforced")
+}
+
+func Test_LoadPolicy_WithoutPolicyFile(t *testing.T) {
+	nc := &NetworkConfigurator{policy: newPolicyStore()}
+	patches := gomonkey.ApplyFunc(readProtoFile, func(_ string, _ proto.Message) (bool, error) {
+		return false, nil
+	})
+	defer patches.Reset()
+
+	assert.NoError(t, nc.LoadPolicy())
+	policy, err := nc.Policy()
+	assert.NoError(t, err)
+	assert.Empty(t, policy.Rules)
+}
+
+func Test_validatePolicy(t *testing.T) {
+	assert.NoError(t, validatePolicy(getMockPolicyConfigurator().policy.policy))
+	assert.Error(t, validatePolicy(&v1.NetworkPolicy{Rules: []*v1.NetworkPolicy_InterfaceRule{{DHCP: Enabled}}}))
+	assert.Error(t, validatePolicy(&v1.NetworkPolicy{Rules: []*v1.NetworkPolicy_InterfaceRule{{Label: "X1", DHCP: "auto"}}}))
+	assert.Error(t, validatePolicy(&v1.NetworkPolicy{Rules: []*v1.NetworkPolicy_InterfaceRule{{Label: "X1", AllowedNetworks: []string{"fd00::/8"}}}}))
+}
@@ -0,0 +1,207 @@
+/*
+ * Copyright © Siemens 2025. ALL RIGHTS RESERVED.
+ * Licensed under the MIT license
+ * See LICENSE file in the top-level directory
+ */
+
+package networking
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	v1 "networkservice/api/siemens_iedge_dmapi_v1"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const (
+	// ProvisioningDirsEnv lists the directories, which are checked for provisioning files, e.g: "/media/usb0:/media/usb1".
+	// Provisioning is disabled if it is not set.
+	ProvisioningDirsEnv = "DM_NETWORK_PROVISIONING_DIRS"
+	// ProvisioningFileName is the name of the provisioning file, a JSON encoded NetworkSettings with label map
+	ProvisioningFileName = "network-provisioning.json"
+	// ProvisioningChecksumSuffix is the suffix of the checksum file next to the provisioning file, in sha256sum format
+	ProvisioningChecksumSuffix = ".sha256"
+	// ProvisioningReportFileName is the name of the result report written next to the provisioning file
+	ProvisioningReportFileName = "network-provisioning.result.json"
+)
+
+// provisioningRetryInterval is the interval in which a file is processed again, if it could not be applied for a
+// reason which may be gone without changing the file. A fixed checksum file is checked earlier.
+const provisioningRetryInterval = time.Minute
+
+// ProvisioningFile is a provisioning file, which was not processed yet. Retry is set if the file was rejected for a
+// reason which may be gone without changing it, e.g. a wrong checksum file or a transient apply failure. Such files
+// are not remembered as processed.
+type ProvisioningFile struct {
+	Path     string
+	Sha256   string
+	Settings *v1.NetworkSettings
+	Retry    bool
+}
+
+// provisioningHistory holds the checksums of the processed provisioning files. A file is not applied again,
+// even if its report can not be written, e.g. to read-only media.
+type provisioningHistory struct {
+	mu     sync.Mutex
+	path   string
+	loaded bool
+	sums   map[string]bool
+}
+
+// processedProvisioning is the history of the processed provisioning files, it is kept in ProvisionedFileName.
+var processedProvisioning = newProvisioningHistory(ProvisionedFileName)
+
+func newProvisioningHistory(path string) *provisioningHistory {
+	return &provisioningHistory{path: path, sums: make(map[string]bool)}
+}
+
+// load reads the checksums once from the history file. The caller must hold h.mu.
+func (h *provisioningHistory) load() {
+	if h.loaded {
+		return
+	}
+	h.loaded = true
+
+	buffer, err := os.ReadFile(h.path)
+	if err != nil {
+		return
+	}
+	var sums []string
+	if err := json.Unmarshal(buffer, &sums); err != nil {
+		return
+	}
+	for _, sum := range sums {
+		h.sums[sum] = true
+	}
+}
+
+// contains checks if the file with the given checksum was processed.
+func (h *provisioningHistory) contains(sum string) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.load()
+	return h.sums[sum]
+}
+
+// add remembers the checksum of a processed file. It is kept in memory, even if the history file can not be written.
+func (h *provisioningHistory) add(sum string) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.load()
+	h.sums[sum] = true
+
+	buffer, err := json.Marshal(slices.Sorted(maps.Keys(h.sums)))
+	if err == nil {
+		err = os.WriteFile(h.path, buffer, 0644)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to write provisioning history: %w", err)
+	}
+	return nil
+}
+
+// ProvisioningDirs returns the directories configured in ProvisioningDirsEnv.
+func ProvisioningDirs() []string {
+	var dirs []string
+	for _, dir := range filepath.SplitList(os.Getenv(ProvisioningDirsEnv)) {
+		if dir != "" {
+			dirs = append(dirs, dir)
+		}
+	}
+	return dirs
+}
+
+// PendingProvisioning returns the provisioning file of the given directory, if it exists and was not processed yet,
+// or if its retry is due. It returns nil if there is nothing to do. A file which can not be used is returned together
+// with the error, so the rejection can be reported.
+func PendingProvisioning(dir string) (*ProvisioningFile, error) {
+	path := filepath.Join(dir, ProvisioningFileName)
+	buffer, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	sum := sha256.Sum256(buffer)
+	file := &ProvisioningFile{Path: path, Sha256: hex.EncodeToString(sum[:])}
+	report := &v1.ProvisioningReport{}
+	if found, _ := readProtoFile(filepath.Join(dir, ProvisioningReportFileName), report); found && report.Sha256 == file.Sha256 &&
+		!retryDue(report, path+ProvisioningChecksumSuffix) {
+		return nil, nil
+	}
+	if processedProvisioning.contains(file.Sha256) {
+		return nil, nil
+	}
+
+	if err := verifyChecksum(path+ProvisioningChecksumSuffix, file.Sha256); err != nil {
+		file.Retry = true
+		return file, err
+	}
+	settings := &v1.NetworkSettings{}
+	if err := protojson.Unmarshal(buffer, settings); err != nil {
+		return file, fmt.Errorf("failed to parse %v: %w", path, err)
+	}
+	file.Settings = settings
+	return file, nil
+}
+
+// retryDue reports whether the file of the report has to be processed again: its rejection may be gone after
+// provisioningRetryInterval, or earlier if the checksum file was changed after the report.
+func retryDue(report *v1.ProvisioningReport, checksumFile string) bool {
+	if !report.Retry {
+		return false
+	}
+	processedAt := time.Unix(report.ProcessedAt, 0)
+	if time.Since(processedAt) >= provisioningRetryInterval {
+		return true
+	}
+	info, err := os.Stat(checksumFile)
+	return err == nil && info.ModTime().Unix() > report.ProcessedAt
+}
+
+// verifyChecksum compares the checksum of the given file with the expected one. The file holds the hex encoded
+// SHA-256 checksum, optionally followed by the file name as written by sha256sum.
+func verifyChecksum(checksumFile, expected string) error {
+	buffer, err := os.ReadFile(checksumFile)
+	if err != nil {
+		return fmt.Errorf("checksum file can not be read: %w", err)
+	}
+	fields := strings.Fields(string(buffer))
+	if len(fields) == 0 || !strings.EqualFold(fields[0], expected) {
+		return fmt.Errorf("checksum of %v does not match", strings.TrimSuffix(checksumFile, ProvisioningChecksumSuffix))
+	}
+	return nil
+}
+
+// WriteProvisioningReport writes the result of the provisioning file next to it and remembers the file as processed,
+// unless it is retried. errMessage is empty if the settings were applied.
+func WriteProvisioningReport(file *ProvisioningFile, revision uint64, errMessage string) error {
+	var historyErr error
+	if !file.Retry {
+		historyErr = processedProvisioning.add(file.Sha256)
+	}
+
+	report := &v1.ProvisioningReport{
+		File:        file.Path,
+		Sha256:      file.Sha256,
+		ProcessedAt: time.Now().Unix(),
+		Applied:     errMessage == "",
+		Error:       errMessage,
+		Revision:    revision,
+		Retry:       file.Retry,
+	}
+	return errors.Join(historyErr, writeProtoFile(report, filepath.Join(filepath.Dir(file.Path), ProvisioningReportFileName)))
+}
@@ -0,0 +1,187 @@
+/*
+ * Copyright © Siemens 2025. ALL RIGHTS RESERVED.
+ * Licensed under the MIT license
+ * See LICENSE file in the top-level directory
+ */
+
+package networking
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	v1 "networkservice/api/siemens_iedge_dmapi_v1"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testProvisioningFile = `{"Interfaces": [{"Label": "X1", "DHCP": "disabled", "Static": {"IPv4": "192.168.0.1", "NetMask": "255.255.255.0"}}],
+"LabelMap": {"X1": "ENS18"}}`
+
+// checksumOf returns the hex encoded SHA-256 checksum of the content.
+func checksumOf(content string) string {
+	sum := sha256.Sum256([]byte(content))
+	return hex.EncodeToString(sum[:])
+}
+
+// writeProvisioningFile writes the provisioning file and its checksum file to the directory.
+func writeProvisioningFile(t *testing.T, dir, content string) string {
+	checksum := checksumOf(content)
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, ProvisioningFileName), []byte(content), 0644))
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, ProvisioningFileName+ProvisioningChecksumSuffix),
+		[]byte(checksum+"  "+ProvisioningFileName+"\n"), 0644))
+	return checksum
+}
+
+// useProvisioningHistory replaces the history of processed provisioning files with an empty one in a temporary directory.
+func useProvisioningHistory(t *testing.T) string {
+	path := filepath.Join(t.TempDir(), "network.provisioned")
+	previous := processedProvisioning
+	processedProvisioning = newProvisioningHistory(path)
+	t.Cleanup(func() { processedProvisioning = previous })
+	return path
+}
+
+func Test_PendingProvisioning_ProcessesFileOnce(t *testing.T) {
+	useProvisioningHistory(t)
+	dir := t.TempDir()
+
+	file, err := PendingProvisioning(dir)
+	assert.NoError(t, err)
+	assert.Nil(t, file, "A directory without provisioning file should be ignored")
+
+	checksum := writeProvisioningFile(t, dir, testProvisioningFile)
+	file, err = PendingProvisioning(dir)
+	assert.NoError(t, err)
+	assert.Equal(t, checksum, file.Sha256)
+	assert.Equal(t, "X1", file.Settings.Interfaces[0].Label)
+	assert.Equal(t, map[string]string{"X1": "ENS18"}, file.Settings.LabelMap)
+
+	assert.NoError(t, WriteProvisioningReport(file, 3, ""))
+	report := &v1.ProvisioningReport{}
+	found, err := readProtoFile(filepath.Join(dir, ProvisioningReportFileName), report)
+	assert.True(t, found)
+	assert.NoError(t, err)
+	assert.True(t, report.Applied)
+	assert.Equal(t, uint64(3), report.Revision)
+
+	file, err = PendingProvisioning(dir)
+	assert.NoError(t, err)
+	assert.Nil(t, file, "A processed file should not be applied again")
+
+	writeProvisioningFile(t, dir, testProvisioningFile+" ")
+	file, err = PendingProvisioning(dir)
+	assert.NoError(t, err)
+	assert.NotNil(t, file, "A changed file should be processed again")
+}
+
+func Test_PendingProvisioning_RejectsInvalidFiles(t *testing.T) {
+	useProvisioningHistory(t)
+	dir := t.TempDir()
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, ProvisioningFileName), []byte(testProvisioningFile), 0644))
+
+	file, err := PendingProvisioning(dir)
+	assert.Error(t, err, "A file without checksum should be rejected")
+	assert.Nil(t, file.Settings)
+
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, ProvisioningFileName+ProvisioningChecksumSuffix), []byte("0123abcd"), 0644))
+	file, err = PendingProvisioning(dir)
+	assert.ErrorContains(t, err, "checksum")
+	assert.NotEmpty(t, file.Sha256)
+
+	writeProvisioningFile(t, dir, `{"Interfaces": "invalid"}`)
+	file, err = PendingProvisioning(dir)
+	assert.Error(t, err)
+	assert.NoError(t, WriteProvisioningReport(file, 0, err.Error()))
+
+	file, err = PendingProvisioning(dir)
+	assert.NoError(t, err)
+	assert.Nil(t, file, "A rejected file should be reported once")
+	assert.True(t, processedProvisioning.contains(checksumOf(`{"Interfaces": "invalid"}`)), "A file with invalid content should be remembered")
+}
+
+func Test_PendingProvisioning_RetriesAfterChecksumFileIsFixed(t *testing.T) {
+	useProvisioningHistory(t)
+	dir := t.TempDir()
+	checksumFile := filepath.Join(dir, ProvisioningFileName+ProvisioningChecksumSuffix)
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, ProvisioningFileName), []byte(testProvisioningFile), 0644))
+	assert.NoError(t, os.WriteFile(checksumFile, []byte("0123abcd"), 0644))
+
+	file, err := PendingProvisioning(dir)
+	assert.ErrorContains(t, err, "checksum")
+	assert.True(t, file.Retry)
+	assert.NoError(t, WriteProvisioningReport(file, 0, err.Error()))
+	assert.False(t, processedProvisioning.contains(file.Sha256), "A file with a wrong checksum file should not be remembered")
+
+	file, err = PendingProvisioning(dir)
+	assert.NoError(t, err)
+	assert.Nil(t, file, "The rejection should not be reported again while nothing changed")
+
+	// the checksum file is copied after the provisioning file
+	writeProvisioningFile(t, dir, testProvisioningFile)
+	later := time.Now().Add(2 * time.Second)
+	assert.NoError(t, os.Chtimes(checksumFile, later, later))
+	file, err = PendingProvisioning(dir)
+	assert.NoError(t, err)
+	assert.NotNil(t, file.Settings, "The file should be processed again with the fixed checksum file")
+}
+
+func Test_PendingProvisioning_RetriesTransientFailures(t *testing.T) {
+	useProvisioningHistory(t)
+	dir := t.TempDir()
+	writeProvisioningFile(t, dir, testProvisioningFile)
+	file, err := PendingProvisioning(dir)
+	assert.NoError(t, err)
+
+	file.Retry = true
+	assert.NoError(t, WriteProvisioningReport(file, 0, "a change awaits confirmation"))
+	assert.False(t, processedProvisioning.contains(file.Sha256), "A file which failed for a transient reason should not be remembered")
+
+	file, err = PendingProvisioning(dir)
+	assert.NoError(t, err)
+	assert.Nil(t, file, "The file should not be retried before the retry interval")
+
+	reportFile := filepath.Join(dir, ProvisioningReportFileName)
+	report := &v1.ProvisioningReport{}
+	_, err = readProtoFile(reportFile, report)
+	assert.NoError(t, err)
+	assert.True(t, report.Retry)
+	report.ProcessedAt = time.Now().Add(-provisioningRetryInterval).Unix()
+	assert.NoError(t, writeProtoFile(report, reportFile))
+
+	file, err = PendingProvisioning(dir)
+	assert.NoError(t, err)
+	assert.NotNil(t, file, "The file should be retried after the retry interval")
+}
+
+func Test_PendingProvisioning_SkipsProcessedFileWithoutReport(t *testing.T) {
+	history := useProvisioningHistory(t)
+	dir := t.TempDir()
+	writeProvisioningFile(t, dir, testProvisioningFile)
+	file, err := PendingProvisioning(dir)
+	assert.NoError(t, err)
+
+	// the report can not be written next to the file, e.g. on read-only media
+	file.Path = filepath.Join(dir, "missing", ProvisioningFileName)
+	assert.Error(t, WriteProvisioningReport(file, 3, ""))
+
+	file, err = PendingProvisioning(dir)
+	assert.NoError(t, err)
+	assert.Nil(t, file, "A processed file should not be applied again without report")
+
+	processedProvisioning = newProvisioningHistory(history)
+	file, err = PendingProvisioning(dir)
+	assert.NoError(t, err)
+	assert.Nil(t, file, "The processed files should be kept after a restart")
+}
+
+func Test_ProvisioningDirs(t *testing.T) {
+	t.Setenv(ProvisioningDirsEnv, "/media/usb0::/media/usb1")
+	assert.Equal(t, []string{"/media/usb0", "/media/usb1"}, ProvisioningDirs())
+
+	t.Setenv(ProvisioningDirsEnv, "")
+	assert.Empty(t, ProvisioningDirs())
+}
@@ -0,0 +1,274 @@
+/*
+ * Copyright © Siemens 2025. ALL RIGHTS RESERVED.
+ * Licensed under the MIT license
+ * See LICENSE file in the top-level directory
+ */
+
+package networking
+
+import (
+	"fmt"
+	"log"
+	"net"
+	"reflect"
+	"strings"
+
+	nm "github.com/Wifx/gonetworkmanager/v2"
+	"github.com/godbus/dbus/v5"
+)
+
+// defaultRouteMetric is the route metric NetworkManager uses when the profile does not set one.
+const defaultRouteMetric = -1
+
+// ownedSettings holds the connection settings which are managed by this service, normalized for comparison.
+type ownedSettings struct {
+	interfaceName string
+	mac           string
+	method        string
+	gateway       string
+	addresses     []string
+	dns           []uint32
+	dnsSearch     []string
+	dnsPriority   int64
+	dnsOptions    []string
+	ignoreAutoDNS bool
+	routeMetric   int64
+	routes        []string
+	owner         string
+	dhcpTimeout   int64
+	fallback      []string
+	dhcpOptions   map[string]string
+	link          map[string]string
+}
+
+// newOwnedSettings extracts the settings managed by this service from the given connection settings.
+// It accepts settings read from NetworkManager as well as settings created by newSettingsFromProto.
+func newOwnedSettings(settings nm.ConnectionSettings) ownedSettings {
+	owned := ownedSettings{
+		interfaceName: settingString(settings[ConnectionKey][InterfaceNameKey]),
+		method:        settingString(settings[IPV4Key][MethodKey]),
+		gateway:       settingString(settings[IPV4Key][GatewayKey]),
+		addresses:     settingAddresses(settings[IPV4Key][AddressDataKey]),
+		dnsSearch:     settingStrings(settings[IPV4Key][DNSSearchKey]),
+		dnsPriority:   settingInt(settings[IPV4Key][DNSPriorityKey], 0),
+		dnsOptions:    settingStrings(settings[IPV4Key][DNSOptionsKey]),
+		ignoreAutoDNS: settingInt(settings[IPV4Key][DNSIgnoreAutoKey], 0) != 0,
+		routeMetric:   settingInt(settings[IPV4Key][RouteMetricKey], defaultRouteMetric),
+		routes:        settingRoutes(settings[IPV4Key][RouteDataKey]),
+		owner:         profileOwner(settings),
+		dhcpTimeout:   settingInt(settings[IPV4Key][DHCPTimeoutKey], 0),
+		fallback:      fallbackTags(settings),
+		dhcpOptions:   make(map[string]string),
+		link:          make(map[string]string),
+	}
+	switch mac := settings[EthernetType][MACAddressKey].(type) {
+	case []byte:
+		owned.mac = strings.ToUpper(net.HardwareAddr(mac).String())
+	case net.HardwareAddr:
+		owned.mac = strings.ToUpper(mac.String())
+	}
+	if dns, ok := settings[IPV4Key][DNSKey].([]uint32); ok && len(dns) > 0 {
+		owned.dns = dns
+	}
+	for _, key := range dhcpOptionKeys {
+		if value := settings[IPV4Key][key]; isSet(value) {
+			owned.dhcpOptions[key] = fmt.Sprint(value)
+		}
+	}
+	for _, key := range linkKeys {
+		if value := settings[EthernetType][key]; isSet(value) {
+			owned.link[key] = fmt.Sprint(value)
+		}
+	}
+	return owned
+}
+
+// equivalentSettings reports whether the current connection settings already contain the desired settings.
+// Fields which are not managed by this service, e.g. the connection UUID or timestamp, are ignored.
+func equivalentSettings(current, desired nm.ConnectionSettings) bool {
+	currentOwned := newOwnedSettings(current)
+	desiredOwned := newOwnedSettings(desired)
+	// label based settings do not carry a MAC address, the profile is bound by its interface name then
+	if desiredOwned.mac == "" {
+		currentOwned.mac = ""
+	}
+	// without desired routes the routes of the current profile are kept
+	if desiredOwned.routes == nil {
+		currentOwned.routes = nil
+	}
+	// DHCP options which are not given are kept as well
+	for key := range currentOwned.dhcpOptions {
+		if _, ok := desiredOwned.dhcpOptions[key]; !ok {
+			delete(currentOwned.dhcpOptions, key)
+		}
+	}
+	for key := range currentOwned.link {
+		if _, ok := desiredOwned.link[key]; !ok {
+			delete(currentOwned.link, key)
+		}
+	}
+	return reflect.DeepEqual(currentOwned, desiredOwned)
+}
+
+// activeProfile returns the connection of the given list, which is active on the device, or nil.
+func activeProfile(device nm.DeviceWired, connections []nm.Connection) nm.Connection {
+	if len(connections) == 0 {
+		return nil
+	}
+	activeConnection, err := device.GetPropertyActiveConnection()
+	if err != nil || activeConnection == nil {
+		return nil
+	}
+	activeUUID, err := activeConnection.GetPropertyUUID()
+	if err != nil {
+		return nil
+	}
+
+	for _, connection := range connections {
+		settings, err := connection.GetSettings()
+		if err == nil && settings[ConnectionKey][UUIDKey] == activeUUID {
+			return connection
+		}
+	}
+	return nil
+}
+
+// exceptConnection returns the given connections without the excluded one.
+func exceptConnection(connections []nm.Connection, excluded nm.Connection) []nm.Connection {
+	var remaining []nm.Connection
+	for _, connection := range connections {
+		if connection != excluded {
+			remaining = append(remaining, connection)
+		}
+	}
+	return remaining
+}
+
+// updateConnectionInPlace updates the active profile of the device to the given settings without deactivating it.
+// It returns false if the profile could not be updated and has to be recreated.
+func (nc *NetworkConfigurator) updateConnectionInPlace(device nm.DeviceWired, connection nm.Connection, settings nm.ConnectionSettings) (bool, error) {
+	current, err := connection.GetSettings()
+	if err != nil {
+		log.Printf("could not read settings of connection %v: %v", connection.GetPath(), err)
+		return false, nil
+	}
+
+	if equivalentSettings(current, settings) {
+		log.Printf("connection %v already has the requested settings, nothing to apply", current[ConnectionKey][IDKey])
+		return true, nil
+	}
+
+	if err := connection.Update(mergeSettings(current, settings)); err != nil {
+		log.Printf("could not update connection %v in place, it will be recreated: %v", connection.GetPath(), err)
+		return false, nil
+	}
+	log.Printf("connection %v has been updated in place", connection.GetPath())
+
+	if err := reapplyDevice(device); err != nil {
+		log.Println("reapply failed, the connection will be activated again: ", err)
+		if _, err := nc.gnm.ActivateConnection(connection, device, nil); err != nil {
+			return true, fmt.Errorf("configuration updated, but could not be activated: %w", err)
+		}
+	}
+	return true, nil
+}
+
+// reapplyDevice reapplies the updated settings connection to the device, without deactivating the device.
+// gonetworkmanager sends the Connection object instead of its settings, so the call is made directly.
+func reapplyDevice(device nm.DeviceWired) error {
+	conn, err := dbus.SystemBus()
+	if err != nil {
+		return err
+	}
+	// empty settings make NetworkManager reapply the current settings connection
+	noSettings := map[string]map[string]dbus.Variant{}
+	return conn.Object(nm.NetworkManagerInterface, device.GetPath()).
+		Call(nm.DeviceReapply, 0, noSettings, uint64(0), uint32(0)).Err
+}
+
+func settingString(value interface{}) string {
+	str, _ := value.(string)
+	return str
+}
+
+// settingStrings returns the given string list setting, or nil if it is not set.
+func settingStrings(value interface{}) []string {
+	if strs, ok := value.([]string); ok && len(strs) > 0 {
+		return strs
+	}
+	return nil
+}
+
+// settingInt returns the given integer setting, or defaultValue if it is not set.
+func settingInt(value interface{}, defaultValue int64) int64 {
+	switch number := value.(type) {
+	case int:
+		return int64(number)
+	case int32:
+		return int64(number)
+	case int64:
+		return number
+	case uint32:
+		return int64(number)
+	case uint64:
+		return int64(number)
+	case bool:
+		if number {
+			return 1
+		}
+		return 0
+	}
+	return defaultValue
+}
+
+// settingAddresses returns the address-data setting as "address/prefix" entries.
+func settingAddresses(value interface{}) []string {
+	var addresses []string
+	switch data := value.(type) {
+	case []DBusDict:
+		for _, entry := range data {
+			addresses = append(addresses, fmt.Sprintf("%v/%v", entry[AddressKey].Value(), entry[PrefixKey].Value()))
+		}
+	case []map[string]dbus.Variant:
+		for _, entry := range data {
+			addresses = append(addresses, fmt.Sprintf("%v/%v", entry[AddressKey].Value(), entry[PrefixKey].Value()))
+		}
+	case []map[string]interface{}:
+		for _, entry := range data {
+			addresses = append(addresses, fmt.Sprintf("%v/%v", entry[AddressKey], entry[PrefixKey]))
+		}
+	}
+	return addresses
+}
+
+// settingRoutes returns the route-data setting as "dest/prefix via next-hop metric" entries.
+func settingRoutes(value interface{}) []string {
+	var routes []string
+	format := func(entry map[string]interface{}) {
+		routes = append(routes, fmt.Sprintf("%v/%v via %v metric %v", entry[RouteDestinationKey], entry[PrefixKey],
+			settingString(entry[RouteNextHopKey]), settingInt(entry[RouteMetricDataKey], 0)))
+	}
+	switch data := value.(type) {
+	case []DBusDict:
+		for _, entry := range data {
+			format(variantValues(entry))
+		}
+	case []map[string]dbus.Variant:
+		for _, entry := range data {
+			format(variantValues(entry))
+		}
+	case []map[string]interface{}:
+		for _, entry := range data {
+			format(entry)
+		}
+	}
+	return routes
+}
+
+func variantValues(entry map[string]dbus.Variant) map[string]interface{} {
+	values := make(map[string]interface{})
+	for key, value := range entry {
+		values[key] = value.Value()
+	}
+	return values
+}
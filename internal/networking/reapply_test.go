@@ -0,0 +1,189 @@
+/*
+ * Copyright © Siemens 2025. ALL RIGHTS RESERVED.
+ * Licensed under the MIT license
+ * See LICENSE file in the top-level directory
+ */
+
+package networking
+
+import (
+	"errors"
+	v1 "networkservice/api/siemens_iedge_dmapi_v1"
+	mockgnm "networkservice/internal/networking/mocks/gonetworkmanager"
+	"reflect"
+	"testing"
+
+	nm "github.com/Wifx/gonetworkmanager/v2"
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/godbus/dbus/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const testActiveUUID = "a6f0a3d4-6c0e-4a4e-9d2b-2d9b1c0e5f11"
+
+// getMockActiveStaticSettings returns the static settings as NetworkManager reports them for getMockStaticProto.
+func getMockActiveStaticSettings() nm.ConnectionSettings {
+	return nm.ConnectionSettings{
+		ConnectionKey: {
+			IDKey:            "00:0A:95:9D:68:16_static",
+			UUIDKey:          testActiveUUID,
+			TypeKey:          EthernetType,
+			InterfaceNameKey: "eth0",
+			TimeStampKey:     uint64(1700000000),
+		},
+		EthernetType: {MACAddressKey: []byte{0x00, 0x0A, 0x95, 0x9D, 0x68, 0x16}},
+		IPV4Key: {
+			MethodKey:      Manual,
+			GatewayKey:     "192.168.1.254",
+			AddressDataKey: []map[string]interface{}{{AddressKey: "192.168.1.1", PrefixKey: uint32(24)}},
+			"addresses":    [][]uint32{{16885952, 24, 4261521600}},
+			DNSKey:         []uint32{IPToUInt32LI("8.8.8.8")},
+		},
+		"ipv6": {MethodKey: "ignore", "addresses": [][]interface{}{}, "routes": [][]interface{}{}},
+	}
+}
+
+func getMockStaticProto() *v1.Interface {
+	return &v1.Interface{
+		MacAddress: "00:0A:95:9D:68:16",
+		DHCP:       Disabled,
+		Static:     &v1.Interface_StaticConf{IPv4: "192.168.1.1", NetMask: "255.255.255.0", Gateway: "192.168.1.254"},
+		DNSConfig:  &v1.Interface_Dns{PrimaryDNS: "8.8.8.8"},
+	}
+}
+
+func getMockActiveDevice() *mockgnm.MockDeviceWired {
+	mockDeviceWired := &mockgnm.MockDeviceWired{}
+	mockActiveConnection := &mockgnm.MockActiveConnection{}
+	mockActiveConnection.On("GetPropertyUUID").Return(testActiveUUID, nil)
+	mockDeviceWired.On("GetPropertyActiveConnection").Return(mockActiveConnection, nil)
+	return mockDeviceWired
+}
+
+func Test_equivalentSettings_ComparesOwnedFieldsOnly(t *testing.T) {
+	current := getMockActiveStaticSettings()
+
+	assert.True(t, equivalentSettings(current, newSettingsFromProto(getMockStaticProto(), "eth0")),
+		"Settings read from NetworkManager should be equivalent to the same settings created from proto")
+
+	changed := getMockStaticProto()
+	changed.Static.IPv4 = "192.168.1.2"
+	assert.False(t, equivalentSettings(current, newSettingsFromProto(changed, "eth0")))
+
+	gateway := getMockStaticProto()
+	gateway.GatewayInterface = true
+	assert.False(t, equivalentSettings(current, newSettingsFromProto(gateway, "eth0")), "A changed route metric should be applied")
+
+	dhcp := &v1.Interface{MacAddress: "00:0A:95:9D:68:16", DHCP: Enabled}
+	assert.False(t, equivalentSettings(current, newSettingsFromProto(dhcp, "eth0")))
+}
+
+func Test_mergeSettings_KeepsProfileIdentity(t *testing.T) {
+	current := getMockActiveStaticSettings()
+	desired := newSettingsFromProto(&v1.Interface{MacAddress: "00:0A:95:9D:68:16", DHCP: Enabled}, "eth0")
+
+	merged := mergeSettings(current, desired)
+
+	assert.Equal(t, testActiveUUID, merged[ConnectionKey][UUIDKey], "The profile should keep its UUID")
+	assert.Equal(t, "00:0A:95:9D:68:16_dhcp", merged[ConnectionKey][IDKey])
+	assert.Equal(t, Auto, merged[IPV4Key][MethodKey])
+	assert.Nil(t, merged[IPV4Key][AddressDataKey])
+	assert.NotContains(t, merged["ipv6"], "addresses", "Deprecated properties should be removed")
+	assert.Equal(t, "ignore", merged["ipv6"][MethodKey])
+	assert.Equal(t, Manual, current[IPV4Key][MethodKey], "The current settings should not be modified")
+}
+
+func Test_UpdateConnections_SkipsEquivalentSettings(t *testing.T) {
+	nc := &NetworkConfigurator{}
+	mockConnection := &mockgnm.MockConnection{}
+	mockConnection.On("GetSettings").Return(getMockActiveStaticSettings(), nil)
+	mockDeviceWired := getMockActiveDevice()
+
+	patches := gomonkey.NewPatches()
+	defer patches.Reset()
+	patches.ApplyFunc(listConnections, func(_ nm.DeviceWired) []nm.Connection {
+		return []nm.Connection{mockConnection}
+	})
+	patches.ApplyPrivateMethod(reflect.TypeOf(nc), "addConnection", func(_ *NetworkConfigurator, _ string, _ nm.ConnectionSettings) error {
+		t.Error("addConnection should not be called for equivalent settings")
+		return nil
+	})
+
+	err := nc.updateConnections(mockDeviceWired, newSettingsFromProto(getMockStaticProto(), "eth0"), v1.ApplyOptions_DELETE)
+
+	assert.NoError(t, err)
+	mockConnection.AssertNotCalled(t, "Update", mock.Anything)
+	mockConnection.AssertNotCalled(t, "Delete")
+}
+
+func Test_UpdateConnections_UpdatesActiveProfileInPlace(t *testing.T) {
+	nc := &NetworkConfigurator{}
+	mockConnection := &mockgnm.MockConnection{}
+	mockConnection.On("GetSettings").Return(getMockActiveStaticSettings(), nil)
+	mockConnection.On("GetPath").Return(dbus.ObjectPath("/org/freedesktop/NetworkManager/Settings/1"))
+	mockConnection.On("Update", mock.Anything).Return(nil)
+	mockStaleConnection := &mockgnm.MockConnection{}
+	mockStaleConnection.On("GetSettings").Return(nm.ConnectionSettings{ConnectionKey: {UUIDKey: "stale"}}, nil)
+	mockStaleConnection.On("GetPath").Return(dbus.ObjectPath("/org/freedesktop/NetworkManager/Settings/2"))
+	mockStaleConnection.On("Delete").Return(nil)
+	mockDeviceWired := getMockActiveDevice()
+
+	patches := gomonkey.NewPatches()
+	defer patches.Reset()
+	patches.ApplyFunc(listConnections, func(_ nm.DeviceWired) []nm.Connection {
+		return []nm.Connection{mockStaleConnection, mockConnection}
+	})
+	reapplied := false
+	patches.ApplyFunc(reapplyDevice, func(_ nm.DeviceWired) error {
+		reapplied = true
+		return nil
+	})
+
+	changed := getMockStaticProto()
+	changed.Static.IPv4 = "192.168.1.2"
+	err := nc.updateConnections(mockDeviceWired, newSettingsFromProto(changed, "eth0"), v1.ApplyOptions_DELETE)
+
+	assert.NoError(t, err)
+	assert.True(t, reapplied, "The changed profile should be reapplied to the device")
+	mockConnection.AssertCalled(t, "Update", mock.MatchedBy(func(settings nm.ConnectionSettings) bool {
+		return settings[ConnectionKey][UUIDKey] == testActiveUUID && settingAddresses(settings[IPV4Key][AddressDataKey])[0] == "192.168.1.2/24"
+	}))
+	mockConnection.AssertNotCalled(t, "Delete")
+	mockStaleConnection.AssertCalled(t, "Delete")
+}
+
+func Test_UpdateConnections_RecreatesProfileWhenUpdateFails(t *testing.T) {
+	nc := &NetworkConfigurator{}
+	mockConnection := &mockgnm.MockConnection{}
+	mockConnection.On("GetSettings").Return(getMockActiveStaticSettings(), nil)
+	mockConnection.On("GetPath").Return(dbus.ObjectPath("/org/freedesktop/NetworkManager/Settings/1"))
+	mockConnection.On("Update", mock.Anything).Return(errors.New("update error"))
+	mockDeviceWired := getMockActiveDevice()
+	mockDeviceWired.On("GetPropertyHwAddress").Return("00:0a:95:9d:68:16", nil)
+	mockDeviceWired.On("GetPropertyPermHwAddress").Return("00:0a:95:9d:68:16", nil)
+
+	patches := gomonkey.NewPatches()
+	defer patches.Reset()
+	patches.ApplyFunc(listConnections, func(_ nm.DeviceWired) []nm.Connection {
+		return []nm.Connection{mockConnection}
+	})
+	var deleted []nm.Connection
+	patches.ApplyPrivateMethod(reflect.TypeOf(nc), "deleteOldConnections", func(_ *NetworkConfigurator, connections []nm.Connection) error {
+		deleted = append(deleted, connections...)
+		return nil
+	})
+	added := ""
+	patches.ApplyPrivateMethod(reflect.TypeOf(nc), "addConnection", func(_ *NetworkConfigurator, mac string, _ nm.ConnectionSettings) error {
+		added = mac
+		return nil
+	})
+
+	changed := getMockStaticProto()
+	changed.DHCP = Enabled
+	err := nc.updateConnections(mockDeviceWired, newSettingsFromProto(changed, "eth0"), v1.ApplyOptions_DELETE)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []nm.Connection{mockConnection}, deleted, "The active profile should be deleted when it can not be updated")
+	assert.Equal(t, "00:0A:95:9D:68:16", added)
+}
@@ -0,0 +1,326 @@
+/*
+ * Copyright © Siemens 2025. ALL RIGHTS RESERVED.
+ * Licensed under the MIT license
+ * See LICENSE file in the top-level directory
+ */
+
+package networking
+
+import (
+	"context"
+	"log"
+	"net"
+	v1 "networkservice/api/siemens_iedge_dmapi_v1"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	nm "github.com/Wifx/gonetworkmanager/v2"
+)
+
+const (
+	// reconcileInterval is the interval of the periodic drift check, which catches changes without any signal.
+	reconcileInterval = 60 * time.Second
+	// correctionBackoff is the minimum time between two automatic corrections of the same interface.
+	correctionBackoff = 60 * time.Second
+)
+
+// reconcileMask selects the fields of an interface which are compared with the desired state.
+var reconcileMask = &InterfaceMask{paths: maskTree{FieldMacAddress: {}, FieldDHCP: {}, FieldStatic: {}, FieldDNSConfig: {}, FieldRoutes: {}, FieldFallback: {}, FieldLink: {}}}
+
+// reconciler holds the desired state and the results of the automatic corrections.
+type reconciler struct {
+	mu          sync.Mutex
+	desired     *v1.NetworkSettings
+	loaded      bool
+	corrections map[string]correction
+	trigger     chan struct{}
+}
+
+// correction is the result of the last automatic correction of an interface.
+type correction struct {
+	at  time.Time
+	err error
+}
+
+func newReconciler() *reconciler {
+	return &reconciler{corrections: make(map[string]correction), trigger: make(chan struct{}, 1)}
+}
+
+// notify schedules a drift check.
+func (r *reconciler) notify() {
+	select {
+	case r.trigger <- struct{}{}:
+	default:
+	}
+}
+
+// notifyReconciler schedules a drift check, e.g. after the snapshot changed.
+func (nc *NetworkConfigurator) notifyReconciler() {
+	if nc.reconciler != nil {
+		nc.reconciler.notify()
+	}
+}
+
+// StartReconciler checks the interfaces for drift on changes and periodically until the given context is done.
+// The drift of interfaces in ENFORCE mode is corrected by applying the desired settings again.
+func (nc *NetworkConfigurator) StartReconciler(ctx context.Context) {
+	go nc.runReconciler(ctx)
+	log.Println("reconciler started")
+}
+
+func (nc *NetworkConfigurator) runReconciler(ctx context.Context) {
+	ticker := time.NewTicker(reconcileInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-nc.reconciler.trigger:
+		}
+		nc.reconcile()
+	}
+}
+
+// reconcile logs the drift of all interfaces and corrects the drift of enforced interfaces.
+func (nc *NetworkConfigurator) reconcile() {
+	for _, drift := range nc.GetDrift().Interfaces {
+		log.Printf("drift detected for %v, fields: %v, missing: %v", desiredKey(drift.Desired), drift.Fields, drift.Missing)
+		if drift.Mode == v1.Interface_ENFORCE && !drift.Missing {
+			nc.correctDrift(drift.Desired)
+		}
+	}
+}
+
+// GetDrift compares the desired state with the actual settings of the interfaces.
+func (nc *NetworkConfigurator) GetDrift() *v1.DriftReport {
+	report := &v1.DriftReport{CheckedAt: time.Now().Unix()}
+	desired := nc.DesiredState()
+
+	for _, element := range desired.GetInterfaces() {
+		actual := nc.actualInterface(element, false)
+		fields := driftFields(element, actual)
+		if actual != nil && len(fields) == 0 {
+			continue
+		}
+
+		drift := &v1.InterfaceDrift{
+			MacAddress: element.MacAddress,
+			Label:      element.Label,
+			Mode:       element.Reconcile,
+			Fields:     fields,
+			Missing:    actual == nil,
+			Desired:    element,
+			Actual:     actual,
+		}
+		if last, ok := nc.lastCorrection(desiredKey(element)); ok {
+			drift.LastCorrection = last.at.Unix()
+			if last.err != nil {
+				drift.LastCorrectionError = last.err.Error()
+			}
+		}
+		report.Interfaces = append(report.Interfaces, drift)
+	}
+	return report
+}
+
+func (nc *NetworkConfigurator) lastCorrection(key string) (correction, bool) {
+	nc.reconciler.mu.Lock()
+	defer nc.reconciler.mu.Unlock()
+	last, ok := nc.reconciler.corrections[key]
+	return last, ok
+}
+
+// correctDrift applies the desired settings of the interface again. The drift is checked once more under the apply
+// locks, the interface may have been changed by an apply meanwhile. Foreign profiles are handled with the policy of
+// the apply stored in the desired state.
+func (nc *NetworkConfigurator) correctDrift(element *v1.Interface) {
+	key := desiredKey(element)
+	if last, ok := nc.lastCorrection(key); ok && time.Since(last.at) < correctionBackoff {
+		return
+	}
+
+	settings := &v1.NetworkSettings{Interfaces: []*v1.Interface{element}}
+	unlock := nc.LockForApply(settings)
+	defer unlock()
+
+	current := nc.desiredInterface(key)
+	if current == nil || current.Reconcile != v1.Interface_ENFORCE {
+		return
+	}
+	actual := nc.actualInterface(current, true)
+	if actual == nil || len(driftFields(current, actual)) == 0 {
+		return
+	}
+
+	// a pending confirmation is rolled back as a whole, a correction would be lost or mixed into it
+	if nc.ConfirmationPending() {
+		log.Printf("drift of %v is not corrected while a change awaits confirmation", key)
+		return
+	}
+
+	log.Printf("correcting drift of %v", key)
+	settings = &v1.NetworkSettings{
+		Interfaces: []*v1.Interface{current},
+		Options:    &v1.ApplyOptions{ForeignProfiles: nc.DesiredState().GetOptions().GetForeignProfiles()},
+	}
+	_, err := nc.ArePreconditionsOk(settings)
+	if err == nil {
+		err = nc.Apply(settings)
+	}
+	if err != nil {
+		log.Printf("drift of %v could not be corrected: %v", key, err)
+	}
+
+	nc.reconciler.mu.Lock()
+	nc.reconciler.corrections[key] = correction{at: time.Now(), err: err}
+	nc.reconciler.mu.Unlock()
+}
+
+// desiredInterface returns the desired settings of the interface with the given key, or nil.
+func (nc *NetworkConfigurator) desiredInterface(key string) *v1.Interface {
+	for _, element := range nc.DesiredState().GetInterfaces() {
+		if desiredKey(element) == key {
+			return element
+		}
+	}
+	return nil
+}
+
+// actualInterface returns the current settings of the interface the desired settings refer to, or nil if it does not exist.
+// With live the settings are read from NetworkManager, otherwise from the snapshot if it is available.
+func (nc *NetworkConfigurator) actualInterface(desired *v1.Interface, live bool) *v1.Interface {
+	var actual *v1.Interface
+	if live {
+		var device nm.DeviceWired
+		if desired.MacAddress != "" {
+			device = nc.getDeviceWithMac(desired.MacAddress)
+		} else {
+			device = nc.getDeviceWithLabel(desired.Label)
+		}
+		if device != nil {
+			actual = DBusToProto(device, reconcileMask)
+		}
+	} else if desired.MacAddress != "" {
+		actual = nc.GetInterfaceWithMac(desired.MacAddress, reconcileMask)
+	} else {
+		actual = nc.GetInterfaceWithLabel(desired.Label, reconcileMask)
+	}
+
+	if actual != nil && desired.GatewayInterface {
+		actual.GatewayInterface = nc.IsGatewayInterface(actual.MacAddress)
+	}
+	return actual
+}
+
+// dhcpMode returns the DHCP mode of an interface, an interface without one is static.
+func dhcpMode(value string) string {
+	if value == "" {
+		return Disabled
+	}
+	return value
+}
+
+// driftFields returns the paths of the fields, which differ between the desired and the actual interface.
+// Only the fields set in the desired settings are compared, e.g. the address of a DHCP interface is not.
+func driftFields(desired, actual *v1.Interface) []string {
+	if actual == nil {
+		return nil
+	}
+
+	var fields []string
+	if !strings.EqualFold(dhcpMode(desired.DHCP), dhcpMode(actual.DHCP)) {
+		fields = append(fields, FieldDHCP)
+	}
+	if strings.EqualFold(dhcpMode(desired.DHCP), Disabled) && desired.Static != nil {
+		if !sameAddress(desired.Static.IPv4, actual.GetStatic().GetIPv4()) {
+			fields = append(fields, FieldStatic+".IPv4")
+		}
+		if !sameAddress(desired.Static.NetMask, actual.GetStatic().GetNetMask()) {
+			fields = append(fields, FieldStatic+".NetMask")
+		}
+		if !sameAddress(desired.Static.Gateway, actual.GetStatic().GetGateway()) {
+			fields = append(fields, FieldStatic+".Gateway")
+		}
+	}
+	if desired.DNSConfig != nil {
+		if len(desired.DNSConfig.Servers) > 0 {
+			// the servers received by DHCP may follow the desired ones
+			if !leadingServers(desired.DNSConfig.Servers, actual.GetDNSConfig().GetServers()) {
+				fields = append(fields, FieldDNSConfig+".Servers")
+			}
+		} else {
+			if !sameAddress(desired.DNSConfig.PrimaryDNS, actual.GetDNSConfig().GetPrimaryDNS()) {
+				fields = append(fields, FieldDNSConfig+".PrimaryDNS")
+			}
+			if !sameAddress(desired.DNSConfig.SecondaryDNS, actual.GetDNSConfig().GetSecondaryDNS()) {
+				fields = append(fields, FieldDNSConfig+".SecondaryDNS")
+			}
+		}
+		if len(desired.DNSConfig.Searches) > 0 && !slices.Equal(desired.DNSConfig.Searches, actual.GetDNSConfig().GetSearches()) {
+			fields = append(fields, FieldDNSConfig+".Searches")
+		}
+	}
+	if len(desired.Routes) > 0 && !sameRoutes(desired.Routes, actual.Routes) {
+		fields = append(fields, FieldRoutes)
+	}
+	if desired.Link != nil && !sameLink(desired.Link, actual.GetLink()) {
+		fields = append(fields, FieldLink)
+	}
+	// A non gateway interface may still hold the default route, if no other interface has one.
+	if desired.GatewayInterface && !actual.GatewayInterface {
+		fields = append(fields, FieldGatewayInterface)
+	}
+	return fields
+}
+
+// sameAddress compares two IP addresses independent of their notation.
+func sameAddress(first, second string) bool {
+	firstIP, secondIP := net.ParseIP(first), net.ParseIP(second)
+	if firstIP != nil && secondIP != nil {
+		return firstIP.Equal(secondIP)
+	}
+	return first == second
+}
+
+// leadingServers checks if the actual DNS servers start with the desired ones.
+func leadingServers(desired, actual []string) bool {
+	if len(actual) < len(desired) {
+		return false
+	}
+	for i := range desired {
+		if !sameAddress(desired[i], actual[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// sameLink checks if the actual link settings contain the given desired ones.
+func sameLink(desired, actual *v1.Interface_LinkConf) bool {
+	return (desired.MTU == 0 || desired.MTU == actual.GetMTU()) &&
+		(desired.Speed == 0 || desired.Speed == actual.GetSpeed()) &&
+		(desired.Duplex == "" || desired.Duplex == actual.GetDuplex()) &&
+		(desired.AutoNegotiate == "" || desired.AutoNegotiate == actual.GetAutoNegotiate()) &&
+		(desired.ClonedMAC == "" || strings.EqualFold(desired.ClonedMAC, actual.GetClonedMAC())) &&
+		(len(desired.WakeOnLan) == 0 || wakeOnLanMask(desired.WakeOnLan) == wakeOnLanMask(actual.GetWakeOnLan()))
+}
+
+// sameRoutes compares two route lists in order, destinations independent of their notation.
+func sameRoutes(first, second []*v1.Interface_Route) bool {
+	if len(first) != len(second) {
+		return false
+	}
+	for i := range first {
+		_, firstNet, _ := net.ParseCIDR(first[i].Destination)
+		_, secondNet, _ := net.ParseCIDR(second[i].Destination)
+		if firstNet.String() != secondNet.String() || !sameAddress(first[i].NextHop, second[i].NextHop) ||
+			first[i].Metric != second[i].Metric {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,244 @@
+/*
+ * Copyright © Siemens 2025. ALL RIGHTS RESERVED.
+ * Licensed under the MIT license
+ * See LICENSE file in the top-level directory
+ */
+
+package networking
+
+import (
+	"errors"
+	v1 "networkservice/api/siemens_iedge_dmapi_v1"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+// getReconcileConfigurator returns a configurator with the given desired state already loaded.
+func getReconcileConfigurator(desired ...*v1.Interface) *NetworkConfigurator {
+	nc := &NetworkConfigurator{locks: newApplyLocks(), reconciler: newReconciler(), confirmation: newConfirmationStore()}
+	nc.reconciler.desired = &v1.NetworkSettings{Interfaces: desired}
+	nc.reconciler.loaded = true
+	return nc
+}
+
+func Test_driftFields(t *testing.T) {
+	desired := &v1.Interface{
+		MacAddress:       "00:0A:95:9D:68:16",
+		DHCP:             Disabled,
+		Static:           getMockInterfaceStaticConf(),
+		DNSConfig:        &v1.Interface_Dns{PrimaryDNS: "8.8.8.8"},
+		GatewayInterface: true,
+	}
+	actual := &v1.Interface{
+		MacAddress:       "00:0A:95:9D:68:16",
+		DHCP:             Disabled,
+		Static:           getMockInterfaceStaticConf(),
+		DNSConfig:        &v1.Interface_Dns{PrimaryDNS: "8.8.8.8"},
+		GatewayInterface: true,
+	}
+	assert.Empty(t, driftFields(desired, actual))
+
+	actual.Static.IPv4 = "192.168.1.2"
+	actual.DNSConfig.SecondaryDNS = "8.8.4.4"
+	actual.GatewayInterface = false
+	assert.Equal(t, []string{"Static.IPv4", "DNSConfig.SecondaryDNS", FieldGatewayInterface}, driftFields(desired, actual))
+
+	desired.DNSConfig = &v1.Interface_Dns{Servers: []string{"8.8.8.8", "8.8.4.4"}, Searches: []string{"plant.local"}}
+	actual.Static.IPv4, actual.GatewayInterface = desired.Static.IPv4, true
+	actual.DNSConfig = &v1.Interface_Dns{Servers: []string{"8.8.8.8", "8.8.4.4", "192.168.0.1"}, Searches: []string{"plant.local"}}
+	assert.Empty(t, driftFields(desired, actual), "DNS servers received by DHCP may follow the desired ones")
+	actual.DNSConfig.Servers = []string{"8.8.4.4", "8.8.8.8"}
+	assert.Equal(t, []string{"DNSConfig.Servers"}, driftFields(desired, actual))
+
+	desired.DNSConfig, actual.DNSConfig = nil, nil
+	desired.Link = &v1.Interface_LinkConf{MTU: 9000, WakeOnLan: []string{"magic", "phy"}}
+	actual.Link = &v1.Interface_LinkConf{MTU: 9000, Duplex: Full, WakeOnLan: []string{"phy", "magic"}}
+	assert.Empty(t, driftFields(desired, actual))
+	actual.Link.MTU = 1500
+	assert.Equal(t, []string{FieldLink}, driftFields(desired, actual))
+
+	dhcp := &v1.Interface{MacAddress: "00:0A:95:9D:68:16", DHCP: "Enabled"}
+	assert.Empty(t, driftFields(dhcp, &v1.Interface{DHCP: Enabled, Static: getMockInterfaceStaticConf()}),
+		"The leased address of a DHCP interface should not be compared")
+	assert.Equal(t, []string{FieldDHCP}, driftFields(dhcp, actual))
+
+	static := &v1.Interface{MacAddress: "00:0A:95:9D:68:16", Static: getMockInterfaceStaticConf()}
+	assert.Empty(t, driftFields(static, &v1.Interface{DHCP: Disabled, Static: getMockInterfaceStaticConf()}),
+		"An interface without DHCP mode is static")
+	assert.Equal(t, []string{"Static.IPv4"}, driftFields(static, &v1.Interface{DHCP: Disabled,
+		Static: &v1.Interface_StaticConf{IPv4: "192.168.1.2", NetMask: "255.255.255.0", Gateway: "192.168.1.254"}}))
+}
+
+func Test_GetDrift(t *testing.T) {
+	nc := getReconcileConfigurator(
+		&v1.Interface{MacAddress: "00:0A:95:9D:68:16", DHCP: Enabled},
+		&v1.Interface{MacAddress: "00:0A:95:9D:68:17", DHCP: Enabled, Reconcile: v1.Interface_ENFORCE},
+		&v1.Interface{Label: "X3", DHCP: Enabled},
+	)
+	nc.reconciler.corrections["00:0A:95:9D:68:17"] = correction{at: time.Unix(1700000000, 0), err: errors.New("activation failed")}
+
+	patches := gomonkey.NewPatches()
+	defer patches.Reset()
+	patches.ApplyMethod(reflect.TypeOf(nc), "GetInterfaceWithMac", func(_ *NetworkConfigurator, mac string, _ *InterfaceMask) *v1.Interface {
+		if mac == "00:0A:95:9D:68:16" {
+			return &v1.Interface{MacAddress: mac, DHCP: Enabled}
+		}
+		return &v1.Interface{MacAddress: mac, DHCP: Disabled, Static: getMockInterfaceStaticConf()}
+	})
+	patches.ApplyMethod(reflect.TypeOf(nc), "GetInterfaceWithLabel", func(_ *NetworkConfigurator, _ string, _ *InterfaceMask) *v1.Interface {
+		return nil
+	})
+
+	report := nc.GetDrift()
+
+	assert.NotZero(t, report.CheckedAt)
+	assert.Len(t, report.Interfaces, 2, "Only interfaces with drift should be reported")
+	assert.Equal(t, "00:0A:95:9D:68:17", report.Interfaces[0].MacAddress)
+	assert.Equal(t, []string{FieldDHCP}, report.Interfaces[0].Fields)
+	assert.Equal(t, v1.Interface_ENFORCE, report.Interfaces[0].Mode)
+	assert.Equal(t, int64(1700000000), report.Interfaces[0].LastCorrection)
+	assert.Equal(t, "activation failed", report.Interfaces[0].LastCorrectionError)
+	assert.Equal(t, "X3", report.Interfaces[1].Label)
+	assert.True(t, report.Interfaces[1].Missing)
+}
+
+func Test_GetDrift_WithoutDesiredState(t *testing.T) {
+	nc := getReconcileConfigurator()
+	nc.reconciler.desired = nil
+
+	assert.Empty(t, nc.GetDrift().Interfaces)
+}
+
+func Test_reconcile_CorrectsEnforcedInterfacesOnly(t *testing.T) {
+	nc := getReconcileConfigurator(
+		&v1.Interface{MacAddress: "00:0A:95:9D:68:16", DHCP: Enabled},
+		&v1.Interface{MacAddress: "00:0A:95:9D:68:17", DHCP: Enabled, Reconcile: v1.Interface_ENFORCE},
+	)
+
+	patches := gomonkey.NewPatches()
+	defer patches.Reset()
+	patches.ApplyPrivateMethod(reflect.TypeOf(nc), "actualInterface", func(_ *NetworkConfigurator, desired *v1.Interface, _ bool) *v1.Interface {
+		return &v1.Interface{MacAddress: desired.MacAddress, DHCP: Disabled}
+	})
+	patches.ApplyMethod(reflect.TypeOf(nc), "ArePreconditionsOk", func(_ *NetworkConfigurator, _ *v1.NetworkSettings) (bool, error) {
+		return true, nil
+	})
+	var applied []*v1.NetworkSettings
+	patches.ApplyMethod(reflect.TypeOf(nc), "Apply", func(_ *NetworkConfigurator, newSettings *v1.NetworkSettings) error {
+		applied = append(applied, newSettings)
+		return nil
+	})
+
+	nc.reconcile()
+	nc.reconcile()
+
+	assert.Len(t, applied, 1, "The drift should be corrected once within the backoff")
+	assert.Equal(t, "00:0A:95:9D:68:17", applied[0].Interfaces[0].MacAddress)
+	last, ok := nc.lastCorrection("00:0A:95:9D:68:17")
+	assert.True(t, ok)
+	assert.NoError(t, last.err)
+	_, ok = nc.lastCorrection("00:0A:95:9D:68:16")
+	assert.False(t, ok, "Observed interfaces should not be corrected")
+}
+
+func Test_correctDrift_SkipsWhenDriftIsGoneUnderLock(t *testing.T) {
+	desired := &v1.Interface{MacAddress: "00:0A:95:9D:68:17", DHCP: Enabled, Reconcile: v1.Interface_ENFORCE}
+	nc := getReconcileConfigurator(desired)
+
+	patches := gomonkey.NewPatches()
+	defer patches.Reset()
+	patches.ApplyPrivateMethod(reflect.TypeOf(nc), "actualInterface", func(_ *NetworkConfigurator, desired *v1.Interface, live bool) *v1.Interface {
+		assert.True(t, live, "The drift should be checked against NetworkManager under the lock")
+		return &v1.Interface{MacAddress: desired.MacAddress, DHCP: Enabled}
+	})
+	patches.ApplyMethod(reflect.TypeOf(nc), "Apply", func(_ *NetworkConfigurator, _ *v1.NetworkSettings) error {
+		t.Error("Apply should not be called without drift")
+		return nil
+	})
+
+	nc.correctDrift(desired)
+
+	_, ok := nc.lastCorrection("00:0A:95:9D:68:17")
+	assert.False(t, ok)
+}
+
+func Test_correctDrift_RecordsRejectedPreconditions(t *testing.T) {
+	desired := &v1.Interface{MacAddress: "00:0A:95:9D:68:17", DHCP: Enabled, Reconcile: v1.Interface_ENFORCE}
+	nc := getReconcileConfigurator(desired)
+
+	patches := gomonkey.NewPatches()
+	defer patches.Reset()
+	patches.ApplyPrivateMethod(reflect.TypeOf(nc), "actualInterface", func(_ *NetworkConfigurator, desired *v1.Interface, _ bool) *v1.Interface {
+		return &v1.Interface{MacAddress: desired.MacAddress, DHCP: Disabled}
+	})
+	patches.ApplyMethod(reflect.TypeOf(nc), "ArePreconditionsOk", func(_ *NetworkConfigurator, _ *v1.NetworkSettings) (bool, error) {
+		return false, errors.New("the policy requires DHCP")
+	})
+	patches.ApplyMethod(reflect.TypeOf(nc), "Apply", func(_ *NetworkConfigurator, _ *v1.NetworkSettings) error {
+		t.Error("Apply should not be called if the preconditions are not met")
+		return nil
+	})
+
+	nc.correctDrift(desired)
+
+	last, ok := nc.lastCorrection("00:0A:95:9D:68:17")
+	assert.True(t, ok)
+	assert.EqualError(t, last.err, "the policy requires DHCP")
+}
+
+func Test_correctDrift_SkipsWhileConfirmationIsPending(t *testing.T) {
+	desired := &v1.Interface{MacAddress: "00:0A:95:9D:68:17", DHCP: Enabled, Reconcile: v1.Interface_ENFORCE}
+	nc := getReconcileConfigurator(desired)
+	nc.confirmation.pending = &pendingChange{}
+
+	patches := gomonkey.NewPatches()
+	defer patches.Reset()
+	patches.ApplyPrivateMethod(reflect.TypeOf(nc), "actualInterface", func(_ *NetworkConfigurator, desired *v1.Interface, _ bool) *v1.Interface {
+		return &v1.Interface{MacAddress: desired.MacAddress, DHCP: Disabled}
+	})
+	patches.ApplyMethod(reflect.TypeOf(nc), "Apply", func(_ *NetworkConfigurator, _ *v1.NetworkSettings) error {
+		t.Error("Apply should not be called while a change awaits confirmation")
+		return nil
+	})
+
+	nc.correctDrift(desired)
+
+	_, ok := nc.lastCorrection("00:0A:95:9D:68:17")
+	assert.False(t, ok, "The correction should be tried again after the confirmation")
+}
+
+func Test_correctDrift_KeepsForeignProfilePolicyOfApply(t *testing.T) {
+	nc := getReconcileConfigurator()
+	desired := &v1.Interface{MacAddress: "00:0A:95:9D:68:17", DHCP: Enabled, Reconcile: v1.Interface_ENFORCE}
+
+	patches := gomonkey.NewPatches()
+	defer patches.Reset()
+	patches.ApplyFunc(writeProtoFile, func(_ proto.Message, _ string) error {
+		return nil
+	})
+	patches.ApplyPrivateMethod(reflect.TypeOf(nc), "actualInterface", func(_ *NetworkConfigurator, desired *v1.Interface, _ bool) *v1.Interface {
+		return &v1.Interface{MacAddress: desired.MacAddress, DHCP: Disabled}
+	})
+	patches.ApplyMethod(reflect.TypeOf(nc), "ArePreconditionsOk", func(_ *NetworkConfigurator, _ *v1.NetworkSettings) (bool, error) {
+		return true, nil
+	})
+	var applied *v1.NetworkSettings
+	patches.ApplyMethod(reflect.TypeOf(nc), "Apply", func(_ *NetworkConfigurator, newSettings *v1.NetworkSettings) error {
+		applied = newSettings
+		return nil
+	})
+
+	_, err := nc.SaveDesiredState(&v1.NetworkSettings{Interfaces: []*v1.Interface{desired},
+		Options: &v1.ApplyOptions{ForeignProfiles: v1.ApplyOptions_LEAVE, Comment: "maintenance"}})
+	assert.NoError(t, err)
+	nc.correctDrift(desired)
+
+	assert.NotNil(t, applied)
+	assert.Equal(t, v1.ApplyOptions_LEAVE, applied.GetOptions().GetForeignProfiles(),
+		"Foreign profiles left by the apply should not be deleted by the correction")
+}
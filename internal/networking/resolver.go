@@ -0,0 +1,218 @@
+/*
+ * Copyright © Siemens 2025. ALL RIGHTS RESERVED.
+ * Licensed under the MIT license
+ * See LICENSE file in the top-level directory
+ */
+
+package networking
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	v1 "networkservice/api/siemens_iedge_dmapi_v1"
+	"os"
+	"strings"
+	"sync"
+
+	nm "github.com/Wifx/gonetworkmanager/v2"
+	"github.com/godbus/dbus/v5"
+)
+
+// Keys of the global DNS configuration of NetworkManager. The servers belong to a domain, "*" is the default domain.
+const (
+	globalDNSSearchesKey = "searches"
+	globalDNSOptionsKey  = "options"
+	globalDNSDomainsKey  = "domains"
+	globalDNSServersKey  = "servers"
+	globalDNSDefault     = "*"
+)
+
+// ErrInvalidGlobalDNS is returned for a global DNS configuration, which can not be set.
+var ErrInvalidGlobalDNS = errors.New("invalid global DNS configuration")
+
+// resolverStore serializes the changes of the hosts file and the global DNS configuration.
+type resolverStore struct {
+	mu sync.Mutex
+}
+
+func newResolverStore() *resolverStore {
+	return &resolverStore{}
+}
+
+// SetGlobalDNS sets the global DNS configuration of NetworkManager, e.g: {"Servers": ["10.0.0.53"], "Searches": ["plant.local"]}.
+// Its servers are used instead of the DNS settings of all interfaces. An empty configuration removes it.
+// NetworkManager keeps the configuration across restarts.
+func (nc *NetworkConfigurator) SetGlobalDNS(config *v1.GlobalDns) error {
+	if err := validateGlobalDNS(config); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidGlobalDNS, err)
+	}
+	nc.resolver.mu.Lock()
+	defer nc.resolver.mu.Unlock()
+
+	if err := setGlobalDNSConfiguration(globalDNSToDBus(config)); err != nil {
+		return fmt.Errorf("could not set global DNS configuration: %w", err)
+	}
+	log.Printf("global DNS configuration set, servers: %v", config.GetServers())
+	return nil
+}
+
+// ResolverStatus returns the effective resolver configuration of the device. Parts which can not be read are
+// left empty, the error of the first one is returned with the rest.
+func (nc *NetworkConfigurator) ResolverStatus() (*v1.ResolverStatus, error) {
+	nc.resolver.mu.Lock()
+	defer nc.resolver.mu.Unlock()
+
+	status := &v1.ResolverStatus{}
+	var errs []error
+	if global, err := getGlobalDNSConfiguration(); err == nil {
+		status.Global = globalDNSFromDBus(global)
+	} else {
+		errs = append(errs, fmt.Errorf("could not read global DNS configuration: %w", err))
+	}
+
+	if manager, err := nm.NewDnsManager(); err == nil {
+		status.Mode, _ = manager.GetPropertyMode()
+		status.RcManager, _ = manager.GetPropertyRcManager()
+		configurations, err := manager.GetPropertyConfiguration()
+		if err != nil {
+			errs = append(errs, fmt.Errorf("could not read DNS configuration: %w", err))
+		}
+		for _, configuration := range configurations {
+			status.Sources = append(status.Sources, &v1.ResolverStatus_ResolverSource{Interface: configuration.Interface,
+				Servers: configuration.Nameservers, Priority: configuration.Priority, Vpn: configuration.Vpn})
+		}
+	} else {
+		errs = append(errs, fmt.Errorf("could not read DNS manager: %w", err))
+	}
+
+	if buffer, err := os.ReadFile(ResolvConfFileName); err == nil {
+		status.Servers, status.Searches, status.Options = parseResolvConf(string(buffer))
+	} else if !os.IsNotExist(err) {
+		errs = append(errs, err)
+	}
+
+	entries, err := readHostEntries(HostsFileName)
+	if err != nil {
+		errs = append(errs, err)
+	}
+	status.HostEntries = entries
+
+	if len(errs) > 0 {
+		return status, errs[0]
+	}
+	return status, nil
+}
+
+// validateGlobalDNS checks the servers, search domains and resolver options. Search domains and options
+// need servers, NetworkManager ignores a global configuration without them.
+func validateGlobalDNS(config *v1.GlobalDns) error {
+	for _, server := range config.GetServers() {
+		if net.ParseIP(server) == nil {
+			return fmt.Errorf("wrong dns address %v", server)
+		}
+	}
+	for _, domain := range config.GetSearches() {
+		if !validHostname(strings.TrimSuffix(domain, ".")) {
+			return fmt.Errorf("wrong dns search domain %v", domain)
+		}
+	}
+	if config.GetTimeout() > maxDNSTimeout {
+		return fmt.Errorf("dns timeout is above %d seconds: %d", maxDNSTimeout, config.GetTimeout())
+	}
+	if config.GetAttempts() > maxDNSAttempts {
+		return fmt.Errorf("dns attempts are above %d: %d", maxDNSAttempts, config.GetAttempts())
+	}
+	if len(config.GetServers()) == 0 && (len(config.GetSearches()) > 0 || len(globalDNSOptions(config)) > 0) {
+		return errors.New("search domains and options need servers")
+	}
+	return nil
+}
+
+// globalDNSOptions returns the resolver options of the global DNS configuration, e.g: ["timeout:2", "rotate"]
+func globalDNSOptions(config *v1.GlobalDns) []string {
+	return dnsOptions(&v1.Interface_Dns{Timeout: config.GetTimeout(), Attempts: config.GetAttempts(), Rotate: config.GetRotate()})
+}
+
+// globalDNSToDBus converts the global DNS configuration to the property of NetworkManager. It is empty without servers.
+func globalDNSToDBus(config *v1.GlobalDns) map[string]dbus.Variant {
+	value := make(map[string]dbus.Variant)
+	if len(config.GetServers()) == 0 {
+		return value
+	}
+	if len(config.Searches) > 0 {
+		value[globalDNSSearchesKey] = dbus.MakeVariant(config.Searches)
+	}
+	if options := globalDNSOptions(config); len(options) > 0 {
+		value[globalDNSOptionsKey] = dbus.MakeVariant(options)
+	}
+	domain := map[string]dbus.Variant{globalDNSServersKey: dbus.MakeVariant(config.Servers)}
+	value[globalDNSDomainsKey] = dbus.MakeVariant(map[string]dbus.Variant{globalDNSDefault: dbus.MakeVariant(domain)})
+	return value
+}
+
+// globalDNSFromDBus converts the property of NetworkManager to the global DNS configuration, it returns nil without one.
+func globalDNSFromDBus(value map[string]dbus.Variant) *v1.GlobalDns {
+	if len(value) == 0 {
+		return nil
+	}
+	config := &v1.GlobalDns{}
+	config.Searches, _ = value[globalDNSSearchesKey].Value().([]string)
+	if domains, ok := value[globalDNSDomainsKey].Value().(map[string]dbus.Variant); ok {
+		if domain, ok := domains[globalDNSDefault].Value().(map[string]dbus.Variant); ok {
+			config.Servers, _ = domain[globalDNSServersKey].Value().([]string)
+		}
+	}
+	options, _ := value[globalDNSOptionsKey].Value().([]string)
+	dns := &v1.Interface_Dns{}
+	parseDNSOptions(options, dns)
+	config.Timeout, config.Attempts, config.Rotate = dns.Timeout, dns.Attempts, dns.Rotate
+	return config
+}
+
+// getGlobalDNSConfiguration reads the global DNS configuration property of NetworkManager.
+// gonetworkmanager does not provide it, so the property is read directly.
+func getGlobalDNSConfiguration() (map[string]dbus.Variant, error) {
+	conn, err := dbus.SystemBus()
+	if err != nil {
+		return nil, err
+	}
+	variant, err := conn.Object(nm.NetworkManagerInterface, nm.NetworkManagerObjectPath).
+		GetProperty(nm.NetworkManagerPropertyGlobalDnsConfiguration)
+	if err != nil {
+		return nil, err
+	}
+	value, _ := variant.Value().(map[string]dbus.Variant)
+	return value, nil
+}
+
+// setGlobalDNSConfiguration writes the global DNS configuration property of NetworkManager.
+func setGlobalDNSConfiguration(value map[string]dbus.Variant) error {
+	conn, err := dbus.SystemBus()
+	if err != nil {
+		return err
+	}
+	return conn.Object(nm.NetworkManagerInterface, nm.NetworkManagerObjectPath).
+		SetProperty(nm.NetworkManagerPropertyGlobalDnsConfiguration, dbus.MakeVariant(value))
+}
+
+// parseResolvConf returns the servers, search domains and options of a resolver configuration file.
+func parseResolvConf(content string) (servers, searches, options []string) {
+	for _, line := range strings.Split(content, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 2 || strings.HasPrefix(fields[0], "#") || strings.HasPrefix(fields[0], ";") {
+			continue
+		}
+		switch fields[0] {
+		case "nameserver":
+			servers = append(servers, fields[1])
+		case "search", "domain":
+			// the last search or domain line wins
+			searches = fields[1:]
+		case "options":
+			options = append(options, fields[1:]...)
+		}
+	}
+	return servers, searches, options
+}
@@ -0,0 +1,109 @@
+/*
+ * Copyright © Siemens 2025. ALL RIGHTS RESERVED.
+ * Licensed under the MIT license
+ * See LICENSE file in the top-level directory
+ */
+
+package networking
+
+import (
+	"errors"
+	v1 "networkservice/api/siemens_iedge_dmapi_v1"
+	"testing"
+
+	nm "github.com/Wifx/gonetworkmanager/v2"
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/godbus/dbus/v5"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+// mockDnsManager returns fixed values for the DNS manager of NetworkManager.
+type mockDnsManager struct {
+	configurations []nm.DnsConfigurationData
+}
+
+func (m mockDnsManager) GetPath() dbus.ObjectPath              { return nm.DnsManagerObjectPath }
+func (m mockDnsManager) GetPropertyMode() (string, error)      { return "default", nil }
+func (m mockDnsManager) GetPropertyRcManager() (string, error) { return "file", nil }
+func (m mockDnsManager) GetPropertyConfiguration() ([]nm.DnsConfigurationData, error) {
+	return m.configurations, nil
+}
+
+func Test_globalDNS_RoundTrip(t *testing.T) {
+	config := &v1.GlobalDns{Servers: []string{"10.0.0.53", "fd00::53"}, Searches: []string{"plant.local"}, Timeout: 2, Rotate: true}
+
+	value := globalDNSToDBus(config)
+	assert.Equal(t, []string{"timeout:2", "rotate"}, value[globalDNSOptionsKey].Value())
+	assert.True(t, proto.Equal(config, globalDNSFromDBus(value)), "The global DNS configuration should be read back: %v", globalDNSFromDBus(value))
+
+	assert.Empty(t, globalDNSToDBus(&v1.GlobalDns{}), "An empty configuration should remove the global DNS configuration")
+	assert.Nil(t, globalDNSFromDBus(map[string]dbus.Variant{}))
+}
+
+func Test_validateGlobalDNS(t *testing.T) {
+	assert.NoError(t, validateGlobalDNS(&v1.GlobalDns{Servers: []string{"10.0.0.53", "fd00::53"}, Searches: []string{"plant.local"},
+		Timeout: 30, Attempts: 5, Rotate: true}))
+	assert.NoError(t, validateGlobalDNS(&v1.GlobalDns{}))
+
+	for name, config := range map[string]*v1.GlobalDns{
+		"server":        {Servers: []string{"10.0.0.256"}},
+		"search domain": {Servers: []string{"10.0.0.53"}, Searches: []string{"plant_local"}},
+		"timeout":       {Servers: []string{"10.0.0.53"}, Timeout: 31},
+		"attempts":      {Servers: []string{"10.0.0.53"}, Attempts: 6},
+		"search only":   {Searches: []string{"plant.local"}},
+		"options only":  {Rotate: true},
+	} {
+		assert.Error(t, validateGlobalDNS(config), name)
+	}
+}
+
+func Test_SetGlobalDNS(t *testing.T) {
+	nc := &NetworkConfigurator{resolver: newResolverStore()}
+	var written map[string]dbus.Variant
+	patches := gomonkey.ApplyFunc(setGlobalDNSConfiguration, func(value map[string]dbus.Variant) error {
+		written = value
+		return nil
+	})
+	defer patches.Reset()
+
+	assert.NoError(t, nc.SetGlobalDNS(&v1.GlobalDns{Servers: []string{"10.0.0.53"}}))
+	assert.Contains(t, written, globalDNSDomainsKey)
+
+	written = nil
+	assert.ErrorIs(t, nc.SetGlobalDNS(&v1.GlobalDns{Servers: []string{"dns"}}), ErrInvalidGlobalDNS)
+	assert.Nil(t, written, "An invalid configuration should not be written")
+}
+
+func Test_parseResolvConf(t *testing.T) {
+	servers, searches, options := parseResolvConf("# Generated by NetworkManager\nsearch plant.local siemens.com\n" +
+		"nameserver 10.0.0.53\nnameserver 10.0.1.53\n; comment\noptions timeout:2 rotate\n")
+
+	assert.Equal(t, []string{"10.0.0.53", "10.0.1.53"}, servers)
+	assert.Equal(t, []string{"plant.local", "siemens.com"}, searches)
+	assert.Equal(t, []string{"timeout:2", "rotate"}, options)
+}
+
+func Test_ResolverStatus(t *testing.T) {
+	nc := &NetworkConfigurator{resolver: newResolverStore()}
+	manager := mockDnsManager{[]nm.DnsConfigurationData{{Nameservers: []string{"192.168.0.1"}, Interface: "ens18", Priority: 100}}}
+	patches := gomonkey.ApplyFunc(getGlobalDNSConfiguration, func() (map[string]dbus.Variant, error) {
+		return globalDNSToDBus(&v1.GlobalDns{Servers: []string{"10.0.0.53"}}), nil
+	})
+	patches.ApplyFunc(nm.NewDnsManager, func() (nm.DnsManager, error) { return manager, nil })
+	patches.ApplyFunc(readHostEntries, func(_ string) ([]*v1.HostEntry, error) { return getMockHostEntries(), nil })
+	defer patches.Reset()
+
+	status, err := nc.ResolverStatus()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"10.0.0.53"}, status.Global.Servers)
+	assert.Equal(t, "default", status.Mode)
+	assert.Equal(t, "ens18", status.Sources[0].Interface)
+	assert.Equal(t, []string{"192.168.0.1"}, status.Sources[0].Servers)
+	assert.Len(t, status.HostEntries, 2)
+
+	patches.ApplyFunc(nm.NewDnsManager, func() (nm.DnsManager, error) { return nil, errors.New("no NetworkManager") })
+	status, err = nc.ResolverStatus()
+	assert.Error(t, err)
+	assert.Len(t, status.HostEntries, 2, "The parts which could be read should be returned")
+}
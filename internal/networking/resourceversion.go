@@ -0,0 +1,158 @@
+/*
+ * Copyright © Siemens 2025. ALL RIGHTS RESERVED.
+ * Licensed under the MIT license
+ * See LICENSE file in the top-level directory
+ */
+
+package networking
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"log"
+	v1 "networkservice/api/siemens_iedge_dmapi_v1"
+	"sort"
+	"strings"
+
+	nm "github.com/Wifx/gonetworkmanager/v2"
+	"google.golang.org/protobuf/proto"
+)
+
+// ErrResourceVersionConflict is returned when the resource version of an apply request does not match the current state.
+var ErrResourceVersionConflict = errors.New("resource version conflict")
+
+// deviceResourceVersion returns the resource version of the given device. It covers all connection profiles
+// of the device, the active connection and the label, so changes made outside the service (e.g. nmcli) are detected.
+func deviceResourceVersion(device nm.DeviceWired, label string) string {
+	var profiles []string
+	for _, connection := range listConnections(device) {
+		settings, err := connection.GetSettings()
+		if err != nil {
+			log.Printf("could not read settings of connection %v: %v", connection.GetPath(), err)
+			continue
+		}
+		profiles = append(profiles, canonicalSettings(settings))
+	}
+	sort.Strings(profiles)
+
+	h := sha256.New()
+	for _, profile := range profiles {
+		fmt.Fprintf(h, "profile\n%s", profile)
+	}
+	if active, err := device.GetPropertyActiveConnection(); err == nil && active != nil {
+		uuid, _ := active.GetPropertyUUID()
+		fmt.Fprintf(h, "active=%s\n", uuid)
+	}
+	fmt.Fprintf(h, "label=%s\n", strings.ToUpper(label))
+	return encodeVersion(h)
+}
+
+// canonicalSettings returns the connection settings as sorted "setting.key=value" lines.
+func canonicalSettings(settings nm.ConnectionSettings) string {
+	var lines []string
+	for name, values := range settings {
+		for key, value := range values {
+			// the timestamp is updated by NetworkManager on every activation
+			if name == ConnectionKey && key == TimeStampKey {
+				continue
+			}
+			lines = append(lines, fmt.Sprintf("%s.%s=%v\n", name, key, value))
+		}
+	}
+	sort.Strings(lines)
+	return strings.Join(lines, "")
+}
+
+// settingsResourceVersion returns the resource version of all interfaces, given as MAC address to resource version,
+// the label map and the bonds. The live state of the bonds is not covered.
+func settingsResourceVersion(versions map[string]string, labelMap map[string]string, bonds *v1.BondList) string {
+	h := sha256.New()
+	for _, mac := range sortedKeys(versions) {
+		fmt.Fprintf(h, "interface %s=%s\n", strings.ToUpper(mac), versions[mac])
+	}
+	upperLabels := GetMapWithUppercase(labelMap)
+	for _, label := range sortedKeys(upperLabels) {
+		fmt.Fprintf(h, "label %s=%s\n", label, upperLabels[label])
+	}
+	for _, bond := range bonds.GetInterfaces() {
+		settings := proto.Clone(bond).(*v1.Bond)
+		settings.Status = nil
+		buffer, _ := proto.MarshalOptions{Deterministic: true}.Marshal(settings)
+		fmt.Fprintf(h, "bond %s=%x\n", bond.Name, buffer)
+	}
+	return encodeVersion(h)
+}
+
+func encodeVersion(h hash.Hash) string {
+	return hex.EncodeToString(h.Sum(nil)[:8])
+}
+
+func sortedKeys(values map[string]string) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// ResourceVersion returns the resource version of all cached interfaces, the label map and the bonds.
+func (s *Snapshot) ResourceVersion() string {
+	versions := make(map[string]string)
+	for _, device := range s.devices {
+		versions[device.iface.MacAddress] = device.iface.ResourceVersion
+	}
+	return settingsResourceVersion(versions, s.labelMap, s.bonds)
+}
+
+// CheckResourceVersions compares the resource versions of the given settings with the current state read from
+// NetworkManager. Empty resource versions are not checked. Callers have to hold the apply locks of the settings.
+func (nc *NetworkConfigurator) CheckResourceVersions(newSettings *v1.NetworkSettings) error {
+	if newSettings.ResourceVersion != "" {
+		current := nc.currentSettingsResourceVersion()
+		if current != newSettings.ResourceVersion {
+			return fmt.Errorf("%w: network settings have version %s, expected %s",
+				ErrResourceVersionConflict, current, newSettings.ResourceVersion)
+		}
+	}
+
+	for _, element := range newSettings.Interfaces {
+		if element.ResourceVersion == "" {
+			continue
+		}
+		device, err := nc.getDeviceBy(element)
+		if err != nil {
+			return err
+		}
+		if device == nil {
+			return fmt.Errorf("%w: interface %s%s does not exist", ErrResourceVersionConflict, element.MacAddress, element.Label)
+		}
+		current := liveDeviceResourceVersion(device)
+		if current != element.ResourceVersion {
+			return fmt.Errorf("%w: interface %s%s has version %s, expected %s",
+				ErrResourceVersionConflict, element.MacAddress, element.Label, current, element.ResourceVersion)
+		}
+	}
+	return nil
+}
+
+// currentSettingsResourceVersion reads the resource version of all interfaces, the label map and the bonds.
+func (nc *NetworkConfigurator) currentSettingsResourceVersion() string {
+	versions := make(map[string]string)
+	for _, device := range nc.getAllEthernetDevices() {
+		mac, _ := deviceMac(device)
+		versions[mac] = liveDeviceResourceVersion(device)
+	}
+	labelMap, _ := readMapFromFile(LabelMapFileName)
+	return settingsResourceVersion(versions, labelMap, readBonds())
+}
+
+// liveDeviceResourceVersion reads the label of the given device and returns its resource version.
+func liveDeviceResourceVersion(device nm.DeviceWired) string {
+	interfaceName, _ := device.GetPropertyInterface()
+	label, _ := getLabelForInterface(interfaceName)
+	return deviceResourceVersion(device, label)
+}
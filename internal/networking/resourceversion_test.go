@@ -0,0 +1,141 @@
+/*
+ * Copyright © Siemens 2025. ALL RIGHTS RESERVED.
+ * Licensed under the MIT license
+ * See LICENSE file in the top-level directory
+ */
+
+package networking
+
+import (
+	"errors"
+	v1 "networkservice/api/siemens_iedge_dmapi_v1"
+	mockgnm "networkservice/internal/networking/mocks/gonetworkmanager"
+	"reflect"
+	"testing"
+
+	nm "github.com/Wifx/gonetworkmanager/v2"
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func getMockVersionedSettings(method string, timestamp uint64) nm.ConnectionSettings {
+	return nm.ConnectionSettings{
+		ConnectionKey: {IDKey: "eth0_dhcp", TimeStampKey: timestamp},
+		IPV4Key:       {MethodKey: method},
+	}
+}
+
+func Test_canonicalSettings_IgnoresTimestamp(t *testing.T) {
+	first := canonicalSettings(getMockVersionedSettings(Auto, 1))
+	second := canonicalSettings(getMockVersionedSettings(Auto, 2))
+
+	assert.Equal(t, first, second, "The activation timestamp should not change the canonical settings")
+	assert.NotEqual(t, first, canonicalSettings(getMockVersionedSettings(Manual, 1)))
+}
+
+func Test_deviceResourceVersion_ChangesWithProfile(t *testing.T) {
+	mockDeviceWired := &mockgnm.MockDeviceWired{}
+	mockActiveConnection := &mockgnm.MockActiveConnection{}
+	mockActiveConnection.On("GetPropertyUUID").Return("a6f0a3d4-6c0e-4a4e-9d2b-2d9b1c0e5f11", nil)
+	mockDeviceWired.On("GetPropertyActiveConnection").Return(mockActiveConnection, nil)
+
+	var currentSettings nm.ConnectionSettings
+	patches := gomonkey.NewPatches()
+	defer patches.Reset()
+	patches.ApplyFunc(listConnections, func(_ nm.DeviceWired) []nm.Connection {
+		mockConnection := &mockgnm.MockConnection{}
+		mockConnection.On("GetSettings").Return(currentSettings, nil)
+		return []nm.Connection{mockConnection}
+	})
+
+	versionOf := func(settings nm.ConnectionSettings, label string) string {
+		currentSettings = settings
+		return deviceResourceVersion(mockDeviceWired, label)
+	}
+	auto := versionOf(getMockVersionedSettings(Auto, 1), "X1")
+
+	assert.Len(t, auto, 16)
+	assert.Equal(t, auto, versionOf(getMockVersionedSettings(Auto, 2), "x1"))
+	assert.NotEqual(t, auto, versionOf(getMockVersionedSettings(Manual, 1), "X1"),
+		"A changed profile, e.g. by nmcli, should change the resource version")
+	assert.NotEqual(t, auto, versionOf(getMockVersionedSettings(Auto, 1), "X2"),
+		"A changed label should change the resource version")
+}
+
+func Test_settingsResourceVersion_CoversInterfacesLabelsAndBonds(t *testing.T) {
+	versions := map[string]string{"00:0A:95:9D:68:16": "aaaa", "00:0A:95:9D:68:17": "bbbb"}
+	labels := map[string]string{"x1": "eth0"}
+	version := settingsResourceVersion(versions, labels, nil)
+
+	assert.Equal(t, version, settingsResourceVersion(versions, map[string]string{"X1": "ETH0"}, nil))
+	assert.NotEqual(t, version, settingsResourceVersion(map[string]string{"00:0A:95:9D:68:16": "aaaa"}, labels, nil))
+	assert.NotEqual(t, version, settingsResourceVersion(versions, map[string]string{"x1": "eth1"}, nil))
+
+	bonds := &v1.BondList{Interfaces: []*v1.Bond{{Name: "bond0", Members: []string{"X1", "X2"}, DHCP: Enabled,
+		Status: &v1.Bond_BondState{Carrier: true, ActiveSlave: "eth0"}}}}
+	bondVersion := settingsResourceVersion(versions, labels, bonds)
+	assert.NotEqual(t, version, bondVersion, "A bond should change the resource version")
+	bonds.Interfaces[0].Status.ActiveSlave = "eth1"
+	assert.Equal(t, bondVersion, settingsResourceVersion(versions, labels, bonds), "The live state of a bond should not be covered")
+	bonds.Interfaces[0].Primary = "X2"
+	assert.NotEqual(t, bondVersion, settingsResourceVersion(versions, labels, bonds))
+}
+
+func Test_Snapshot_ResourceVersion(t *testing.T) {
+	snapshot := getMockSnapshot()
+	snapshot.devices[0].iface.ResourceVersion = "aaaa"
+	snapshot.devices[1].iface.ResourceVersion = "bbbb"
+
+	snapshot.bonds = &v1.BondList{Interfaces: []*v1.Bond{{Name: "bond0", Members: []string{"X1", "X2"}}}}
+
+	expected := settingsResourceVersion(map[string]string{"00:0A:95:9D:68:16": "aaaa", "00:0A:95:9D:68:17": "bbbb"}, snapshot.labelMap, snapshot.bonds)
+
+	assert.Equal(t, expected, snapshot.ResourceVersion())
+}
+
+func Test_CheckResourceVersions(t *testing.T) {
+	nc := &NetworkConfigurator{}
+	mockDeviceWired := &mockgnm.MockDeviceWired{}
+	mockDeviceWired.On("GetPropertyInterface").Return("eth0", nil)
+	mockDeviceWired.On("GetPropertyHwAddress").Return("00:0A:95:9D:68:16", nil)
+	mockDeviceWired.On("GetPropertyPermHwAddress").Return("00:0A:95:9D:68:16", nil)
+
+	patches := gomonkey.NewPatches()
+	defer patches.Reset()
+	patches.ApplyPrivateMethod(reflect.TypeOf(nc), "getDeviceWithMac", func(_ *NetworkConfigurator, mac string) nm.DeviceWired {
+		if mac == "00:0A:95:9D:68:16" {
+			return mockDeviceWired
+		}
+		return nil
+	})
+	patches.ApplyPrivateMethod(reflect.TypeOf(nc), "getAllEthernetDevices", func(_ *NetworkConfigurator) []nm.DeviceWired {
+		return []nm.DeviceWired{mockDeviceWired}
+	})
+	patches.ApplyFunc(getLabelForInterface, func(_ string) (string, error) {
+		return "X1", nil
+	})
+	patches.ApplyFunc(readMapFromFile, func(_ string) (map[string]string, error) {
+		return map[string]string{"X1": "ETH0"}, nil
+	})
+	patches.ApplyFunc(deviceResourceVersion, func(_ nm.DeviceWired, label string) string {
+		return "current-" + label
+	})
+	patches.ApplyFunc(readBonds, func() *v1.BondList {
+		return nil
+	})
+
+	assert.NoError(t, nc.CheckResourceVersions(&v1.NetworkSettings{Interfaces: []*v1.Interface{{MacAddress: "00:0A:95:9D:68:16"}}}),
+		"Interfaces without resource version should not be checked")
+	assert.NoError(t, nc.CheckResourceVersions(&v1.NetworkSettings{Interfaces: []*v1.Interface{{MacAddress: "00:0A:95:9D:68:16", ResourceVersion: "current-X1"}}}))
+
+	err := nc.CheckResourceVersions(&v1.NetworkSettings{Interfaces: []*v1.Interface{{MacAddress: "00:0A:95:9D:68:16", ResourceVersion: "stale"}}})
+	assert.True(t, errors.Is(err, ErrResourceVersionConflict), "A stale interface version should be a conflict")
+
+	err = nc.CheckResourceVersions(&v1.NetworkSettings{Interfaces: []*v1.Interface{{MacAddress: "00:0A:95:9D:68:17", ResourceVersion: "current-X1"}}})
+	assert.True(t, errors.Is(err, ErrResourceVersionConflict), "A removed interface should be a conflict")
+
+	current := settingsResourceVersion(map[string]string{"00:0A:95:9D:68:16": "current-X1"}, map[string]string{"X1": "ETH0"}, nil)
+	assert.NoError(t, nc.CheckResourceVersions(&v1.NetworkSettings{ResourceVersion: current}))
+	err = nc.CheckResourceVersions(&v1.NetworkSettings{ResourceVersion: "stale"})
+	assert.True(t, errors.Is(err, ErrResourceVersionConflict), "A stale settings version should be a conflict")
+}
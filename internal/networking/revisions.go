@@ -0,0 +1,337 @@
+/*
+ * Copyright © Siemens 2025. ALL RIGHTS RESERVED.
+ * Licensed under the MIT license
+ * See LICENSE file in the top-level directory
+ */
+
+package networking
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	v1 "networkservice/api/siemens_iedge_dmapi_v1"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// maxRevisions is the number of revisions kept in the history, older revisions are dropped.
+const maxRevisions = 50
+
+// ErrRevisionNotFound is returned for revision numbers, which are not in the history.
+var ErrRevisionNotFound = errors.New("revision not found")
+
+// revisionStore holds the history of applied settings.
+type revisionStore struct {
+	mu      sync.Mutex
+	history *v1.RevisionList
+}
+
+func newRevisionStore() *revisionStore {
+	return &revisionStore{}
+}
+
+// load returns the history, reading it from RevisionsFileName on first use. The caller must hold s.mu.
+func (s *revisionStore) load() *v1.RevisionList {
+	if s.history == nil {
+		s.history = &v1.RevisionList{}
+		if _, err := readProtoFile(RevisionsFileName, s.history); err != nil {
+			log.Println("revision history could not be read: ", err)
+		}
+	}
+	return s.history
+}
+
+// find returns the revision with the given number, or nil. The caller must hold s.mu.
+func (s *revisionStore) find(number uint64) *v1.Revision {
+	for _, revision := range s.load().Revisions {
+		if revision.Number == number {
+			return revision
+		}
+	}
+	return nil
+}
+
+// CommitDesiredState saves the successfully applied settings as the desired state, with replace it replaces the
+// desired state with them, and records a revision of exactly the resulting state. Both happen under the lock of the
+// history, so applies on other devices can not record their change in this revision. A desired state, which could
+// not be written, is logged and still recorded. The desired state of a change, which has to be confirmed, is saved
+// by ConfirmChange.
+func (nc *NetworkConfigurator) CommitDesiredState(newSettings *v1.NetworkSettings, replace bool, caller string, rollbackOf uint64) (*v1.Revision, error) {
+	nc.revisions.mu.Lock()
+	defer nc.revisions.mu.Unlock()
+
+	desired := nc.deferDesiredState(newSettings, replace)
+	if desired == nil {
+		save := nc.SaveDesiredState
+		if replace {
+			save = nc.ResetDesiredState
+		}
+		var err error
+		if desired, err = save(newSettings); err != nil {
+			log.Println(err)
+		}
+	}
+	return nc.recordRevision(desired, newSettings, caller, rollbackOf)
+}
+
+// recordRevision adds a revision for a successful apply to the history. The revision holds the given desired state
+// and the options of the apply. The caller must hold nc.revisions.mu.
+func (nc *NetworkConfigurator) recordRevision(desired, newSettings *v1.NetworkSettings, caller string, rollbackOf uint64) (*v1.Revision, error) {
+	settings := desired
+	// The options only apply to this change, e.g. the permission to change the management interface is not stored.
+	settings.Options = nil
+	if options := newSettings.GetOptions(); options != nil {
+		settings.Options = &v1.ApplyOptions{ForeignProfiles: options.ForeignProfiles, Comment: options.Comment}
+	}
+
+	history := nc.revisions.load()
+	revision := &v1.Revision{
+		Number:     1,
+		Timestamp:  time.Now().Unix(),
+		Caller:     caller,
+		Comment:    newSettings.GetOptions().GetComment(),
+		Settings:   settings,
+		RollbackOf: rollbackOf,
+	}
+	if count := len(history.Revisions); count > 0 {
+		revision.Number = history.Revisions[count-1].Number + 1
+	}
+
+	revisions := append(history.Revisions, revision)
+	if len(revisions) > maxRevisions {
+		revisions = revisions[len(revisions)-maxRevisions:]
+	}
+	updated := &v1.RevisionList{Revisions: revisions}
+	if err := writeProtoFile(updated, RevisionsFileName); err != nil {
+		return nil, fmt.Errorf("failed to write revision history: %w", err)
+	}
+	nc.revisions.history = updated
+	log.Printf("revision %d recorded for %v", revision.Number, caller)
+	return revision, nil
+}
+
+// ListRevisions returns the revisions of the history without their settings, the oldest first.
+func (nc *NetworkConfigurator) ListRevisions() *v1.RevisionList {
+	nc.revisions.mu.Lock()
+	defer nc.revisions.mu.Unlock()
+
+	list := &v1.RevisionList{}
+	for _, revision := range nc.revisions.load().Revisions {
+		summary := proto.Clone(revision).(*v1.Revision)
+		summary.Settings = nil
+		list.Revisions = append(list.Revisions, summary)
+	}
+	return list
+}
+
+// GetRevision returns the revision with the given number, or ErrRevisionNotFound.
+func (nc *NetworkConfigurator) GetRevision(number uint64) (*v1.Revision, error) {
+	nc.revisions.mu.Lock()
+	defer nc.revisions.mu.Unlock()
+
+	revision := nc.revisions.find(number)
+	if revision == nil {
+		return nil, fmt.Errorf("%w: %d", ErrRevisionNotFound, number)
+	}
+	return proto.Clone(revision).(*v1.Revision), nil
+}
+
+// RollbackSettings returns the settings of the revision with the given number to apply them again. A revision holds
+// the whole desired state, so bonds and VLANs of an interface, which are desired now, but not in the revision, are
+// removed by an empty list.
+func (nc *NetworkConfigurator) RollbackSettings(number uint64) (*v1.NetworkSettings, error) {
+	revision, err := nc.GetRevision(number)
+	if err != nil {
+		return nil, err
+	}
+	settings := revision.GetSettings()
+	if settings == nil {
+		settings = &v1.NetworkSettings{}
+	}
+	desired := nc.DesiredState()
+	if settings.Bonds == nil && desired.GetBonds() != nil {
+		settings.Bonds = &v1.BondList{}
+	}
+	vlans := make(map[string]bool)
+	for _, element := range desired.GetInterfaces() {
+		vlans[desiredKey(element)] = element.Vlans != nil
+	}
+	for _, element := range settings.Interfaces {
+		if element.Vlans == nil && vlans[desiredKey(element)] {
+			element.Vlans = &v1.Interface_VlanList{}
+		}
+	}
+	return settings, nil
+}
+
+// DiffRevisions returns the differences of the interface settings and the label map between two revisions.
+func (nc *NetworkConfigurator) DiffRevisions(from, to uint64) (*v1.RevisionDiff, error) {
+	fromRevision, err := nc.GetRevision(from)
+	if err != nil {
+		return nil, err
+	}
+	toRevision, err := nc.GetRevision(to)
+	if err != nil {
+		return nil, err
+	}
+
+	diff := &v1.RevisionDiff{From: from, To: to}
+	diff.Changes = diffSettings(fromRevision.Settings, toRevision.Settings)
+	return diff, nil
+}
+
+// diffSettings returns the changes between two settings. Interfaces are matched by their desired state key.
+func diffSettings(from, to *v1.NetworkSettings) []*v1.RevisionChange {
+	var changes []*v1.RevisionChange
+
+	fromInterfaces := make(map[string]*v1.Interface)
+	for _, element := range from.GetInterfaces() {
+		fromInterfaces[desiredKey(element)] = element
+	}
+	toInterfaces := make(map[string]*v1.Interface)
+	for _, element := range to.GetInterfaces() {
+		toInterfaces[desiredKey(element)] = element
+	}
+
+	for _, key := range sortedInterfaceKeys(fromInterfaces, toInterfaces) {
+		fromInterface, toInterface := fromInterfaces[key], toInterfaces[key]
+		switch {
+		case fromInterface == nil:
+			changes = append(changes, &v1.RevisionChange{Interface: key, To: prototext.MarshalOptions{}.Format(toInterface)})
+		case toInterface == nil:
+			changes = append(changes, &v1.RevisionChange{Interface: key, From: prototext.MarshalOptions{}.Format(fromInterface)})
+		default:
+			changes = append(changes, diffMessages(key, "", fromInterface.ProtoReflect(), toInterface.ProtoReflect())...)
+		}
+	}
+
+	changes = append(changes, diffBonds(from.GetBonds().GetInterfaces(), to.GetBonds().GetInterfaces())...)
+	changes = append(changes, diffMaps("", "LabelMap", from.GetLabelMap(), to.GetLabelMap())...)
+	return changes
+}
+
+// diffBonds returns the changes between two bond lists. Bonds are matched by their name.
+func diffBonds(from, to []*v1.Bond) []*v1.RevisionChange {
+	var changes []*v1.RevisionChange
+	fromBonds := make(map[string]*v1.Bond)
+	for _, bond := range from {
+		fromBonds[bond.GetName()] = bond
+	}
+	toBonds := make(map[string]*v1.Bond)
+	names := make(map[string]string)
+	for _, bond := range to {
+		toBonds[bond.GetName()] = bond
+		names[bond.GetName()] = bond.GetName()
+	}
+	for name := range fromBonds {
+		names[name] = name
+	}
+
+	for _, name := range sortedKeys(names) {
+		fromBond, toBond := fromBonds[name], toBonds[name]
+		switch {
+		case fromBond == nil:
+			changes = append(changes, &v1.RevisionChange{Interface: name, To: prototext.MarshalOptions{}.Format(toBond)})
+		case toBond == nil:
+			changes = append(changes, &v1.RevisionChange{Interface: name, From: prototext.MarshalOptions{}.Format(fromBond)})
+		default:
+			changes = append(changes, diffMessages(name, "", fromBond.ProtoReflect(), toBond.ProtoReflect())...)
+		}
+	}
+	return changes
+}
+
+func sortedInterfaceKeys(first, second map[string]*v1.Interface) []string {
+	seen := make(map[string]string)
+	for key := range first {
+		seen[key] = key
+	}
+	for key := range second {
+		seen[key] = key
+	}
+	return sortedKeys(seen)
+}
+
+// diffMessages returns the changed fields of two messages of the same type. Nested messages are compared
+// field by field, the paths of their fields are prefixed with the field name of the message.
+func diffMessages(key, prefix string, from, to protoreflect.Message) []*v1.RevisionChange {
+	var changes []*v1.RevisionChange
+	fields := from.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+		path := prefix + string(field.Name())
+
+		switch {
+		case field.IsMap():
+			changes = append(changes, diffMaps(key, path, mapValues(from.Get(field).Map()), mapValues(to.Get(field).Map()))...)
+		case field.Message() != nil && !field.IsList():
+			changes = append(changes, diffMessages(key, path+".", from.Get(field).Message(), to.Get(field).Message())...)
+		default:
+			fromValue, toValue := formatValue(field, from.Get(field)), formatValue(field, to.Get(field))
+			if fromValue != toValue {
+				changes = append(changes, &v1.RevisionChange{Interface: key, Field: path, From: fromValue, To: toValue})
+			}
+		}
+	}
+	return changes
+}
+
+// diffMaps returns the changed entries of two string maps.
+func diffMaps(key, path string, from, to map[string]string) []*v1.RevisionChange {
+	var changes []*v1.RevisionChange
+	all := make(map[string]string)
+	for entry := range from {
+		all[entry] = entry
+	}
+	for entry := range to {
+		all[entry] = entry
+	}
+	for _, entry := range sortedKeys(all) {
+		if from[entry] != to[entry] {
+			changes = append(changes, &v1.RevisionChange{Interface: key, Field: path + "." + entry, From: from[entry], To: to[entry]})
+		}
+	}
+	return changes
+}
+
+func mapValues(values protoreflect.Map) map[string]string {
+	result := make(map[string]string)
+	values.Range(func(key protoreflect.MapKey, value protoreflect.Value) bool {
+		result[key.String()] = value.String()
+		return true
+	})
+	return result
+}
+
+// formatValue returns the value as a string, enums by their name. Unset scalars result in an empty string.
+func formatValue(field protoreflect.FieldDescriptor, value protoreflect.Value) string {
+	if field.IsList() {
+		var values []string
+		for i := 0; i < value.List().Len(); i++ {
+			item := value.List().Get(i)
+			if field.Message() != nil {
+				values = append(values, prototext.MarshalOptions{}.Format(item.Message().Interface()))
+			} else {
+				values = append(values, item.String())
+			}
+		}
+		if len(values) == 0 {
+			return ""
+		}
+		return fmt.Sprint(values)
+	}
+	if field.Enum() != nil {
+		if enumValue := field.Enum().Values().ByNumber(value.Enum()); enumValue != nil {
+			return string(enumValue.Name())
+		}
+	}
+	if field.Kind() == protoreflect.BoolKind && !value.Bool() {
+		return ""
+	}
+	return value.String()
+}
@@ -0,0 +1,214 @@
+/*
+ * Copyright © Siemens 2025. ALL RIGHTS RESERVED.
+ * Licensed under the MIT license
+ * See LICENSE file in the top-level directory
+ */
+
+package networking
+
+import (
+	"errors"
+	v1 "networkservice/api/siemens_iedge_dmapi_v1"
+	"reflect"
+	"testing"
+
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+// getRevisionConfigurator returns a configurator with an empty revision history and the given desired state.
+func getRevisionConfigurator(desired ...*v1.Interface) *NetworkConfigurator {
+	nc := getReconcileConfigurator(desired...)
+	nc.revisions = newRevisionStore()
+	nc.revisions.history = &v1.RevisionList{}
+	return nc
+}
+
+func Test_CommitDesiredState_KeepsBoundedHistory(t *testing.T) {
+	nc := getRevisionConfigurator(&v1.Interface{MacAddress: "00:0A:95:9D:68:16", DHCP: Enabled})
+	var written *v1.RevisionList
+	patches := gomonkey.ApplyFunc(writeProtoFile, func(message proto.Message, _ string) error {
+		if history, ok := message.(*v1.RevisionList); ok {
+			written = history
+		}
+		return nil
+	})
+	defer patches.Reset()
+
+	applied := &v1.NetworkSettings{
+		Interfaces: []*v1.Interface{{MacAddress: "00:0A:95:9D:68:16", DHCP: Enabled}},
+		Options: &v1.ApplyOptions{ForeignProfiles: v1.ApplyOptions_LEAVE, Comment: "maintenance", AllowManagementChange: true,
+			ConfirmTimeout: 30, Checks: []*v1.ConnectivityCheck{{Type: v1.ConnectivityCheck_DNS, Target: "example.com"}}},
+	}
+	for i := 0; i < maxRevisions+2; i++ {
+		_, err := nc.CommitDesiredState(applied, false, "unix:@", 0)
+		assert.NoError(t, err)
+	}
+	revision, err := nc.CommitDesiredState(applied, false, "operator", 7)
+
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(maxRevisions+3), revision.Number)
+	assert.Equal(t, "operator", revision.Caller)
+	assert.Equal(t, "maintenance", revision.Comment)
+	assert.Equal(t, uint64(7), revision.RollbackOf)
+	assert.NotZero(t, revision.Timestamp)
+	assert.Equal(t, &v1.ApplyOptions{ForeignProfiles: v1.ApplyOptions_LEAVE, Comment: "maintenance"}, revision.Settings.Options,
+		"Options of the change itself should not be stored")
+	assert.True(t, proto.Equal(nc.DesiredState().Interfaces[0], revision.Settings.Interfaces[0]))
+	assert.Len(t, written.Revisions, maxRevisions, "Only the latest revisions should be kept")
+	assert.Equal(t, uint64(4), written.Revisions[0].Number)
+}
+
+func Test_CommitDesiredState_RecordsSavedState(t *testing.T) {
+	nc := getRevisionConfigurator()
+	saved := &v1.NetworkSettings{Interfaces: []*v1.Interface{
+		{MacAddress: "00:0A:95:9D:68:16", DHCP: Enabled},
+		{MacAddress: "00:0A:95:9D:68:17", DHCP: Enabled},
+	}}
+	patches := gomonkey.ApplyMethod(reflect.TypeOf(nc), "SaveDesiredState", func(_ *NetworkConfigurator, _ *v1.NetworkSettings) (*v1.NetworkSettings, error) {
+		return proto.Clone(saved).(*v1.NetworkSettings), nil
+	})
+	defer patches.Reset()
+	patches.ApplyMethod(reflect.TypeOf(nc), "DesiredState", func(_ *NetworkConfigurator) *v1.NetworkSettings {
+		t.Error("The desired state should not be read again, it may contain the change of another apply")
+		return nil
+	})
+	patches.ApplyFunc(writeProtoFile, func(_ proto.Message, _ string) error {
+		return nil
+	})
+
+	revision, err := nc.CommitDesiredState(&v1.NetworkSettings{Interfaces: saved.Interfaces[1:]}, false, "operator", 0)
+
+	assert.NoError(t, err)
+	assert.True(t, proto.Equal(saved, revision.Settings), "The revision should hold the desired state saved by the apply")
+}
+
+func Test_CommitDesiredState_ReturnsWriteError(t *testing.T) {
+	nc := getRevisionConfigurator()
+	patches := gomonkey.ApplyFunc(writeProtoFile, func(_ proto.Message, _ string) error {
+		return errors.New("disk full")
+	})
+	defer patches.Reset()
+
+	revision, err := nc.CommitDesiredState(&v1.NetworkSettings{}, false, "operator", 0)
+
+	assert.Error(t, err)
+	assert.Nil(t, revision)
+	assert.Empty(t, nc.ListRevisions().Revisions, "The history should not change when it can not be written")
+}
+
+func Test_ListAndGetRevision(t *testing.T) {
+	nc := getRevisionConfigurator()
+	settings := &v1.NetworkSettings{Interfaces: []*v1.Interface{{MacAddress: "00:0A:95:9D:68:16", DHCP: Enabled}}}
+	nc.revisions.history.Revisions = []*v1.Revision{{Number: 1, Settings: settings}, {Number: 2, Settings: settings}}
+
+	list := nc.ListRevisions()
+	assert.Len(t, list.Revisions, 2)
+	assert.Nil(t, list.Revisions[0].Settings, "ListRevisions should not return the settings")
+
+	revision, err := nc.GetRevision(2)
+	assert.NoError(t, err)
+	assert.True(t, proto.Equal(settings, revision.Settings))
+	revision.Settings.Interfaces[0].DHCP = Disabled
+	assert.Equal(t, Enabled, settings.Interfaces[0].DHCP, "GetRevision should return a copy")
+
+	_, err = nc.GetRevision(3)
+	assert.True(t, errors.Is(err, ErrRevisionNotFound))
+	_, err = nc.DiffRevisions(1, 3)
+	assert.True(t, errors.Is(err, ErrRevisionNotFound))
+}
+
+func Test_RollbackSettings_RemovesBondsAddedLater(t *testing.T) {
+	nc := getRevisionConfigurator()
+	nc.reconciler.desired.Bonds = &v1.BondList{Interfaces: []*v1.Bond{{Name: "bond0", Members: []string{"X1", "X2"}}}}
+	nc.revisions.history.Revisions = []*v1.Revision{
+		{Number: 1, Settings: &v1.NetworkSettings{Interfaces: []*v1.Interface{{Label: "X1", DHCP: Enabled}}}},
+		{Number: 2, Settings: &v1.NetworkSettings{Bonds: &v1.BondList{Interfaces: []*v1.Bond{{Name: "bond1", Members: []string{"X1"}}}}}},
+	}
+
+	settings, err := nc.RollbackSettings(1)
+	assert.NoError(t, err)
+	assert.NotNil(t, settings.Bonds, "Bonds added after the revision should be removed by the rollback")
+	assert.Empty(t, settings.Bonds.Interfaces)
+
+	settings, err = nc.RollbackSettings(2)
+	assert.NoError(t, err)
+	assert.Equal(t, "bond1", settings.Bonds.Interfaces[0].Name)
+
+	nc.reconciler.desired.Bonds = nil
+	settings, _ = nc.RollbackSettings(1)
+	assert.Nil(t, settings.Bonds, "Bonds should not be given without bonds in the desired state")
+
+	_, err = nc.RollbackSettings(3)
+	assert.True(t, errors.Is(err, ErrRevisionNotFound))
+}
+
+func Test_RollbackSettings_RemovesVlansAddedLater(t *testing.T) {
+	nc := getRevisionConfigurator(
+		&v1.Interface{MacAddress: "00:0A:95:9D:68:16", DHCP: Enabled, Vlans: &v1.Interface_VlanList{Interfaces: []*v1.Interface_VlanConf{{ID: 100}}}},
+		&v1.Interface{MacAddress: "00:0A:95:9D:68:17", DHCP: Enabled},
+	)
+	nc.revisions.history.Revisions = []*v1.Revision{{Number: 1, Settings: &v1.NetworkSettings{Interfaces: []*v1.Interface{
+		{MacAddress: "00:0A:95:9D:68:16", DHCP: Disabled},
+		{MacAddress: "00:0A:95:9D:68:17", DHCP: Disabled},
+	}}}}
+
+	settings, err := nc.RollbackSettings(1)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, settings.Interfaces[0].Vlans, "VLANs added after the revision should be removed by the rollback")
+	assert.Empty(t, settings.Interfaces[0].Vlans.Interfaces)
+	assert.Nil(t, settings.Interfaces[1].Vlans, "VLANs should not be given for interfaces without desired VLANs")
+}
+
+func Test_diffSettings(t *testing.T) {
+	from := &v1.NetworkSettings{
+		Interfaces: []*v1.Interface{
+			{MacAddress: "00:0A:95:9D:68:16", DHCP: Disabled, Static: getMockInterfaceStaticConf()},
+			{MacAddress: "00:0A:95:9D:68:17", DHCP: Enabled},
+		},
+		LabelMap: map[string]string{"X1": "ETH0"},
+	}
+	to := &v1.NetworkSettings{
+		Interfaces: []*v1.Interface{
+			{MacAddress: "00:0A:95:9D:68:16", DHCP: Disabled, Static: &v1.Interface_StaticConf{IPv4: "192.168.1.2", NetMask: "255.255.255.0", Gateway: "192.168.1.254"},
+				Reconcile: v1.Interface_ENFORCE},
+			{Label: "X2", DHCP: Enabled},
+		},
+		LabelMap: map[string]string{"X1": "ETH0", "X2": "ETH1"},
+	}
+
+	changes := diffSettings(from, to)
+
+	assert.Len(t, changes, 5)
+	assert.Equal(t, &v1.RevisionChange{Interface: "00:0A:95:9D:68:16", Field: "Static.IPv4", From: "192.168.1.1", To: "192.168.1.2"},
+		changes[0])
+	assert.Equal(t, &v1.RevisionChange{Interface: "00:0A:95:9D:68:16", Field: "Reconcile", From: "OBSERVE", To: "ENFORCE"}, changes[1])
+	assert.Equal(t, "00:0A:95:9D:68:17", changes[2].Interface)
+	assert.NotEmpty(t, changes[2].From)
+	assert.Empty(t, changes[2].To, "A removed interface should have no To value")
+	assert.Equal(t, "LABEL:X2", changes[3].Interface)
+	assert.Empty(t, changes[3].From, "An added interface should have no From value")
+	assert.Equal(t, &v1.RevisionChange{Field: "LabelMap.X2", To: "ETH1"}, changes[4])
+
+	assert.Empty(t, diffSettings(from, proto.Clone(from).(*v1.NetworkSettings)))
+}
+
+func Test_diffSettings_Bonds(t *testing.T) {
+	from := &v1.NetworkSettings{Bonds: &v1.BondList{Interfaces: []*v1.Bond{
+		{Name: "bond0", Members: []string{"X1", "X2"}, Primary: "X1", DHCP: Enabled},
+		{Name: "bond1", Members: []string{"X3", "X4"}, DHCP: Enabled},
+	}}}
+	to := &v1.NetworkSettings{Bonds: &v1.BondList{Interfaces: []*v1.Bond{
+		{Name: "bond0", Members: []string{"X1", "X2"}, Primary: "X2", DHCP: Enabled},
+	}}}
+
+	changes := diffSettings(from, to)
+
+	assert.Len(t, changes, 2)
+	assert.Equal(t, &v1.RevisionChange{Interface: "bond0", Field: "Primary", From: "X1", To: "X2"}, changes[0])
+	assert.Equal(t, "bond1", changes[1].Interface)
+	assert.NotEmpty(t, changes[1].From)
+	assert.Empty(t, changes[1].To, "A removed bond should have no To value")
+}
@@ -0,0 +1,406 @@
+/*
+ * Copyright © Siemens 2025. ALL RIGHTS RESERVED.
+ * Licensed under the MIT license
+ * See LICENSE file in the top-level directory
+ */
+
+package networking
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"log"
+	v1 "networkservice/api/siemens_iedge_dmapi_v1"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	nm "github.com/Wifx/gonetworkmanager/v2"
+	"github.com/godbus/dbus/v5"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// snapshotDebounceInterval is the quiet time after the last change signal before the snapshot is refreshed.
+	snapshotDebounceInterval = 200 * time.Millisecond
+	// snapshotResyncInterval is the interval of the full refresh, which catches changes without any signal.
+	snapshotResyncInterval = 60 * time.Second
+	// dockerEventsRetryInterval is the wait time before docker events are watched again after docker exited.
+	dockerEventsRetryInterval = 30 * time.Second
+	// nmSettingsObjectPath is the D-Bus path of the NetworkManager settings object.
+	nmSettingsObjectPath = nm.NetworkManagerObjectPath + "/Settings"
+)
+
+// Snapshot is an immutable view of all ethernet typed devices, their connections, routes and labels.
+type Snapshot struct {
+	// Generation is increased whenever any data of the snapshot changes.
+	Generation uint64
+	devices    []*deviceSnapshot
+	labelMap   map[string]string
+	labelMod   time.Time
+	bonds      *v1.BondList
+	bondPaths  map[dbus.ObjectPath]bool
+}
+
+// deviceSnapshot holds the cached state of a single ethernet device.
+type deviceSnapshot struct {
+	path            dbus.ObjectPath
+	device          nm.DeviceWired
+	iface           *v1.Interface
+	hasGatewayRoute bool
+	gatewayMetric   uint8
+	relatedPaths    map[dbus.ObjectPath]bool
+	generation      uint64
+}
+
+// snapshotChanges collects the parts of the snapshot which have to be refreshed.
+type snapshotChanges struct {
+	all     bool
+	l2      bool
+	bonds   bool
+	devices map[dbus.ObjectPath]bool
+}
+
+// snapshotCache keeps the current Snapshot up to date from NetworkManager signals and docker events.
+type snapshotCache struct {
+	current    atomic.Pointer[Snapshot]
+	refreshMu  sync.Mutex
+	invalidate chan struct{}
+}
+
+// StartSnapshotCache builds the initial snapshot and keeps it up to date until the given context is done.
+// All reads of the NetworkConfigurator are served from the snapshot afterwards.
+func (nc *NetworkConfigurator) StartSnapshotCache(ctx context.Context) error {
+	if nc.gnm == nil {
+		return errors.New("network manager is not available")
+	}
+	if nc.cache != nil {
+		return errors.New("snapshot cache is already started")
+	}
+
+	cache := &snapshotCache{invalidate: make(chan struct{}, 1)}
+	signals := nc.gnm.Subscribe()
+	cache.current.Store(nc.buildSnapshot(0))
+	nc.cache = cache
+
+	dockerEvents := make(chan struct{}, 1)
+	go watchDockerNetworkEvents(ctx, dockerEvents)
+	go nc.runSnapshotCache(ctx, signals, dockerEvents)
+
+	log.Println("snapshot cache started")
+	return nil
+}
+
+// Snapshot returns the current snapshot, or nil when the snapshot cache is not started.
+func (nc *NetworkConfigurator) Snapshot() *Snapshot {
+	if nc.cache == nil {
+		return nil
+	}
+	return nc.cache.current.Load()
+}
+
+// InvalidateSnapshot schedules a full refresh of the snapshot, e.g. after the label map file was written.
+func (nc *NetworkConfigurator) InvalidateSnapshot() {
+	if nc.cache == nil {
+		return
+	}
+	select {
+	case nc.cache.invalidate <- struct{}{}:
+	default:
+	}
+}
+
+// runSnapshotCache collects change notifications and refreshes the affected parts of the snapshot.
+func (nc *NetworkConfigurator) runSnapshotCache(ctx context.Context, signals <-chan *dbus.Signal, dockerEvents <-chan struct{}) {
+	debounce := time.NewTimer(snapshotDebounceInterval)
+	debounce.Stop()
+	resync := time.NewTicker(snapshotResyncInterval)
+	defer resync.Stop()
+
+	pending := &snapshotChanges{devices: make(map[dbus.ObjectPath]bool)}
+	for {
+		select {
+		case <-ctx.Done():
+			nc.gnm.Unsubscribe()
+			return
+		case signal, ok := <-signals:
+			if !ok {
+				log.Println("NetworkManager signal channel closed, snapshot is refreshed periodically only")
+				signals = nil
+				continue
+			}
+			nc.cache.current.Load().classifySignal(signal, pending)
+		case <-dockerEvents:
+			pending.l2 = true
+		case <-nc.cache.invalidate:
+			pending.all = true
+		case <-resync.C:
+			pending.all = true
+		case <-debounce.C:
+			nc.refreshSnapshot(pending)
+			pending = &snapshotChanges{devices: make(map[dbus.ObjectPath]bool)}
+			continue
+		}
+		debounce.Reset(snapshotDebounceInterval)
+	}
+}
+
+// classifySignal marks the parts of the snapshot which are affected by the given NetworkManager signal.
+func (s *Snapshot) classifySignal(signal *dbus.Signal, pending *snapshotChanges) {
+	if signal == nil {
+		return
+	}
+	for _, device := range s.devices {
+		if device.path == signal.Path || device.relatedPaths[signal.Path] {
+			pending.devices[device.path] = true
+			return
+		}
+	}
+	if s.bondPaths[signal.Path] {
+		pending.bonds = true
+		return
+	}
+
+	path := string(signal.Path)
+	switch {
+	case path == nm.NetworkManagerObjectPath, path == nmSettingsObjectPath:
+		// devices or connection profiles are added or removed
+		pending.all = true
+	case strings.HasPrefix(path, nm.NetworkManagerObjectPath+"/IP4Config/"),
+		strings.HasPrefix(path, nm.NetworkManagerObjectPath+"/ActiveConnection/"):
+		// runtime configuration objects are not tracked by path, the owning device is unknown
+		pending.all = true
+	}
+}
+
+// refreshSnapshot publishes a new snapshot containing the given changes. The generation is only increased
+// when the refreshed data differs from the current snapshot.
+func (nc *NetworkConfigurator) refreshSnapshot(changes *snapshotChanges) {
+	nc.cache.refreshMu.Lock()
+	defer nc.cache.refreshMu.Unlock()
+
+	current := nc.cache.current.Load()
+	var next *Snapshot
+	if changes.all {
+		next = nc.buildSnapshot(current.Generation + 1)
+	} else {
+		next = current.withRefreshedDevices(nc, changes)
+	}
+
+	if next.equal(current) {
+		return
+	}
+	next.inheritGenerations(current)
+	nc.cache.current.Store(next)
+	log.Printf("snapshot refreshed, generation: %d", next.Generation)
+	nc.notifyReconciler()
+}
+
+// buildSnapshot reads all devices, connections, routes and labels.
+func (nc *NetworkConfigurator) buildSnapshot(generation uint64) *Snapshot {
+	snapshot := &Snapshot{Generation: generation}
+	snapshot.labelMap, _ = readMapFromFile(LabelMapFileName)
+	if info, err := os.Stat(LabelMapFileName); err == nil {
+		snapshot.labelMod = info.ModTime()
+	}
+
+	for _, device := range nc.getAllEthernetDevices() {
+		snapshot.devices = append(snapshot.devices, nc.snapshotDevice(device, generation))
+	}
+	snapshot.bonds, snapshot.bondPaths = readBondsWithPaths()
+	return snapshot
+}
+
+// withRefreshedDevices returns a copy of the snapshot, where the given devices and L2 configurations are read again.
+// The bonds are read again with any device, the members of a bond are known from their own profiles.
+func (s *Snapshot) withRefreshedDevices(nc *NetworkConfigurator, changes *snapshotChanges) *Snapshot {
+	next := &Snapshot{Generation: s.Generation + 1, labelMap: s.labelMap, labelMod: s.labelMod, bonds: s.bonds, bondPaths: s.bondPaths}
+	if changes.bonds || len(changes.devices) > 0 {
+		next.bonds, next.bondPaths = readBondsWithPaths()
+	}
+	for _, device := range s.devices {
+		switch {
+		case changes.devices[device.path]:
+			next.devices = append(next.devices, nc.snapshotDevice(device.device, next.Generation))
+		case changes.l2:
+			refreshed := *device
+			refreshed.iface = proto.Clone(device.iface).(*v1.Interface)
+			refreshed.iface.L2Conf = dockerNetworkGetMacvlanConnection(device.iface.InterfaceName)
+			refreshed.generation = next.Generation
+			next.devices = append(next.devices, &refreshed)
+		default:
+			next.devices = append(next.devices, device)
+		}
+	}
+	return next
+}
+
+// snapshotDevice reads the complete state of a single device.
+func (nc *NetworkConfigurator) snapshotDevice(device nm.DeviceWired, generation uint64) *deviceSnapshot {
+	entry := &deviceSnapshot{
+		path:         device.GetPath(),
+		device:       device,
+		iface:        DBusToProto(device, nil),
+		relatedPaths: make(map[dbus.ObjectPath]bool),
+		generation:   generation,
+	}
+
+	if _, metric, err := nc.getDeviceGatewayMACAndMetric(device); err == nil {
+		entry.hasGatewayRoute = true
+		entry.gatewayMetric = metric
+	}
+
+	if active, err := device.GetPropertyActiveConnection(); err == nil && active != nil {
+		entry.relatedPaths[active.GetPath()] = true
+	}
+	for _, connection := range listConnections(device) {
+		entry.relatedPaths[connection.GetPath()] = true
+	}
+	managed, foreign, _ := vlanConnections(entry.iface.InterfaceName)
+	for _, connection := range append(managed, foreign...) {
+		entry.relatedPaths[connection.GetPath()] = true
+	}
+	return entry
+}
+
+// equal reports whether both snapshots hold the same data, regardless of their generations.
+func (s *Snapshot) equal(other *Snapshot) bool {
+	if other == nil || len(s.devices) != len(other.devices) || !s.labelMod.Equal(other.labelMod) || !proto.Equal(s.bonds, other.bonds) {
+		return false
+	}
+	for i, device := range s.devices {
+		if !device.equal(other.devices[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// inheritGenerations keeps the generation of every device which did not change compared to the previous snapshot.
+func (s *Snapshot) inheritGenerations(previous *Snapshot) {
+	for _, device := range s.devices {
+		for _, old := range previous.devices {
+			if old.path == device.path && old.equal(device) {
+				device.generation = old.generation
+			}
+		}
+	}
+}
+
+func (d *deviceSnapshot) equal(other *deviceSnapshot) bool {
+	return d.path == other.path &&
+		d.hasGatewayRoute == other.hasGatewayRoute &&
+		d.gatewayMetric == other.gatewayMetric &&
+		proto.Equal(d.iface, other.iface)
+}
+
+// gatewayIndex returns the index of the device with the lowest default route metric, or -1.
+func (s *Snapshot) gatewayIndex() int {
+	var lowestMetric uint8 = MaxMetricValue
+	index := -1
+	for i, device := range s.devices {
+		if device.hasGatewayRoute && device.gatewayMetric < lowestMetric {
+			lowestMetric = device.gatewayMetric
+			index = i
+		}
+	}
+	return index
+}
+
+// interfaceAt returns a copy of the cached interface at the given index, reduced to the fields selected by the mask.
+func (s *Snapshot) interfaceAt(index int, mask *InterfaceMask) *v1.Interface {
+	device := s.devices[index]
+	iface := proto.Clone(device.iface).(*v1.Interface)
+	iface.GatewayInterface = index == s.gatewayIndex()
+	iface.Generation = device.generation
+	mask.Prune(iface)
+	return iface
+}
+
+// Interfaces returns all cached ethernet interfaces.
+func (s *Snapshot) Interfaces(mask *InterfaceMask) []*v1.Interface {
+	var interfaces []*v1.Interface
+	for i := range s.devices {
+		interfaces = append(interfaces, s.interfaceAt(i, mask))
+	}
+	return interfaces
+}
+
+// Bonds returns the cached bonds, or nil if there are none.
+func (s *Snapshot) Bonds() *v1.BondList {
+	if s.bonds == nil {
+		return nil
+	}
+	return proto.Clone(s.bonds).(*v1.BondList)
+}
+
+// InterfaceWithMac returns the cached interface with the given MAC address, or nil.
+func (s *Snapshot) InterfaceWithMac(mac string, mask *InterfaceMask) *v1.Interface {
+	for i, device := range s.devices {
+		if strings.EqualFold(device.iface.MacAddress, mac) {
+			return s.interfaceAt(i, mask)
+		}
+	}
+	return nil
+}
+
+// InterfaceWithLabel returns the cached interface, which is mapped to the given label, or nil.
+func (s *Snapshot) InterfaceWithLabel(label string, mask *InterfaceMask) *v1.Interface {
+	interfaceName := s.labelMap[strings.ToUpper(label)]
+	if interfaceName == "" {
+		return nil
+	}
+	for i, device := range s.devices {
+		if strings.EqualFold(device.iface.InterfaceName, interfaceName) {
+			return s.interfaceAt(i, mask)
+		}
+	}
+	return nil
+}
+
+// IsGatewayInterface checks if the cached interface with the given MAC address is the gateway interface.
+func (s *Snapshot) IsGatewayInterface(mac string) bool {
+	index := s.gatewayIndex()
+	return index >= 0 && strings.EqualFold(s.devices[index].iface.MacAddress, mac)
+}
+
+// watchDockerNetworkEvents notifies about created, removed or changed docker networks until the context is done.
+func watchDockerNetworkEvents(ctx context.Context, events chan<- struct{}) {
+	for ctx.Err() == nil {
+		cmd := execCommand("/bin/bash", "-c", "docker events --filter type=network --format \"{{.Action}}\"")
+		stdout, err := cmd.StdoutPipe()
+		if err == nil {
+			err = cmd.Start()
+		}
+		if err != nil {
+			log.Println("docker events : ", err)
+		} else {
+			done := make(chan struct{})
+			go func() {
+				select {
+				case <-ctx.Done():
+					_ = cmd.Process.Kill()
+				case <-done:
+				}
+			}()
+
+			scanner := bufio.NewScanner(stdout)
+			for scanner.Scan() {
+				select {
+				case events <- struct{}{}:
+				default:
+				}
+			}
+			_ = cmd.Wait()
+			close(done)
+		}
+
+		select {
+		case <-ctx.Done():
+		case <-time.After(dockerEventsRetryInterval):
+		}
+	}
+}
@@ -0,0 +1,246 @@
+/*
+ * Copyright © Siemens 2025. ALL RIGHTS RESERVED.
+ * Licensed under the MIT license
+ * See LICENSE file in the top-level directory
+ */
+
+package networking
+
+import (
+	"context"
+	v1 "networkservice/api/siemens_iedge_dmapi_v1"
+	mockgnm "networkservice/internal/networking/mocks/gonetworkmanager"
+	"reflect"
+	"testing"
+
+	nm "github.com/Wifx/gonetworkmanager/v2"
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/godbus/dbus/v5"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+const (
+	testDevicePath1 = dbus.ObjectPath("/org/freedesktop/NetworkManager/Devices/1")
+	testDevicePath2 = dbus.ObjectPath("/org/freedesktop/NetworkManager/Devices/2")
+)
+
+func getMockSnapshot() *Snapshot {
+	return &Snapshot{
+		Generation: 7,
+		labelMap:   map[string]string{"X1": "ETH0", "X2": "ETH1"},
+		devices: []*deviceSnapshot{
+			{
+				path:            testDevicePath1,
+				iface:           &v1.Interface{MacAddress: "00:0A:95:9D:68:16", InterfaceName: "eth0", DHCP: Enabled},
+				hasGatewayRoute: true,
+				gatewayMetric:   100,
+				relatedPaths:    map[dbus.ObjectPath]bool{"/org/freedesktop/NetworkManager/Settings/3": true},
+				generation:      5,
+			},
+			{
+				path:            testDevicePath2,
+				iface:           &v1.Interface{MacAddress: "00:0A:95:9D:68:17", InterfaceName: "eth1", DHCP: Disabled},
+				hasGatewayRoute: true,
+				gatewayMetric:   1,
+				relatedPaths:    map[dbus.ObjectPath]bool{},
+				generation:      7,
+			},
+		},
+	}
+}
+
+func getSnapshotConfigurator(snapshot *Snapshot) *NetworkConfigurator {
+	cache := &snapshotCache{invalidate: make(chan struct{}, 1)}
+	cache.current.Store(snapshot)
+	return &NetworkConfigurator{cache: cache}
+}
+
+func Test_Snapshot_InterfacesMarksGatewayAndGeneration(t *testing.T) {
+	snapshot := getMockSnapshot()
+
+	result := snapshot.Interfaces(nil)
+
+	assert.Equal(t, 2, len(result))
+	assert.False(t, result[0].GatewayInterface)
+	assert.True(t, result[1].GatewayInterface, "The interface with the lowest metric should be the gateway interface")
+	assert.Equal(t, uint64(5), result[0].Generation)
+	assert.Equal(t, uint64(7), result[1].Generation)
+
+	result[0].DHCP = Disabled
+	assert.Equal(t, Enabled, snapshot.devices[0].iface.DHCP, "Interfaces should return copies of the cached data")
+}
+
+func Test_Snapshot_InterfacesAppliesMask(t *testing.T) {
+	mask, _ := NewInterfaceMask(&fieldmaskpb.FieldMask{Paths: []string{"InterfaceName"}})
+
+	result := getMockSnapshot().Interfaces(mask)
+
+	assert.Equal(t, "eth0", result[0].InterfaceName)
+	assert.Empty(t, result[0].MacAddress)
+	assert.False(t, result[1].GatewayInterface)
+}
+
+func Test_Snapshot_InterfaceWithMacAndLabel(t *testing.T) {
+	snapshot := getMockSnapshot()
+
+	assert.Equal(t, "eth1", snapshot.InterfaceWithMac("00:0a:95:9d:68:17", nil).InterfaceName)
+	assert.Nil(t, snapshot.InterfaceWithMac("11:22:33:44:55:66", nil))
+	assert.Equal(t, "eth0", snapshot.InterfaceWithLabel("x1", nil).InterfaceName)
+	assert.Nil(t, snapshot.InterfaceWithLabel("X3", nil))
+	assert.True(t, snapshot.IsGatewayInterface("00:0A:95:9D:68:17"))
+	assert.False(t, snapshot.IsGatewayInterface("00:0A:95:9D:68:16"))
+}
+
+func Test_Snapshot_ClassifySignal(t *testing.T) {
+	snapshot := getMockSnapshot()
+
+	pending := &snapshotChanges{devices: make(map[dbus.ObjectPath]bool)}
+	snapshot.classifySignal(&dbus.Signal{Path: testDevicePath2}, pending)
+	snapshot.classifySignal(&dbus.Signal{Path: "/org/freedesktop/NetworkManager/Settings/3"}, pending)
+	snapshot.classifySignal(&dbus.Signal{Path: "/org/freedesktop/NetworkManager/AccessPoint/1"}, pending)
+
+	assert.False(t, pending.all, "Signals of known objects should not cause a full refresh")
+	assert.Equal(t, map[dbus.ObjectPath]bool{testDevicePath1: true, testDevicePath2: true}, pending.devices)
+
+	snapshot.classifySignal(&dbus.Signal{Path: nmSettingsObjectPath, Name: "org.freedesktop.NetworkManager.Settings.NewConnection"}, pending)
+	assert.True(t, pending.all, "New connection profiles should cause a full refresh")
+}
+
+func Test_Snapshot_ClassifySignalOfBondProfile(t *testing.T) {
+	snapshot := getMockSnapshot()
+	snapshot.bondPaths = map[dbus.ObjectPath]bool{"/org/freedesktop/NetworkManager/Settings/9": true}
+
+	pending := &snapshotChanges{devices: make(map[dbus.ObjectPath]bool)}
+	snapshot.classifySignal(&dbus.Signal{Path: "/org/freedesktop/NetworkManager/Settings/9"}, pending)
+
+	assert.True(t, pending.bonds)
+	assert.False(t, pending.all, "Changed bond profiles should not cause a full refresh")
+	assert.Empty(t, pending.devices)
+}
+
+func Test_refreshSnapshot_ReadsChangedBonds(t *testing.T) {
+	snapshot := getMockSnapshot()
+	nc := getSnapshotConfigurator(snapshot)
+	bonds := &v1.BondList{Interfaces: []*v1.Bond{{Name: "bond0", Members: []string{"X1", "X2"}, DHCP: Enabled}}}
+
+	patches := gomonkey.ApplyFunc(readBondsWithPaths, func() (*v1.BondList, map[dbus.ObjectPath]bool) {
+		return bonds, map[dbus.ObjectPath]bool{"/org/freedesktop/NetworkManager/Settings/9": true}
+	})
+	defer patches.Reset()
+
+	nc.refreshSnapshot(&snapshotChanges{bonds: true, devices: make(map[dbus.ObjectPath]bool)})
+
+	assert.Equal(t, uint64(8), nc.Snapshot().Generation)
+	assert.True(t, proto.Equal(bonds, nc.Snapshot().Bonds()))
+	assert.NotEqual(t, snapshot.ResourceVersion(), nc.Snapshot().ResourceVersion(), "Bonds should be covered by the resource version")
+}
+
+func Test_GetNetworkSettings_ReturnsBondsOnlyIfSelected(t *testing.T) {
+	snapshot := getMockSnapshot()
+	snapshot.bonds = &v1.BondList{Interfaces: []*v1.Bond{{Name: "bond0", Members: []string{"X1", "X2"}, DHCP: Enabled}}}
+	nc := getSnapshotConfigurator(snapshot)
+
+	patches := gomonkey.ApplyFunc(readBondsWithPaths, func() (*v1.BondList, map[dbus.ObjectPath]bool) {
+		t.Error("Bonds should be served from the snapshot")
+		return nil, nil
+	})
+	defer patches.Reset()
+
+	assert.True(t, proto.Equal(snapshot.bonds, nc.GetNetworkSettings(nil).Bonds))
+	assert.Nil(t, nc.GetNetworkSettings(&InterfaceMask{paths: maskTree{FieldDHCP: {}}}).Bonds)
+	assert.NotNil(t, nc.GetNetworkSettings(&InterfaceMask{paths: maskTree{FieldBond: {}}}).Bonds)
+}
+
+func Test_refreshSnapshot_KeepsGenerationWhenNothingChanged(t *testing.T) {
+	snapshot := getMockSnapshot()
+	nc := getSnapshotConfigurator(snapshot)
+
+	patches := gomonkey.NewPatches()
+	defer patches.Reset()
+	patches.ApplyPrivateMethod(reflect.TypeOf(nc), "snapshotDevice", func(_ *NetworkConfigurator, _ nm.DeviceWired, generation uint64) *deviceSnapshot {
+		unchanged := *snapshot.devices[0]
+		unchanged.generation = generation
+		return &unchanged
+	})
+
+	nc.refreshSnapshot(&snapshotChanges{devices: map[dbus.ObjectPath]bool{testDevicePath1: true}})
+
+	assert.Same(t, snapshot, nc.Snapshot(), "The snapshot should not be replaced when nothing changed")
+}
+
+func Test_refreshSnapshot_IncreasesGenerationOfChangedDevice(t *testing.T) {
+	snapshot := getMockSnapshot()
+	nc := getSnapshotConfigurator(snapshot)
+
+	patches := gomonkey.NewPatches()
+	defer patches.Reset()
+	patches.ApplyPrivateMethod(reflect.TypeOf(nc), "snapshotDevice", func(_ *NetworkConfigurator, _ nm.DeviceWired, generation uint64) *deviceSnapshot {
+		changed := *snapshot.devices[0]
+		changed.iface = &v1.Interface{MacAddress: "00:0A:95:9D:68:16", InterfaceName: "eth0", DHCP: Disabled}
+		changed.generation = generation
+		return &changed
+	})
+
+	nc.refreshSnapshot(&snapshotChanges{devices: map[dbus.ObjectPath]bool{testDevicePath1: true}})
+
+	result := nc.GetNetworkSettings(nil)
+	assert.Equal(t, uint64(8), result.Generation)
+	assert.Equal(t, uint64(8), result.Interfaces[0].Generation)
+	assert.Equal(t, Disabled, result.Interfaces[0].DHCP)
+	assert.Equal(t, uint64(7), result.Interfaces[1].Generation, "Unchanged interfaces should keep their generation")
+}
+
+func Test_GetEthernetInterfaces_ServesFromSnapshot(t *testing.T) {
+	nc := getSnapshotConfigurator(getMockSnapshot())
+
+	patches := gomonkey.NewPatches()
+	defer patches.Reset()
+	patches.ApplyPrivateMethod(reflect.TypeOf(nc), "getAllEthernetDevices", func(_ *NetworkConfigurator) []nm.DeviceWired {
+		t.Error("getAllEthernetDevices should not be called when the snapshot is available")
+		return nil
+	})
+
+	result := nc.GetEthernetInterfaces(nil)
+
+	assert.Equal(t, 2, len(result))
+	assert.Equal(t, "eth1", nc.GetInterfaceWithMac("00:0A:95:9D:68:17", nil).InterfaceName)
+	assert.True(t, nc.IsGatewayInterface("00:0A:95:9D:68:17"))
+}
+
+func Test_StartSnapshotCache_ReturnsErrorWithoutNetworkManager(t *testing.T) {
+	nc := &NetworkConfigurator{}
+
+	err := nc.StartSnapshotCache(context.Background())
+
+	assert.Error(t, err)
+	assert.Nil(t, nc.Snapshot())
+}
+
+func Test_StartSnapshotCache_BuildsInitialSnapshot(t *testing.T) {
+	mockNetworkManager := &mockgnm.MockNetworkManager{}
+	signals := make(chan *dbus.Signal)
+	mockNetworkManager.On("Subscribe").Return((<-chan *dbus.Signal)(signals))
+	mockNetworkManager.On("Unsubscribe").Return()
+	nc := NewNetworkConfiguratorWithNM(mockNetworkManager)
+
+	patches := gomonkey.NewPatches()
+	defer patches.Reset()
+	patches.ApplyPrivateMethod(reflect.TypeOf(nc), "getAllEthernetDevices", func(_ *NetworkConfigurator) []nm.DeviceWired {
+		return []nm.DeviceWired{&mockgnm.MockDeviceWired{}}
+	})
+	patches.ApplyPrivateMethod(reflect.TypeOf(nc), "snapshotDevice", func(_ *NetworkConfigurator, _ nm.DeviceWired, generation uint64) *deviceSnapshot {
+		return &deviceSnapshot{path: testDevicePath1, iface: &v1.Interface{InterfaceName: "eth0"}, generation: generation}
+	})
+	patches.ApplyFunc(watchDockerNetworkEvents, func(_ context.Context, _ chan<- struct{}) {})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	err := nc.StartSnapshotCache(ctx)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, nc.Snapshot())
+	assert.Equal(t, "eth0", nc.GetEthernetInterfaces(nil)[0].InterfaceName)
+	assert.Error(t, nc.StartSnapshotCache(ctx), "The snapshot cache should only be started once")
+}
@@ -0,0 +1,165 @@
+/*
+ * Copyright © Siemens 2025. ALL RIGHTS RESERVED.
+ * Licensed under the MIT license
+ * See LICENSE file in the top-level directory
+ */
+
+package networking
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"log"
+	"math/big"
+	"net"
+	v1 "networkservice/api/siemens_iedge_dmapi_v1"
+	"os"
+	"strconv"
+	"text/template"
+)
+
+// ErrInvalidTemplate is returned for templates, which can not be rendered or result in invalid settings.
+var ErrInvalidTemplate = errors.New("invalid template")
+
+// templateFuncs are the functions, which can be used in NetworkSettings templates.
+var templateFuncs = template.FuncMap{
+	"add": func(a, b interface{}) (int64, error) {
+		return templateArithmetic(a, b, func(x, y int64) int64 { return x + y })
+	},
+	"sub": func(a, b interface{}) (int64, error) {
+		return templateArithmetic(a, b, func(x, y int64) int64 { return x - y })
+	},
+	"mul": func(a, b interface{}) (int64, error) {
+		return templateArithmetic(a, b, func(x, y int64) int64 { return x * y })
+	},
+	"ipAdd":       ipAdd,
+	"cidrHost":    cidrHost,
+	"cidrNetmask": cidrNetmask,
+	"cidrPrefix":  cidrPrefix,
+}
+
+// RenderTemplate renders the NetworkSettings template of the request and validates the result like ApplySettings.
+// The variables of the request override the ones of the facts file.
+func (nc *NetworkConfigurator) RenderTemplate(request *v1.TemplateRequest) (*v1.NetworkSettings, error) {
+	variables := readFacts(FactsFileName)
+	for name, value := range request.Variables {
+		variables[name] = value
+	}
+
+	parsed, err := template.New("settings").Option("missingkey=error").Funcs(templateFuncs).Parse(request.Template)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidTemplate, err)
+	}
+	var rendered bytes.Buffer
+	if err := parsed.Execute(&rendered, variables); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidTemplate, err)
+	}
+
+	settings := &v1.NetworkSettings{}
+	if err := decodeDocument(rendered.Bytes(), request.Format, settings); err != nil {
+		return nil, fmt.Errorf("%w: rendered settings can not be parsed: %v", ErrInvalidTemplate, err)
+	}
+	settings.Options = request.Options
+	if _, err := nc.ArePreconditionsOk(settings); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidTemplate, err)
+	}
+	return settings, nil
+}
+
+// readFacts returns the variables of the device from the given facts file, a JSON object of strings.
+// A missing facts file results in no variables.
+func readFacts(fileName string) map[string]string {
+	facts, err := readMapFromFile(fileName)
+	if err != nil && !os.IsNotExist(err) {
+		log.Println("facts could not be read: ", err)
+	}
+	if facts == nil {
+		facts = make(map[string]string)
+	}
+	return facts
+}
+
+// templateInt converts a template argument to an integer, variables are strings.
+func templateInt(value interface{}) (int64, error) {
+	switch number := value.(type) {
+	case int:
+		return int64(number), nil
+	case int64:
+		return number, nil
+	case string:
+		return strconv.ParseInt(number, 10, 64)
+	}
+	return 0, fmt.Errorf("%v is not a number", value)
+}
+
+func templateArithmetic(a, b interface{}, operation func(x, y int64) int64) (int64, error) {
+	x, err := templateInt(a)
+	if err != nil {
+		return 0, err
+	}
+	y, err := templateInt(b)
+	if err != nil {
+		return 0, err
+	}
+	return operation(x, y), nil
+}
+
+// ipAdd returns the IPv4 address, which is offset addresses after the given one, e.g: ipAdd "192.168.0.10" 5 is 192.168.0.15.
+func ipAdd(address string, offset interface{}) (string, error) {
+	ip := net.ParseIP(address).To4()
+	if ip == nil {
+		return "", fmt.Errorf("%v is not an IPv4 address", address)
+	}
+	n, err := templateInt(offset)
+	if err != nil {
+		return "", err
+	}
+	sum := new(big.Int).Add(big.NewInt(int64(binary.BigEndian.Uint32(ip))), big.NewInt(n))
+	if sum.Sign() < 0 || sum.BitLen() > 32 {
+		return "", fmt.Errorf("%v plus %v is not an IPv4 address", address, n)
+	}
+	return IPFromUInt32BI(uint32(sum.Uint64())), nil
+}
+
+// cidrHost returns the host with the given number in the network, e.g: cidrHost "192.168.0.0/24" 12 is 192.168.0.12.
+// Negative numbers count from the end of the network, -1 is the broadcast address.
+func cidrHost(cidr string, host interface{}) (string, error) {
+	_, network, err := net.ParseCIDR(cidr)
+	if err != nil || network.IP.To4() == nil {
+		return "", fmt.Errorf("%v is not an IPv4 network", cidr)
+	}
+	n, err := templateInt(host)
+	if err != nil {
+		return "", err
+	}
+	ones, bits := network.Mask.Size()
+	size := int64(1) << uint(bits-ones)
+	if n < 0 {
+		n += size
+	}
+	if n < 0 || n >= size {
+		return "", fmt.Errorf("host %v is outside of %v", host, cidr)
+	}
+	return ipAdd(network.IP.String(), n)
+}
+
+// cidrNetmask returns the netmask of the network, e.g: cidrNetmask "192.168.0.0/24" is 255.255.255.0.
+func cidrNetmask(cidr string) (string, error) {
+	prefix, err := cidrPrefix(cidr)
+	if err != nil {
+		return "", err
+	}
+	return ParseNetMask(uint32(prefix)), nil
+}
+
+// cidrPrefix returns the prefix length of the network, e.g: cidrPrefix "192.168.0.0/24" is 24.
+func cidrPrefix(cidr string) (int, error) {
+	_, network, err := net.ParseCIDR(cidr)
+	if err != nil || network.IP.To4() == nil {
+		return 0, fmt.Errorf("%v is not an IPv4 network", cidr)
+	}
+	ones, _ := network.Mask.Size()
+	return ones, nil
+}
@@ -0,0 +1,97 @@
+/*
+ * Copyright © Siemens 2025. ALL RIGHTS RESERVED.
+ * Licensed under the MIT license
+ * See LICENSE file in the top-level directory
+ */
+
+package networking
+
+import (
+	"errors"
+	v1 "networkservice/api/siemens_iedge_dmapi_v1"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+const testTemplate = `{"Interfaces": [
+  {"Label": "X1", "DHCP": "disabled", "Static": {
+    "IPv4": "{{ cidrHost .Network .Station }}", "NetMask": "{{ cidrNetmask .Network }}", "Gateway": "{{ cidrHost .Network -2 }}"}},
+  {"Label": "X2", "DHCP": "disabled", "Static": {"IPv4": "{{ ipAdd "10.0.0.0" (mul .Station 4) }}", "NetMask": "255.255.255.252"}}
+]}`
+
+func Test_RenderTemplate_FillsVariables(t *testing.T) {
+	nc := &NetworkConfigurator{}
+	patches := gomonkey.ApplyFunc(readFacts, func(_ string) map[string]string {
+		return map[string]string{"Station": "1", "Network": "192.168.10.0/24"}
+	})
+	defer patches.Reset()
+
+	settings, err := nc.RenderTemplate(&v1.TemplateRequest{
+		Template:  testTemplate,
+		Variables: map[string]string{"Station": "12"},
+		Options:   &v1.ApplyOptions{Comment: "station 12"},
+	})
+
+	assert.NoError(t, err)
+	assert.True(t, proto.Equal(&v1.Interface_StaticConf{IPv4: "192.168.10.12", NetMask: "255.255.255.0", Gateway: "192.168.10.254"},
+		settings.Interfaces[0].Static), "Variables of the request should override the facts")
+	assert.Equal(t, "10.0.0.48", settings.Interfaces[1].Static.IPv4)
+	assert.Equal(t, "station 12", settings.Options.Comment)
+}
+
+func Test_RenderTemplate_RejectsInvalidTemplates(t *testing.T) {
+	nc := &NetworkConfigurator{}
+	patches := gomonkey.ApplyFunc(readFacts, func(_ string) map[string]string {
+		return map[string]string{"Network": "192.168.10.0/24"}
+	})
+	defer patches.Reset()
+
+	for name, tmpl := range map[string]string{
+		"missing variable": testTemplate,
+		"syntax":           `{"Interfaces": [{{ .Network }]}`,
+		"invalid settings": `{"Interfaces": [{"Label": "X1", "Static": {"IPv4": "{{ .Network }}"}}]}`,
+		"host outside":     `{"Interfaces": [{"Label": "X1", "Static": {"IPv4": "{{ cidrHost .Network 256 }}"}}]}`,
+		"not json":         `Interfaces: []`,
+	} {
+		_, err := nc.RenderTemplate(&v1.TemplateRequest{Template: tmpl})
+		assert.True(t, errors.Is(err, ErrInvalidTemplate), name)
+	}
+
+	settings, err := nc.RenderTemplate(&v1.TemplateRequest{
+		Template:  "Interfaces:\n  - Label: X1\n    DHCP: enabled\n",
+		Format:    v1.DocumentFormat_YAML,
+		Variables: map[string]string{},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, Enabled, settings.Interfaces[0].DHCP)
+}
+
+func Test_templateFuncs(t *testing.T) {
+	host, err := cidrHost("10.1.0.0/16", "258")
+	assert.NoError(t, err)
+	assert.Equal(t, "10.1.1.2", host)
+
+	_, err = ipAdd("255.255.255.255", 1)
+	assert.Error(t, err)
+
+	prefix, err := cidrPrefix("172.16.0.0/12")
+	assert.NoError(t, err)
+	assert.Equal(t, 12, prefix)
+
+	_, err = cidrNetmask("fd00::/64")
+	assert.Error(t, err, "IPv6 networks are not supported")
+}
+
+func Test_readFacts(t *testing.T) {
+	dir := t.TempDir()
+	assert.Empty(t, readFacts(filepath.Join(dir, "missing")))
+
+	fileName := filepath.Join(dir, "facts.json")
+	assert.NoError(t, os.WriteFile(fileName, []byte(`{"Station": "7"}`), 0644))
+	assert.Equal(t, map[string]string{"Station": "7"}, readFacts(fileName))
+}
@@ -7,19 +7,20 @@
 package networking
 
 import (
-	"container/list"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"log"
 	"net"
 	v1 "networkservice/api/siemens_iedge_dmapi_v1"
+	"strconv"
 	"strings"
 	"time"
 
 	nm "github.com/Wifx/gonetworkmanager/v2"
 	"github.com/godbus/dbus/v5"
 	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
 )
 
 // dict Dictionary type
@@ -29,20 +30,17 @@ type dict map[string]interface{}
 type DBusDict map[string]dbus.Variant
 
 // Backup configuration can not applied to dbus! some fields needs to be removed Create a new connection
-// INSTANCE based on ipv4 and name from backup
+// INSTANCE based on the backup. All settings of the backup are preserved, only the UUID and timestamp are renewed.
 func retrieveSettingsFromBackup(backup nm.ConnectionSettings) nm.ConnectionSettings {
-	connection := make(nm.ConnectionSettings)
-	connection[ConnectionKey] = make(dict)
-	connection[IPV4Key] = make(dict)
-	connection[EthernetType] = make(dict)
-	connection[ConnectionKey][IDKey] = backup[ConnectionKey][IDKey]
-	connection[ConnectionKey][TypeKey] = backup[ConnectionKey][TypeKey]
-	connection[ConnectionKey][InterfaceNameKey] = backup[ConnectionKey][InterfaceNameKey]
+	connection := copySettings(backup)
+	for _, name := range []string{ConnectionKey, IPV4Key, EthernetType} {
+		if connection[name] == nil {
+			connection[name] = make(dict)
+		}
+	}
 	connection[ConnectionKey][UUIDKey] = uuid.New().String()
 	connection[ConnectionKey][TimeStampKey] = time.Now().UnixNano()
-	connection[EthernetType] = backup[EthernetType]
-	connection[EthernetType][MACAddressKey] = backup[EthernetType][MACAddressKey]
-	connection[IPV4Key] = backup[IPV4Key]
+	removeDeprecatedKeys(connection)
 	return connection
 }
 
@@ -84,27 +82,73 @@ func parseDHCPIPv4Config(ipv4conf nm.IP4Config) *v1.Interface_StaticConf {
 
 func parseDns(dnsArray []nm.IP4NameserverData) *v1.Interface_Dns {
 	dns := &v1.Interface_Dns{}
-	tmpDnsList := list.New()
 
-	// copy nonempty members of dnsArray to tmpDnsArray list
+	// copy nonempty members of dnsArray to the server list
 	for _, dnsEntry := range dnsArray {
 		if len(dnsEntry.Address) != 0 {
-			tmpDnsList.PushBack(dnsEntry.Address)
+			dns.Servers = append(dns.Servers, dnsEntry.Address)
 		}
 	}
 	// Set PrimaryDns and SecondaryDns values, if this fields exists in dns entry list
-	listElement := tmpDnsList.Front()
-	if tmpDnsList.Len() > 0 {
-		dns.PrimaryDNS = listElement.Value.(string)
-		if tmpDnsList.Len() > 1 {
-			listElement = listElement.Next()
-			dns.SecondaryDNS = listElement.Value.(string)
+	if len(dns.Servers) > 0 {
+		dns.PrimaryDNS = dns.Servers[0]
+		if len(dns.Servers) > 1 {
+			dns.SecondaryDNS = dns.Servers[1]
 		}
 	}
 
 	return dns
 }
 
+// parseDNSSettings reads the search domains, the DNS priority and the resolver options of the profile into the
+// given DNS configuration. The mode of the DNS servers received by DHCP is reported as it is in effect.
+func parseDNSSettings(connection nm.ConnectionSettings, dns *v1.Interface_Dns) {
+	dns.Searches = settingStrings(connection[IPV4Key][DNSSearchKey])
+	dns.Priority = int32(settingInt(connection[IPV4Key][DNSPriorityKey], 0))
+	parseDNSOptions(settingStrings(connection[IPV4Key][DNSOptionsKey]), dns)
+
+	switch {
+	case settingInt(connection[IPV4Key][DNSIgnoreAutoKey], 0) != 0:
+		dns.Auto = v1.Interface_Dns_IGNORE
+	case connection[IPV4Key][MethodKey] == Auto && isSet(connection[IPV4Key][DNSKey]):
+		dns.Auto = v1.Interface_Dns_MERGE
+	}
+}
+
+// parseDNSOptions reads the timeout, attempts and rotate resolver options, e.g: ["timeout:2", "rotate"]
+func parseDNSOptions(options []string, dns *v1.Interface_Dns) {
+	for _, option := range options {
+		name, value, _ := strings.Cut(option, ":")
+		number, _ := strconv.ParseUint(value, 10, 32)
+		switch name {
+		case DNSOptionTimeout:
+			dns.Timeout = uint32(number)
+		case DNSOptionAttempts:
+			dns.Attempts = uint32(number)
+		case DNSOptionRotate:
+			dns.Rotate = true
+		}
+	}
+}
+
+// parseRoutes returns the static routes of the connection, read from its route-data.
+func parseRoutes(connection nm.ConnectionSettings) []*v1.Interface_Route {
+	data, _ := connection[IPV4Key][RouteDataKey].([]map[string]interface{})
+
+	var routes []*v1.Interface_Route
+	for _, entry := range data {
+		destination, _ := entry[RouteDestinationKey].(string)
+		if destination == "" {
+			continue
+		}
+		route := &v1.Interface_Route{Destination: fmt.Sprintf("%v/%v", destination, settingInt(entry[PrefixKey], 32))}
+		route.NextHop, _ = entry[RouteNextHopKey].(string)
+		route.Metric = uint32(settingInt(entry[RouteMetricDataKey], 0))
+		routes = append(routes, route)
+	}
+	return routes
+}
+
 func listConnections(device nm.DeviceWired) []nm.Connection {
 	var connections []nm.Connection
 
@@ -168,7 +212,9 @@ func isValidConnection(connection nm.Connection, name, activeConnectionUUID, act
 	return connectionUUID == activeConnectionUUID && connectionID == activeConnectionID
 }
 
-func DBusToProto(device nm.DeviceWired) *v1.Interface {
+// DBusToProto converts the given device into Device Model Proto. Only the fields selected by the mask are resolved,
+// lookups for unselected fields (e.g. docker for L2Conf, the label file for Label) are skipped. A nil mask selects all fields.
+func DBusToProto(device nm.DeviceWired, mask *InterfaceMask) *v1.Interface {
 	if device == nil {
 		return nil
 	}
@@ -176,23 +222,32 @@ func DBusToProto(device nm.DeviceWired) *v1.Interface {
 	//gnm,_:=nm.NewNetworkManager()
 	var values nm.ConnectionSettings
 	var retVal *v1.Interface
-	var allConnections []nm.Connection
 
-	conn, err := device.GetPropertyActiveConnection()
-	allConnections = listConnections(device)
-
-	mac, _ := device.GetPropertyHwAddress()
+	mac, _ := deviceMac(device)
 	deviceName, _ := device.GetPropertyInterface()
 
-	if err == nil && conn != nil {
-		IPv4Wrapper, _ := conn.GetPropertyIP4Config()
-		props, _ := conn.GetPropertyConnection()
-		values, _ = props.GetSettings()
-		retVal = convertToProto(values, IPv4Wrapper, mac)
-	} else if allConnections != nil && len(allConnections) > 0 {
-		values, _ = allConnections[0].GetSettings()
-		retVal = convertToProto(values, nil, mac)
-	} else {
+	if mask.includesAny(FieldDHCP, FieldStatic, FieldDNSConfig, FieldProfileOwner, FieldRoutes, FieldFallback, FieldAddressSource, FieldDHCPOptions, FieldLease, FieldLink) {
+		conn, err := device.GetPropertyActiveConnection()
+		allConnections := listConnections(device)
+
+		if err == nil && conn != nil {
+			var IPv4Wrapper nm.IP4Config
+			if mask.includesAny(FieldStatic, FieldDNSConfig) {
+				IPv4Wrapper, _ = conn.GetPropertyIP4Config()
+			}
+			props, _ := conn.GetPropertyConnection()
+			values, _ = props.GetSettings()
+			retVal = convertToProto(values, IPv4Wrapper, mac, mask)
+			if retVal != nil && mask.Includes(FieldLease) && values[IPV4Key][MethodKey] == Auto {
+				dhcp4Config, _ := conn.GetPropertyDHCP4Config()
+				retVal.Lease = parseDHCPLease(dhcp4Config)
+			}
+		} else if allConnections != nil && len(allConnections) > 0 {
+			values, _ = allConnections[0].GetSettings()
+			retVal = convertToProto(values, nil, mac, mask)
+		}
+	}
+	if retVal == nil {
 		retVal = &v1.Interface{MacAddress: mac, Label: deviceName}
 	}
 
@@ -201,32 +256,78 @@ func DBusToProto(device nm.DeviceWired) *v1.Interface {
 	log.Println("interfacename :", interfaceName)
 
 	// get layer2 config from device
-	l2device := dockerNetworkGetMacvlanConnection(interfaceName)
-	retVal.L2Conf = l2device
+	if mask.Includes(FieldL2Conf) {
+		l2device := dockerNetworkGetMacvlanConnection(interfaceName)
+		retVal.L2Conf = l2device
+	}
 
 	retVal.InterfaceName = interfaceName
-	retVal.Label, _ = getLabelForInterface(interfaceName)
+	if mask.includesAny(FieldLabel, FieldResourceVersion) {
+		retVal.Label, _ = getLabelForInterface(interfaceName)
+	}
+	if mask.Includes(FieldResourceVersion) {
+		retVal.ResourceVersion = deviceResourceVersion(device, retVal.Label)
+	}
+	if values != nil && mask.Includes(FieldProfileOwner) {
+		retVal.ProfileOwner = profileOwner(values)
+	}
+	if mask.Includes(FieldLinkStatus) {
+		retVal.LinkStatus = readLinkStatus(SysClassNetPath, interfaceName)
+	}
+	if mask.Includes(FieldVlans) {
+		retVal.Vlans = readVlans(interfaceName)
+	}
 
+	mask.Prune(retVal)
 	return retVal
 }
 
 // Converts DBus data (nm.ConnectionSettings) to Device Model Proto
-func convertToProto(connection nm.ConnectionSettings, ipv4Config nm.IP4Config, mac string) *v1.Interface {
+func convertToProto(connection nm.ConnectionSettings, ipv4Config nm.IP4Config, mac string, mask *InterfaceMask) *v1.Interface {
 
 	retVal := &v1.Interface{}
 	retVal.MacAddress = strings.ToUpper(mac)
+	// a bond member has no IP settings of its own
+	if retVal.Bond = bondMaster(connection); retVal.Bond != "" {
+		return retVal
+	}
 
-	if connection[IPV4Key][MethodKey] == Auto {
+	switch connection[IPV4Key][MethodKey] {
+	case Auto:
 		retVal.DHCP = Enabled
-		retVal.Static = parseDHCPIPv4Config(ipv4Config)
-	} else {
+		retVal.AddressSource = DHCP
+		if mask.Includes(FieldStatic) {
+			retVal.Static = parseDHCPIPv4Config(ipv4Config)
+		}
+	case LinkLocal:
+		retVal.DHCP = Disabled
+		retVal.AddressSource = LinkLocal
+		if mask.Includes(FieldStatic) {
+			retVal.Static = parseDHCPIPv4Config(ipv4Config)
+		}
+	default:
 		retVal.Static = parseStaticIPConfig(connection)
 		retVal.DHCP = Disabled
+		retVal.AddressSource = Static
+	}
+	if mask.Includes(FieldDHCPOptions) {
+		retVal.DHCPOptions = parseDHCPOptions(connection)
+	}
+	if fallback := parseFallback(connection); fallback != nil {
+		retVal.DHCP = Fallback
+		retVal.Fallback = fallback
 	}
 
-	if ipv4Config != nil {
+	if ipv4Config != nil && mask.Includes(FieldDNSConfig) {
 		dnsArray, _ := ipv4Config.GetPropertyNameserverData()
 		retVal.DNSConfig = parseDns(dnsArray)
+		parseDNSSettings(connection, retVal.DNSConfig)
+	}
+	if mask.Includes(FieldRoutes) {
+		retVal.Routes = parseRoutes(connection)
+	}
+	if mask.Includes(FieldLink) {
+		retVal.Link = parseLink(connection)
 	}
 
 	return retVal
@@ -240,13 +341,15 @@ func newSettingsFromProto(protoData *v1.Interface, deviceName string) nm.Connect
 	applyConnectionSetting(ipAssignmentMethod, protoData, connection)
 	identifier := determineIdentifier(protoData)
 	setConnectionDetails(connection, protoData, identifier, ipAssignmentMethod, deviceName)
+	tagOwnership(connection)
+	tagFallback(protoData, connection)
 
 	return connection
 }
 
 // determineIpAssignmentMethod determines the connection suffix based on the protoData.
 func determineIpAssignmentMethod(protoData *v1.Interface) string {
-	if protoData.DHCP == Enabled {
+	if protoData.DHCP == Enabled || protoData.DHCP == Fallback {
 		return DHCP
 	}
 	return Static
@@ -259,11 +362,15 @@ func applyConnectionSetting(connectionSuffix string, protoData *v1.Interface, co
 	}
 	if connectionSuffix == DHCP {
 		putDHCP(connection)
+		putDHCPOptions(protoData, connection)
+		putFallback(protoData, connection)
 	} else {
 		putStaticIP(protoData, connection)
 	}
 
 	putDNSConfig(protoData, connection)
+	putRoutes(protoData, connection)
+	putLink(protoData, connection)
 }
 
 // ConfigureExistingGatewayInterfacesExceptProtoData sets the route metric for all Ethernet device connections
@@ -415,6 +522,61 @@ func putDHCP(connection nm.ConnectionSettings) {
 	connection[IPV4Key][MethodKey] = Auto
 }
 
+// putDHCPOptions puts the options of the DHCP client, options which are not given keep the NetworkManager defaults.
+func putDHCPOptions(protoData *v1.Interface, connection nm.ConnectionSettings) {
+	options := protoData.GetDHCPOptions()
+	if options == nil {
+		return
+	}
+	if options.Hostname != "" {
+		connection[IPV4Key][DHCPHostnameKey] = options.Hostname
+	}
+	if options.SendHostname != "" {
+		connection[IPV4Key][DHCPSendHostnameKey] = options.SendHostname == Enabled
+	}
+	if options.ClientID != "" {
+		connection[IPV4Key][DHCPClientIDKey] = options.ClientID
+	}
+	if options.VendorClass != "" {
+		connection[IPV4Key][DHCPVendorClassKey] = options.VendorClass
+	}
+	if options.Timeout != 0 {
+		connection[IPV4Key][DHCPTimeoutKey] = int32(options.Timeout)
+	}
+	if options.MayFail != "" {
+		connection[IPV4Key][MayFailKey] = options.MayFail == Enabled
+	}
+}
+
+// parseDHCPOptions reads the options of the DHCP client, it returns nil if the profile keeps all defaults.
+func parseDHCPOptions(connection nm.ConnectionSettings) *v1.Interface_DHCPConf {
+	ipv4 := connection[IPV4Key]
+	options := &v1.Interface_DHCPConf{
+		Hostname:    settingString(ipv4[DHCPHostnameKey]),
+		ClientID:    settingString(ipv4[DHCPClientIDKey]),
+		VendorClass: settingString(ipv4[DHCPVendorClassKey]),
+		Timeout:     uint32(settingInt(ipv4[DHCPTimeoutKey], 0)),
+	}
+	if ipv4[DHCPSendHostnameKey] != nil {
+		options.SendHostname = enabledString(settingInt(ipv4[DHCPSendHostnameKey], 1) != 0)
+	}
+	if ipv4[MayFailKey] != nil {
+		options.MayFail = enabledString(settingInt(ipv4[MayFailKey], 1) != 0)
+	}
+	if proto.Equal(options, &v1.Interface_DHCPConf{}) {
+		return nil
+	}
+	return options
+}
+
+// enabledString returns the string used for flags of the API, like DHCP.
+func enabledString(enabled bool) string {
+	if enabled {
+		return Enabled
+	}
+	return Disabled
+}
+
 // putStaticIP puts the static IP configuration.
 func putStaticIP(protoData *v1.Interface, connection nm.ConnectionSettings) {
 	connection[IPV4Key][MethodKey] = Manual
@@ -433,26 +595,101 @@ func putStaticIP(protoData *v1.Interface, connection nm.ConnectionSettings) {
 	}
 }
 
-// putDNSConfig puts the DNS configuration.
+// putDNSConfig puts the DNS configuration. The DNS servers received by DHCP are ignored or used after the given
+// servers, as chosen by DNSConfig.Auto.
 func putDNSConfig(protoData *v1.Interface, connection nm.ConnectionSettings) {
-	if protoData.DNSConfig != nil {
-		var dns1, dns2 uint32
-		if len(protoData.DNSConfig.PrimaryDNS) > 0 {
-			dns1 = IPToUInt32LI(protoData.DNSConfig.PrimaryDNS)
-			connection[IPV4Key][DNSKey] = []uint32{dns1}
-
-			if len(protoData.DNSConfig.SecondaryDNS) > 0 {
-				dns2 = IPToUInt32LI(protoData.DNSConfig.SecondaryDNS)
-				connection[IPV4Key][DNSKey] = []uint32{dns1, dns2}
-			}
-		}
+	dns := protoData.DNSConfig
+	if dns == nil {
+		return
+	}
+
+	var servers []uint32
+	for _, server := range dnsServers(dns) {
+		servers = append(servers, IPToUInt32LI(server))
+	}
+	if len(servers) > 0 {
+		connection[IPV4Key][DNSKey] = servers
+	}
+	if len(dns.Searches) > 0 {
+		connection[IPV4Key][DNSSearchKey] = dns.Searches
+	}
+	if dns.Priority != 0 {
+		connection[IPV4Key][DNSPriorityKey] = dns.Priority
+	}
+	if options := dnsOptions(dns); len(options) > 0 {
+		connection[IPV4Key][DNSOptionsKey] = options
+	}
 
-		if protoData.DHCP == Enabled {
+	switch dns.Auto {
+	case v1.Interface_Dns_IGNORE:
+		connection[IPV4Key][DNSIgnoreAutoKey] = Yes
+	case v1.Interface_Dns_DEFAULT:
+		if usesDHCP(protoData.DHCP) {
 			connection[IPV4Key][DNSIgnoreAutoKey] = Yes
 		}
 	}
 }
 
+// dnsServers returns the DNS servers in order. Servers replaces PrimaryDNS and SecondaryDNS, if it is set.
+func dnsServers(dns *v1.Interface_Dns) []string {
+	if len(dns.GetServers()) > 0 {
+		return dns.Servers
+	}
+	var servers []string
+	if dns.GetPrimaryDNS() != "" {
+		servers = append(servers, dns.PrimaryDNS)
+		if dns.SecondaryDNS != "" {
+			servers = append(servers, dns.SecondaryDNS)
+		}
+	}
+	return servers
+}
+
+// dnsOptions returns the resolver options of the DNS configuration, e.g: ["timeout:2", "rotate"]
+func dnsOptions(dns *v1.Interface_Dns) []string {
+	var options []string
+	if dns.Timeout != 0 {
+		options = append(options, fmt.Sprintf("%s:%d", DNSOptionTimeout, dns.Timeout))
+	}
+	if dns.Attempts != 0 {
+		options = append(options, fmt.Sprintf("%s:%d", DNSOptionAttempts, dns.Attempts))
+	}
+	if dns.Rotate {
+		options = append(options, DNSOptionRotate)
+	}
+	return options
+}
+
+// putRoutes puts the static routes. Without routes the route-data is not set, so the routes of an existing
+// profile are kept on update.
+func putRoutes(protoData *v1.Interface, connection nm.ConnectionSettings) {
+	if len(protoData.Routes) == 0 {
+		return
+	}
+
+	var routes []DBusDict
+	for _, route := range protoData.Routes {
+		_, destination, err := net.ParseCIDR(route.Destination)
+		if err != nil {
+			log.Printf("Error parsing route destination: %v", err)
+			continue
+		}
+		prefix, _ := destination.Mask.Size()
+
+		routeDict := make(DBusDict)
+		routeDict[RouteDestinationKey] = dbus.MakeVariant(destination.IP.String()) // Network, e.g: "10.0.0.0"
+		routeDict[PrefixKey] = dbus.MakeVariant(uint32(prefix))                    // Subnet, e.g: 8
+		if route.NextHop != "" {
+			routeDict[RouteNextHopKey] = dbus.MakeVariant(route.NextHop)
+		}
+		if route.Metric != 0 {
+			routeDict[RouteMetricDataKey] = dbus.MakeVariant(route.Metric)
+		}
+		routes = append(routes, routeDict)
+	}
+	connection[IPV4Key][RouteDataKey] = routes
+}
+
 // determineIdentifier determines the identifier for the connection ID.
 func determineIdentifier(protoData *v1.Interface) string {
 	identifier := ""
@@ -499,10 +736,18 @@ func WriteMapToFile(mapToBeWritten map[string]string, fileName string) error {
 	if err == nil {
 		err = ioutil.WriteFile(fileName, buffer, 0666)
 	}
+	if fileName == LabelMapFileName {
+		legacyLabels.invalidate()
+	}
 
 	return err
 }
 
+// ReadLabelMap returns the label map of the device from LabelMapFileName.
+func ReadLabelMap() (map[string]string, error) {
+	return readMapFromFile(LabelMapFileName)
+}
+
 func readMapFromFile(fileName string) (map[string]string, error) {
 
 	var parsedMap map[string]string
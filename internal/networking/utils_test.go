@@ -23,6 +23,7 @@ import (
 	"github.com/godbus/dbus/v5"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
 )
 
 func getMockInterfaceStaticConf() *v1.Interface_StaticConf {
@@ -136,6 +137,7 @@ func Test_ParseDns_ReturnsCorrectDnsConfig(t *testing.T) {
 	expected := &v1.Interface_Dns{
 		PrimaryDNS:   "8.8.8.8",
 		SecondaryDNS: "8.8.4.4",
+		Servers:      []string{"8.8.8.8", "8.8.4.4"},
 	}
 
 	dns := parseDns(dnsArray)
@@ -252,7 +254,7 @@ func TestIsValidConnection_UUIDAndIDDoNotMatch(t *testing.T) {
 
 func Test_DBusToProto_ReturnsNilWhenDeviceWiredIsNil(t *testing.T) {
 	// Call the function
-	result := DBusToProto(nil)
+	result := DBusToProto(nil, nil)
 	// Assertions
 	assert.Nil(t, result, "DBusToProto should return nil when the input is nil")
 }
@@ -267,6 +269,7 @@ func Test_DBusToProto_ReturnsBasicInterfaceInformationWhenNoConnectionFound(t *t
 
 	mockDeviceWired.On("GetPropertyActiveConnection").Return(mockActiveConnection, errors.New("error from GetPropertyActiveConnection"))
 	mockDeviceWired.On("GetPropertyHwAddress").Return(testMac, nil)
+	mockDeviceWired.On("GetPropertyPermHwAddress").Return(testMac, nil)
 	mockDeviceWired.On("GetPropertyInterface").Return(testInterface, nil)
 
 	patches := gomonkey.NewPatches()
@@ -287,8 +290,12 @@ func Test_DBusToProto_ReturnsBasicInterfaceInformationWhenNoConnectionFound(t *t
 		return "", nil
 	})
 
+	patches.ApplyFunc(deviceResourceVersion, func(_ nm.DeviceWired, _ string) string {
+		return "0123456789abcdef"
+	})
+
 	// Call the function
-	result := DBusToProto(mockDeviceWired)
+	result := DBusToProto(mockDeviceWired, nil)
 	// Assertions
 	assert.Equal(t, testInterface, result.InterfaceName, "DBusToProto should return an Interface with the correct interface name")
 	assert.Equal(t, testMac, result.MacAddress, "DBusToProto should return an Interface with the correct MAC address")
@@ -313,6 +320,7 @@ func Test_DBusToProto_ReturnsInterfaceWithFirstConnectionWhenActiveConnectionNot
 
 	mockDeviceWired.On("GetPropertyActiveConnection").Return(mockActiveConnection, errors.New("error from GetPropertyActiveConnection"))
 	mockDeviceWired.On("GetPropertyHwAddress").Return(expectedInterface.MacAddress, nil)
+	mockDeviceWired.On("GetPropertyPermHwAddress").Return(expectedInterface.MacAddress, nil)
 	mockDeviceWired.On("GetPropertyInterface").Return(testInterface, nil)
 	mockConnection1.On("GetSettings").Return(nm.ConnectionSettings{
 		ConnectionKey: map[string]interface{}{
@@ -335,7 +343,7 @@ func Test_DBusToProto_ReturnsInterfaceWithFirstConnectionWhenActiveConnectionNot
 	})
 
 	// Patch convertToProto function
-	patches.ApplyFunc(convertToProto, func(settings nm.ConnectionSettings, ipv4conf nm.IP4Config, mac string) *v1.Interface {
+	patches.ApplyFunc(convertToProto, func(settings nm.ConnectionSettings, ipv4conf nm.IP4Config, mac string, _ *InterfaceMask) *v1.Interface {
 		if settings[ConnectionKey]["id"] == "connection1" && mac == expectedInterface.MacAddress && ipv4conf == nil {
 			return expectedInterface
 		}
@@ -346,8 +354,12 @@ func Test_DBusToProto_ReturnsInterfaceWithFirstConnectionWhenActiveConnectionNot
 		return expectedInterface.Label, nil
 	})
 
+	patches.ApplyFunc(deviceResourceVersion, func(_ nm.DeviceWired, _ string) string {
+		return "0123456789abcdef"
+	})
+
 	// Call the function
-	result := DBusToProto(mockDeviceWired)
+	result := DBusToProto(mockDeviceWired, nil)
 
 	// Assertions
 	assert.NotNil(t, result, "DBusToProto should return a non-nil Interface instance")
@@ -377,6 +389,7 @@ func Test_DBusToProto_ReturnsFullInterfaceInfoWhenActiveConnectionIsAvailable(t
 
 	mockDeviceWired.On("GetPropertyActiveConnection").Return(mockActiveConnection, nil)
 	mockDeviceWired.On("GetPropertyHwAddress").Return(expectedInterface.MacAddress, nil)
+	mockDeviceWired.On("GetPropertyPermHwAddress").Return(expectedInterface.MacAddress, nil)
 	mockDeviceWired.On("GetPropertyInterface").Return(testInterface, nil)
 	mockActiveConnection.On("GetPropertyIP4Config").Return(mockIP4Config, nil)
 	mockActiveConnection.On("GetPropertyConnection").Return(mockConnection, nil)
@@ -391,15 +404,19 @@ func Test_DBusToProto_ReturnsFullInterfaceInfoWhenActiveConnectionIsAvailable(t
 	})
 
 	// Patch convertToProto function
-	patches.ApplyFunc(convertToProto, func(settings nm.ConnectionSettings, ipv4conf nm.IP4Config, mac string) *v1.Interface {
+	patches.ApplyFunc(convertToProto, func(settings nm.ConnectionSettings, ipv4conf nm.IP4Config, mac string, _ *InterfaceMask) *v1.Interface {
 		if mac == expectedInterface.MacAddress && ipv4conf == mockIP4Config {
 			return expectedInterface
 		}
 		return nil
 	})
 
+	patches.ApplyFunc(deviceResourceVersion, func(_ nm.DeviceWired, _ string) string {
+		return "0123456789abcdef"
+	})
+
 	// Call the function
-	result := DBusToProto(mockDeviceWired)
+	result := DBusToProto(mockDeviceWired, nil)
 
 	// Assertions
 	assert.NotNil(t, result, "DBusToProto should return a non-nil Interface instance")
@@ -434,7 +451,7 @@ func Test_ConvertToProto_ReturnsCorrectProtoWhenDHCPEnabled(t *testing.T) {
 		return getMockInterfaceStaticConf()
 	})
 
-	result := convertToProto(connection, mockIPV4Config, mac)
+	result := convertToProto(connection, mockIPV4Config, mac, nil)
 
 	assert.Equal(t, Enabled, result.DHCP, "DHCP should be enabled")
 	assert.Equal(t, "F7:2B:A1:D5:97:4E", result.MacAddress)
@@ -459,7 +476,7 @@ func Test_ConvertToProto_ReturnsCorrectProtoWhenStaticIP(t *testing.T) {
 
 	mockIPV4Config.On("GetPropertyNameserverData").Return(mockNsData, nil)
 
-	result := convertToProto(connection, mockIPV4Config, mac)
+	result := convertToProto(connection, mockIPV4Config, mac, nil)
 
 	assert.Equal(t, Disabled, result.DHCP, "DHCP should be disabled")
 	assert.Equal(t, "F7:2B:A1:D5:97:4E", result.MacAddress)
@@ -487,7 +504,7 @@ func Test_ConvertToProto_ReturnsCorrectProtoWithDHCPIPConfig(t *testing.T) {
 	mockIPV4Config.On("GetPropertyGateway").Return("192.168.1.254", nil)
 	mockIPV4Config.On("GetPropertyNameserverData").Return(mockNsData, nil)
 
-	result := convertToProto(connection, mockIPV4Config, mac)
+	result := convertToProto(connection, mockIPV4Config, mac, nil)
 
 	assert.Equal(t, Enabled, result.DHCP, "DHCP should be enabled")
 	assert.Equal(t, "F7:2B:A1:D5:97:4E", result.MacAddress)
@@ -523,6 +540,9 @@ func Test_NewSettingsFromProto_ReturnsSettingsWhenDHCPEnabled(t *testing.T) {
 		EthernetType: map[string]interface{}{
 			MACAddressKey: net.HardwareAddr{},
 		},
+		UserKey: map[string]interface{}{
+			UserDataKey: map[string]string{OwnerTagKey: OwnerServiceName},
+		},
 	}
 
 	patches := gomonkey.NewPatches()
@@ -579,6 +599,9 @@ func Test_NewSettingsFromProto_ReturnsSettingsWithDefaultsWhenPutMacAddressFails
 		EthernetType: map[string]interface{}{
 			MACAddressKey: net.HardwareAddr{},
 		},
+		UserKey: map[string]interface{}{
+			UserDataKey: map[string]string{OwnerTagKey: OwnerServiceName},
+		},
 	}
 
 	patches := gomonkey.NewPatches()
@@ -643,6 +666,9 @@ func Test_NewSettingsFromProto_ReturnsSettingsWithStaticIPWhenDHCPDisabled(t *te
 		EthernetType: map[string]interface{}{
 			MACAddressKey: net.HardwareAddr{},
 		},
+		UserKey: map[string]interface{}{
+			UserDataKey: map[string]string{OwnerTagKey: OwnerServiceName},
+		},
 	}
 
 	patches := gomonkey.NewPatches()
@@ -934,6 +960,7 @@ func Test_deactivateAndActivateConnection_GetPropertyActiveConnectionWhenReturnN
 
 	patches.ApplyMethodReturn(ethernetDevice, "GetPropertyActiveConnection", nil, nil)
 	ethernetDevice.On("GetPropertyHwAddress").Return("F7:2B:A1:D5:97:4E", nil)
+	ethernetDevice.On("GetPropertyPermHwAddress").Return("F7:2B:A1:D5:97:4E", nil)
 
 	err := deactivateAndActivateConnection(ethernetDevice, connection, networkConfigurator)
 	assert.Nil(t, err, "Expected no error when the active connection is nil")
@@ -1154,3 +1181,100 @@ func Test_changePriorityOfGatewayInterface_Success(t *testing.T) {
 	assert.NoError(t, err, "Expected no error when the route metric is updated successfully")
 	assert.Equal(t, int32(-1), settings[IPV4Key][RouteMetricKey], "Expected Route Metric to be set to -1")
 }
+
+func Test_putRoutes_AndParseRoutes(t *testing.T) {
+	routes := []*v1.Interface_Route{
+		{Destination: "10.1.2.3/8", NextHop: "192.168.1.254"},
+		{Destination: "172.16.0.0/12", Metric: 50},
+		{Destination: "invalid"},
+	}
+	connection := initializeConnectionSettings()
+
+	putRoutes(&v1.Interface{Routes: routes}, connection)
+
+	routeData := connection[IPV4Key][RouteDataKey].([]DBusDict)
+	assert.Len(t, routeData, 2, "Invalid routes should be skipped")
+	assert.Equal(t, "10.0.0.0", routeData[0][RouteDestinationKey].Value())
+	assert.Equal(t, uint32(8), routeData[0][PrefixKey].Value())
+	assert.NotContains(t, routeData[1], RouteNextHopKey)
+
+	// NetworkManager returns the route-data decoded
+	decoded := nm.ConnectionSettings{IPV4Key: {RouteDataKey: []map[string]interface{}{
+		{RouteDestinationKey: "10.0.0.0", PrefixKey: uint32(8), RouteNextHopKey: "192.168.1.254"},
+		{RouteDestinationKey: "172.16.0.0", PrefixKey: uint32(12), RouteMetricDataKey: uint32(50)},
+	}}}
+	assert.Equal(t, []*v1.Interface_Route{
+		{Destination: "10.0.0.0/8", NextHop: "192.168.1.254"},
+		{Destination: "172.16.0.0/12", Metric: 50},
+	}, parseRoutes(decoded))
+
+	empty := initializeConnectionSettings()
+	putRoutes(&v1.Interface{}, empty)
+	assert.NotContains(t, empty[IPV4Key], RouteDataKey, "Without routes the route-data should not be set")
+	assert.Nil(t, parseRoutes(empty))
+}
+
+func Test_DHCPOptions_RoundTrip(t *testing.T) {
+	options := &v1.Interface_DHCPConf{Hostname: "ipc-station-12", SendHostname: Enabled, ClientID: "01:00:11:22:33:44:55",
+		VendorClass: "SIEMENS-IPC", Timeout: 60, MayFail: Disabled}
+	settings := newSettingsFromProto(&v1.Interface{MacAddress: "00:0A:95:9D:68:16", DHCP: Enabled, DHCPOptions: options}, "eth0")
+
+	assert.Equal(t, "ipc-station-12", settings[IPV4Key][DHCPHostnameKey])
+	assert.Equal(t, true, settings[IPV4Key][DHCPSendHostnameKey])
+	assert.Equal(t, int32(60), settings[IPV4Key][DHCPTimeoutKey])
+	assert.Equal(t, false, settings[IPV4Key][MayFailKey])
+
+	result := convertToProto(settings, nil, "00:0A:95:9D:68:16", nil)
+	assert.True(t, proto.Equal(options, result.DHCPOptions), "DHCP options should be read back: %v", result.DHCPOptions)
+
+	plain := newSettingsFromProto(&v1.Interface{MacAddress: "00:0A:95:9D:68:16", DHCP: Enabled}, "eth0")
+	assert.Nil(t, convertToProto(plain, nil, "00:0A:95:9D:68:16", nil).DHCPOptions, "Defaults should not be reported as options")
+}
+
+func Test_DNSConfig_RoundTrip(t *testing.T) {
+	dns := &v1.Interface_Dns{Servers: []string{"10.0.0.53", "10.0.1.53", "9.9.9.9"}, Searches: []string{"plant.local", "siemens.com"},
+		Priority: -10, Timeout: 2, Attempts: 3, Rotate: true, Auto: v1.Interface_Dns_IGNORE}
+	settings := newSettingsFromProto(&v1.Interface{MacAddress: "00:0A:95:9D:68:16", DHCP: Disabled,
+		Static: getMockInterfaceStaticConf(), DNSConfig: dns}, "eth0")
+
+	assert.Len(t, settings[IPV4Key][DNSKey], 3, "All DNS servers should be written")
+	assert.Equal(t, []string{"plant.local", "siemens.com"}, settings[IPV4Key][DNSSearchKey])
+	assert.Equal(t, int32(-10), settings[IPV4Key][DNSPriorityKey])
+	assert.Equal(t, []string{"timeout:2", "attempts:3", "rotate"}, settings[IPV4Key][DNSOptionsKey])
+	assert.Equal(t, Yes, settings[IPV4Key][DNSIgnoreAutoKey])
+
+	// NetworkManager returns the address data without variants
+	settings[IPV4Key][AddressDataKey] = []map[string]interface{}{{AddressKey: "192.168.1.1", PrefixKey: uint32(24)}}
+	mockIP4Config := &mockgnm.MockIP4Config{}
+	mockIP4Config.On("GetPropertyNameserverData").Return(
+		[]nm.IP4NameserverData{{Address: "10.0.0.53"}, {Address: "10.0.1.53"}, {Address: "9.9.9.9"}}, nil)
+	result := convertToProto(settings, mockIP4Config, "00:0A:95:9D:68:16", nil)
+	assert.Equal(t, "10.0.0.53", result.DNSConfig.PrimaryDNS)
+	assert.Equal(t, "10.0.1.53", result.DNSConfig.SecondaryDNS)
+	dns.PrimaryDNS, dns.SecondaryDNS = "10.0.0.53", "10.0.1.53"
+	assert.True(t, proto.Equal(dns, result.DNSConfig), "DNS settings should be read back: %v", result.DNSConfig)
+}
+
+func Test_putDNSConfig_AutoMode(t *testing.T) {
+	servers := &v1.Interface_Dns{PrimaryDNS: "10.0.0.53"}
+	for name, test := range map[string]struct {
+		dhcp   string
+		auto   v1.Interface_Dns_AutoMode
+		ignore bool
+	}{
+		"dhcp default":     {Enabled, v1.Interface_Dns_DEFAULT, true},
+		"fallback default": {Fallback, v1.Interface_Dns_DEFAULT, true},
+		"static default":   {Disabled, v1.Interface_Dns_DEFAULT, false},
+		"dhcp merge":       {Enabled, v1.Interface_Dns_MERGE, false},
+		"dhcp ignore":      {Enabled, v1.Interface_Dns_IGNORE, true},
+	} {
+		servers.Auto = test.auto
+		settings := initializeConnectionSettings()
+		putDNSConfig(&v1.Interface{DHCP: test.dhcp, DNSConfig: servers}, settings)
+		assert.Equal(t, test.ignore, settings[IPV4Key][DNSIgnoreAutoKey] == Yes, name)
+	}
+
+	servers.Auto = v1.Interface_Dns_MERGE
+	settings := newSettingsFromProto(&v1.Interface{MacAddress: "00:0A:95:9D:68:16", DHCP: Enabled, DNSConfig: servers}, "eth0")
+	assert.Equal(t, v1.Interface_Dns_MERGE, configuredDNS(settings).Auto, "Merged DHCP servers should be read back")
+}
@@ -10,8 +10,11 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"math"
 	"net"
 	v1 "networkservice/api/siemens_iedge_dmapi_v1"
+	"regexp"
+	"slices"
 	"strings"
 )
 
@@ -40,7 +43,15 @@ func verify(newSettings *v1.NetworkSettings, configurator *NetworkConfigurator)
 		if element.DNSConfig != nil {
 			verifyDNS(element, resultOut)
 		}
+		verifyRoutes(element, resultOut)
+		verifyFallback(element, resultOut)
+		verifyDHCPOptions(element, resultOut)
+		verifyLink(element, resultOut)
+		verifyVlans(element, resultOut, configurator)
 	}
+	verifyBonds(newSettings, resultOut, configurator)
+	verifyChecks(newSettings, resultOut)
+	verifyPolicy(newSettings, resultOut, configurator)
 	errorMessages := resultOut.builder.String()
 	var err error
 	if len(errorMessages) > 0 {
@@ -88,6 +99,12 @@ func verifyStaticConf(element *v1.Interface, result *verifyResult) {
 	}
 }
 
+// The resolver caps its timeout and attempts options at these values.
+const (
+	maxDNSTimeout  = 30
+	maxDNSAttempts = 5
+)
+
 func verifyDNS(element *v1.Interface, result *verifyResult) {
 	if len(element.DNSConfig.PrimaryDNS) > 0 {
 		val := net.ParseIP(element.DNSConfig.PrimaryDNS)
@@ -103,4 +120,132 @@ func verifyDNS(element *v1.Interface, result *verifyResult) {
 			result.builder.WriteString(fmt.Sprintf("wrong dns address %s \n", element.DNSConfig.SecondaryDNS))
 		}
 	}
+	for _, server := range element.DNSConfig.Servers {
+		if net.ParseIP(server).To4() == nil {
+			result.retVal = false
+			result.builder.WriteString(fmt.Sprintf("wrong dns address %s \n", server))
+		}
+	}
+	for _, domain := range element.DNSConfig.Searches {
+		if !validHostname(strings.TrimSuffix(domain, ".")) {
+			result.retVal = false
+			result.builder.WriteString(fmt.Sprintf("wrong dns search domain %s \n", domain))
+		}
+	}
+	if element.DNSConfig.Timeout > maxDNSTimeout {
+		result.retVal = false
+		result.builder.WriteString(fmt.Sprintf("dns timeout of %s is above %d seconds: %d \n", interfaceIdentifier(element), maxDNSTimeout, element.DNSConfig.Timeout))
+	}
+	if element.DNSConfig.Attempts > maxDNSAttempts {
+		result.retVal = false
+		result.builder.WriteString(fmt.Sprintf("dns attempts of %s are above %d: %d \n", interfaceIdentifier(element), maxDNSAttempts, element.DNSConfig.Attempts))
+	}
+	if _, ok := v1.Interface_Dns_AutoMode_name[int32(element.DNSConfig.Auto)]; !ok {
+		result.retVal = false
+		result.builder.WriteString(fmt.Sprintf("unknown dns auto mode %d of %s \n", element.DNSConfig.Auto, interfaceIdentifier(element)))
+	}
+}
+
+func verifyRoutes(element *v1.Interface, result *verifyResult) {
+	for _, route := range element.Routes {
+		_, destination, err := net.ParseCIDR(route.Destination)
+		if err != nil || destination.IP.To4() == nil {
+			result.retVal = false
+			result.builder.WriteString(fmt.Sprintf("wrong route destination %s \n", route.Destination))
+		}
+		if len(route.NextHop) > 0 && net.ParseIP(route.NextHop) == nil {
+			result.retVal = false
+			result.builder.WriteString(fmt.Sprintf("wrong route next hop %s \n", route.NextHop))
+		}
+	}
+}
+
+func verifyFallback(element *v1.Interface, result *verifyResult) {
+	if element.DHCP != Fallback || element.GetFallback().GetLinkLocal() {
+		return
+	}
+	static := element.GetFallback().GetStatic()
+	if static.GetIPv4() == "" || static.GetNetMask() == "" {
+		result.retVal = false
+		result.builder.WriteString(fmt.Sprintf("fallback of %s needs a static address or link-local \n", interfaceIdentifier(element)))
+		return
+	}
+	verifyStaticConf(&v1.Interface{Static: static}, result)
+}
+
+// dhcpClientIDKeywords are the client IDs NetworkManager derives from the device, other client IDs are given as hex bytes.
+var dhcpClientIDKeywords = []string{"mac", "perm-mac", "duid", "ipv6-duid", "stable", "none"}
+
+// dhcpClientIDBytes matches a client ID given as hex bytes, e.g: 01:00:11:22:33:44:55
+var dhcpClientIDBytes = regexp.MustCompile(`^[0-9A-Fa-f]{2}(:[0-9A-Fa-f]{2})+$`)
+
+func verifyDHCPOptions(element *v1.Interface, result *verifyResult) {
+	options := element.GetDHCPOptions()
+	if options == nil {
+		return
+	}
+	identifier := interfaceIdentifier(element)
+	if element.DHCP == Disabled {
+		result.retVal = false
+		result.builder.WriteString(fmt.Sprintf("DHCP options of %s need DHCP %s or %s \n", identifier, Enabled, Fallback))
+	}
+	if options.Hostname != "" && !validHostname(options.Hostname) {
+		result.retVal = false
+		result.builder.WriteString(fmt.Sprintf("wrong DHCP hostname %s \n", options.Hostname))
+	}
+	for name, value := range map[string]string{"SendHostname": options.SendHostname, "MayFail": options.MayFail} {
+		if value != "" && value != Enabled && value != Disabled {
+			result.retVal = false
+			result.builder.WriteString(fmt.Sprintf("%s of %s has to be %s or %s, not %s \n", name, identifier, Enabled, Disabled, value))
+		}
+	}
+	if options.ClientID != "" && !dhcpClientIDBytes.MatchString(options.ClientID) && !slices.Contains(dhcpClientIDKeywords, options.ClientID) {
+		result.retVal = false
+		result.builder.WriteString(fmt.Sprintf("wrong DHCP client id %s \n", options.ClientID))
+	}
+	if len(options.VendorClass) > 255 || strings.IndexFunc(options.VendorClass, func(r rune) bool { return r < ' ' || r > '~' }) >= 0 {
+		result.retVal = false
+		result.builder.WriteString(fmt.Sprintf("wrong DHCP vendor class %s \n", options.VendorClass))
+	}
+	if options.Timeout > math.MaxInt32 {
+		result.retVal = false
+		result.builder.WriteString(fmt.Sprintf("DHCP timeout of %s is too long: %d \n", identifier, options.Timeout))
+	}
+}
+
+// validHostname checks if the name is a valid DNS hostname, e.g: ipc-station-12.plant.local
+func validHostname(name string) bool {
+	if len(name) > 253 {
+		return false
+	}
+	for _, label := range strings.Split(name, ".") {
+		if len(label) == 0 || len(label) > 63 || label[0] == '-' || label[len(label)-1] == '-' {
+			return false
+		}
+		if strings.IndexFunc(label, func(r rune) bool {
+			return !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '-')
+		}) >= 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func verifyChecks(newSettings *v1.NetworkSettings, result *verifyResult) {
+	for _, check := range newSettings.GetOptions().GetChecks() {
+		valid := true
+		switch check.Type {
+		case v1.ConnectivityCheck_GATEWAY:
+			valid = check.Target == "" || net.ParseIP(check.Target).To4() != nil
+		case v1.ConnectivityCheck_DNS:
+			valid = check.Target != ""
+		case v1.ConnectivityCheck_TCP:
+			_, port, err := net.SplitHostPort(check.Target)
+			valid = err == nil && port != ""
+		}
+		if !valid {
+			result.retVal = false
+			result.builder.WriteString(fmt.Sprintf("wrong %v check target %s \n", check.Type, check.Target))
+		}
+	}
 }
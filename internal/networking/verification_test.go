@@ -139,6 +139,19 @@ func TestVerifyDNS_SecondaryDNSInvalid(t *testing.T) {
 	assert.Equal(t, result.builder.String(), "wrong dns address invalid-ip-address \n", "verifyDNS should append an error message for invalid SecondaryDNS address")
 }
 
+func TestVerifyRoutes_Invalid(t *testing.T) {
+	input := &v1.Interface{Routes: []*v1.Interface_Route{
+		{Destination: "10.0.0.0/8", NextHop: "192.168.1.254"},
+		{Destination: "10.0.0.0", NextHop: "invalid-ip-address"},
+	}}
+	result := createMockVerifyResult(true)
+
+	verifyRoutes(input, result)
+
+	assert.False(t, result.retVal, "verifyRoutes should return false when a route is invalid")
+	assert.Equal(t, "wrong route destination 10.0.0.0 \nwrong route next hop invalid-ip-address \n", result.builder.String())
+}
+
 func TestVerify_AllConditionsValid(t *testing.T) {
 	input := &v1.NetworkSettings{
 		Interfaces: []*v1.Interface{
@@ -246,3 +259,45 @@ func TestVerify_DNSInvalid(t *testing.T) {
 	assert.False(t, valid, "verify should return false when DNSConfig is invalid")
 	assert.Error(t, err, "verify should return an error when DNS")
 }
+
+func TestVerifyDHCPOptions(t *testing.T) {
+	result := createMockVerifyResult(true)
+	verifyDHCPOptions(&v1.Interface{Label: "X1", DHCP: Enabled, DHCPOptions: &v1.Interface_DHCPConf{
+		Hostname: "ipc-station-12.plant.local", SendHostname: Enabled, ClientID: "01:00:11:22:33:44:55",
+		VendorClass: "SIEMENS-IPC", Timeout: 60, MayFail: Disabled}}, result)
+	verifyDHCPOptions(&v1.Interface{Label: "X2", DHCP: Fallback, DHCPOptions: &v1.Interface_DHCPConf{ClientID: "duid"}}, result)
+	assert.True(t, result.retVal, result.builder.String())
+
+	for name, element := range map[string]*v1.Interface{
+		"dhcp disabled": {Label: "X1", DHCP: Disabled, DHCPOptions: &v1.Interface_DHCPConf{Hostname: "ipc"}},
+		"hostname":      {Label: "X1", DHCPOptions: &v1.Interface_DHCPConf{Hostname: "ipc_station"}},
+		"send hostname": {Label: "X1", DHCPOptions: &v1.Interface_DHCPConf{SendHostname: "yes"}},
+		"client id":     {Label: "X1", DHCPOptions: &v1.Interface_DHCPConf{ClientID: "01:0g"}},
+		"vendor class":  {Label: "X1", DHCPOptions: &v1.Interface_DHCPConf{VendorClass: "IPC\n"}},
+		"timeout":       {Label: "X1", DHCPOptions: &v1.Interface_DHCPConf{Timeout: 1 << 31}},
+	} {
+		result := createMockVerifyResult(true)
+		verifyDHCPOptions(element, result)
+		assert.False(t, result.retVal, name)
+	}
+}
+
+func TestVerifyDNSSettings(t *testing.T) {
+	result := createMockVerifyResult(true)
+	verifyDNS(&v1.Interface{Label: "X1", DNSConfig: &v1.Interface_Dns{Servers: []string{"10.0.0.53", "10.0.1.53", "9.9.9.9"},
+		Searches: []string{"plant.local", "siemens.com."}, Priority: -10, Timeout: 30, Attempts: 5, Rotate: true,
+		Auto: v1.Interface_Dns_MERGE}}, result)
+	assert.True(t, result.retVal, result.builder.String())
+
+	for name, dns := range map[string]*v1.Interface_Dns{
+		"server":        {Servers: []string{"10.0.0.53", "fd00::53"}},
+		"search domain": {Searches: []string{"plant_local"}},
+		"timeout":       {Timeout: 31},
+		"attempts":      {Attempts: 6},
+		"auto mode":     {Auto: 3},
+	} {
+		result := createMockVerifyResult(true)
+		verifyDNS(&v1.Interface{Label: "X1", DNSConfig: dns}, result)
+		assert.False(t, result.retVal, name)
+	}
+}
@@ -0,0 +1,338 @@
+/*
+ * Copyright © Siemens 2025. ALL RIGHTS RESERVED.
+ * Licensed under the MIT license
+ * See LICENSE file in the top-level directory
+ */
+
+package networking
+
+import (
+	"fmt"
+	"log"
+	v1 "networkservice/api/siemens_iedge_dmapi_v1"
+	"slices"
+	"strings"
+	"time"
+
+	nm "github.com/Wifx/gonetworkmanager/v2"
+	"github.com/google/uuid"
+)
+
+const (
+	// maxVlanID is the highest VLAN ID, 0 and 4095 are reserved
+	maxVlanID = 4094
+	// maxInterfaceNameLength is the longest interface name the kernel accepts
+	maxInterfaceNameLength = 15
+)
+
+// vlanBackup holds the VLAN profiles of this service on a parent interface, as they were before an apply.
+type vlanBackup struct {
+	parent   string
+	profiles []nm.ConnectionSettings
+}
+
+// vlanName returns the interface name of the VLAN, "<parent>.<ID>" if none is given.
+func vlanName(parent string, vlan *v1.Interface_VlanConf) string {
+	if vlan.Name != "" {
+		return vlan.Name
+	}
+	return fmt.Sprintf("%s.%d", parent, vlan.ID)
+}
+
+// vlanID returns the VLAN ID of the profile, or 0 if it is no VLAN profile.
+func vlanID(settings nm.ConnectionSettings) uint32 {
+	id, _ := settings[VlanType][VlanIDKey].(uint32)
+	return id
+}
+
+// vlanConnections returns the VLAN profiles on the given parent interface, split into the ones of this service
+// and the ones of other tools.
+func vlanConnections(parent string) (managed, foreign []nm.Connection, err error) {
+	connections, err := listAllConnections()
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, connection := range connections {
+		settings, err := connection.GetSettings()
+		if err != nil || settings[ConnectionKey][TypeKey] != VlanType || settingString(settings[VlanType][VlanParentKey]) != parent {
+			continue
+		}
+		if isServiceProfile(settings) {
+			managed = append(managed, connection)
+		} else {
+			foreign = append(foreign, connection)
+		}
+	}
+	return managed, foreign, nil
+}
+
+// newVlanSettings creates the profile of a VLAN on the given parent interface. The IPv4 and DNS settings are
+// put like the ones of an Ethernet interface.
+func newVlanSettings(parent string, vlan *v1.Interface_VlanConf) nm.ConnectionSettings {
+	protoData := &v1.Interface{DHCP: vlan.DHCP, Static: vlan.Static, DNSConfig: vlan.DNSConfig}
+	connection := initializeConnectionSettings()
+	delete(connection, EthernetType)
+	ipAssignmentMethod := determineIpAssignmentMethod(protoData)
+	applyConnectionSetting(ipAssignmentMethod, protoData, connection)
+
+	name := vlanName(parent, vlan)
+	connection[ConnectionKey][IDKey] = fmt.Sprintf("%s_%s", name, ipAssignmentMethod)
+	connection[ConnectionKey][UUIDKey] = uuid.New().String()
+	connection[ConnectionKey][TimeStampKey] = time.Now().Unix()
+	connection[ConnectionKey][TypeKey] = VlanType
+	connection[ConnectionKey][InterfaceNameKey] = name
+	connection[VlanType] = map[string]interface{}{VlanParentKey: parent, VlanIDKey: vlan.ID}
+	tagOwnership(connection)
+	if vlan.Label != "" {
+		userData(connection)[LabelTagKey] = vlan.Label
+	}
+	return connection
+}
+
+// vlanFromSettings converts a VLAN profile to its proto representation. Static addresses are read from the profile.
+func vlanFromSettings(settings nm.ConnectionSettings) *v1.Interface_VlanConf {
+	vlan := &v1.Interface_VlanConf{
+		ID:        vlanID(settings),
+		Name:      settingString(settings[ConnectionKey][InterfaceNameKey]),
+		Label:     userData(settings)[LabelTagKey],
+		Parent:    settingString(settings[VlanType][VlanParentKey]),
+		DNSConfig: configuredDNS(settings),
+	}
+	if settings[IPV4Key][MethodKey] == Auto {
+		vlan.DHCP = Enabled
+	} else {
+		vlan.DHCP = Disabled
+		if _, ok := settings[IPV4Key][AddressDataKey].([]map[string]interface{}); ok {
+			vlan.Static = parseStaticIPConfig(settings)
+		}
+	}
+	return vlan
+}
+
+// sameVlanProfile reports whether the current VLAN profile already has the desired settings.
+func sameVlanProfile(current, desired nm.ConnectionSettings) bool {
+	return equivalentSettings(current, desired) &&
+		settingString(current[ConnectionKey][InterfaceNameKey]) == settingString(desired[ConnectionKey][InterfaceNameKey]) &&
+		userData(current)[LabelTagKey] == userData(desired)[LabelTagKey]
+}
+
+// readVlans returns the VLANs on the given parent interface, ordered by their IDs, or nil if there are none.
+func readVlans(parent string) *v1.Interface_VlanList {
+	managed, foreign, err := vlanConnections(parent)
+	if err != nil {
+		log.Printf("could not read VLAN profiles of %v: %v", parent, err)
+		return nil
+	}
+
+	list := &v1.Interface_VlanList{}
+	for _, connection := range append(managed, foreign...) {
+		settings, err := connection.GetSettings()
+		if err != nil {
+			continue
+		}
+		vlan := vlanFromSettings(settings)
+		vlan.Active = readLinkStatus(SysClassNetPath, vlan.Name).GetCarrier()
+		list.Interfaces = append(list.Interfaces, vlan)
+	}
+	if len(list.Interfaces) == 0 {
+		return nil
+	}
+	slices.SortFunc(list.Interfaces, func(a, b *v1.Interface_VlanConf) int { return int(a.ID) - int(b.ID) })
+	return list
+}
+
+// applyVlans replaces the VLAN profiles of this service on the interface by the given VLANs. Unchanged profiles are
+// kept, profiles of other tools with the same ID or name are handled according to the policy. The previous profiles
+// are returned as backup, also if the apply fails.
+func (nc *NetworkConfigurator) applyVlans(protoData *v1.Interface, policy v1.ApplyOptions_ForeignProfilePolicy) (*vlanBackup, error) {
+	device, err := nc.getDeviceBy(protoData)
+	if err != nil {
+		return nil, err
+	}
+	if device == nil {
+		return nil, fmt.Errorf("device does not exist for the VLANs of %s", interfaceIdentifier(protoData))
+	}
+	parent, err := device.GetPropertyInterface()
+	if err != nil {
+		return nil, err
+	}
+	managed, foreign, err := vlanConnections(parent)
+	if err != nil {
+		return nil, fmt.Errorf("could not read VLAN profiles of %v: %w", parent, err)
+	}
+
+	backup := &vlanBackup{parent: parent}
+	current := make(map[uint32]nm.Connection)
+	var obsolete []nm.Connection
+	for _, connection := range managed {
+		settings, err := connection.GetSettings()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get settings for connection: %w", err)
+		}
+		backup.profiles = append(backup.profiles, copySettings(settings))
+		if !slices.ContainsFunc(protoData.Vlans.GetInterfaces(), func(vlan *v1.Interface_VlanConf) bool { return vlan.ID == vlanID(settings) }) {
+			obsolete = append(obsolete, connection)
+		} else {
+			current[vlanID(settings)] = connection
+		}
+	}
+
+	if err := nc.handleForeignVlans(parent, protoData.Vlans, foreign, policy); err != nil {
+		return backup, err
+	}
+	if err := nc.deleteOldConnections(obsolete); err != nil {
+		return backup, err
+	}
+	for _, vlan := range protoData.Vlans.GetInterfaces() {
+		if err := nc.putVlanProfile(current[vlan.ID], newVlanSettings(parent, vlan)); err != nil {
+			return backup, fmt.Errorf("could not configure VLAN %d of %v: %w", vlan.ID, parent, err)
+		}
+	}
+	log.Printf("%d VLANs configured on %v", len(protoData.Vlans.GetInterfaces()), parent)
+	return backup, nil
+}
+
+// handleForeignVlans applies the policy to the VLAN profiles of other tools, which have the ID or the name of a given VLAN.
+func (nc *NetworkConfigurator) handleForeignVlans(parent string, vlans *v1.Interface_VlanList, foreign []nm.Connection,
+	policy v1.ApplyOptions_ForeignProfilePolicy) error {
+	var conflicting []nm.Connection
+	for _, connection := range foreign {
+		settings, err := connection.GetSettings()
+		if err != nil {
+			return fmt.Errorf("failed to get settings for connection: %w", err)
+		}
+		name := settingString(settings[ConnectionKey][InterfaceNameKey])
+		if slices.ContainsFunc(vlans.GetInterfaces(), func(vlan *v1.Interface_VlanConf) bool {
+			return vlan.ID == vlanID(settings) || vlanName(parent, vlan) == name
+		}) {
+			conflicting = append(conflicting, connection)
+		}
+	}
+
+	if policy == v1.ApplyOptions_DELETE {
+		return nc.deleteOldConnections(conflicting)
+	}
+	return handleForeignProfiles(conflicting, policy)
+}
+
+// putVlanProfile updates the given VLAN profile to the settings, or adds a new one if there is none, and activates it.
+// A profile which already has the settings is left as it is.
+func (nc *NetworkConfigurator) putVlanProfile(connection nm.Connection, settings nm.ConnectionSettings) error {
+	if connection == nil {
+		settingsM, err := nm.NewSettings()
+		if err != nil {
+			return err
+		}
+		if connection, err = settingsM.AddConnection(settings); err != nil {
+			return err
+		}
+	} else {
+		current, err := connection.GetSettings()
+		if err != nil {
+			return err
+		}
+		if sameVlanProfile(current, settings) {
+			return nil
+		}
+		settings[ConnectionKey][UUIDKey] = current[ConnectionKey][UUIDKey]
+		if err := connection.Update(settings); err != nil {
+			return err
+		}
+	}
+
+	if _, err := nc.gnm.ActivateConnection(connection, nil, nil); err != nil {
+		log.Println("VLAN configuration applied, but could not activated since: ", err)
+	}
+	return nil
+}
+
+// restoreVlans replaces the VLAN profiles of this service on the parent interface by the ones of the backup.
+func (nc *NetworkConfigurator) restoreVlans(backup *vlanBackup) error {
+	managed, _, err := vlanConnections(backup.parent)
+	if err != nil {
+		return err
+	}
+	if err := nc.deleteOldConnections(managed); err != nil {
+		return err
+	}
+
+	settingsM, err := nm.NewSettings()
+	if err != nil {
+		return err
+	}
+	for _, profile := range backup.profiles {
+		settings := copySettings(profile)
+		removeDeprecatedKeys(settings)
+		connection, err := settingsM.AddConnection(settings)
+		if err != nil {
+			log.Printf("restoreVlans failed for %v: %v", settings[ConnectionKey][IDKey], err)
+			return err
+		}
+		if _, err := nc.gnm.ActivateConnection(connection, nil, nil); err != nil {
+			log.Println("VLAN restored, but could not activated since: ", err)
+		}
+	}
+	log.Printf("restoreVlans success for %v", backup.parent)
+	return nil
+}
+
+// validInterfaceName reports whether the kernel accepts the name for a network interface.
+func validInterfaceName(name string) bool {
+	return name != "" && len(name) <= maxInterfaceNameLength && !strings.ContainsAny(name, "/: \t") && name != "." && name != ".."
+}
+
+// verifyVlans checks the VLANs of the interface: their IDs, names and labels have to be unique. The names are checked
+// as the VLAN interfaces get them, the default name of the parent of the interface may be too long.
+func verifyVlans(element *v1.Interface, result *verifyResult, configurator *NetworkConfigurator) {
+	identifier := interfaceIdentifier(element)
+	fail := func(format string, args ...interface{}) {
+		result.retVal = false
+		result.builder.WriteString(fmt.Sprintf(format+" \n", args...))
+	}
+	if len(element.GetVlans().GetInterfaces()) == 0 {
+		return
+	}
+	var parent string
+	if device, _ := configurator.getDeviceBy(element); device != nil {
+		parent, _ = device.GetPropertyInterface()
+	}
+
+	ids := make(map[uint32]bool)
+	names := make(map[string]bool)
+	labels := make(map[string]bool)
+	for _, vlan := range element.GetVlans().GetInterfaces() {
+		if vlan.ID < 1 || vlan.ID > maxVlanID {
+			fail("VLAN ID of %s has to be between 1 and %d, not %d", identifier, maxVlanID, vlan.ID)
+		} else if ids[vlan.ID] {
+			fail("VLAN %d of %s is given twice", vlan.ID, identifier)
+		}
+		ids[vlan.ID] = true
+
+		// without parent only the given names can be checked
+		if name := vlanName(parent, vlan); vlan.Name != "" || parent != "" {
+			if !validInterfaceName(name) {
+				fail("wrong name %s of VLAN %d of %s", name, vlan.ID, identifier)
+			} else if names[name] {
+				fail("VLAN name %s of %s is given twice", name, identifier)
+			}
+			names[name] = true
+		}
+		if vlan.Label != "" {
+			if labels[strings.ToUpper(vlan.Label)] {
+				fail("VLAN label %s of %s is given twice", vlan.Label, identifier)
+			}
+			labels[strings.ToUpper(vlan.Label)] = true
+		}
+
+		if vlan.DHCP != "" && vlan.DHCP != Enabled && vlan.DHCP != Disabled {
+			fail("DHCP of VLAN %d of %s has to be %s or %s, not %s", vlan.ID, identifier, Enabled, Disabled, vlan.DHCP)
+		}
+		settings := &v1.Interface{MacAddress: element.MacAddress, Label: element.Label, Static: vlan.Static, DNSConfig: vlan.DNSConfig}
+		if vlan.Static != nil {
+			verifyStaticConf(settings, result)
+		}
+		if vlan.DNSConfig != nil {
+			verifyDNS(settings, result)
+		}
+	}
+}
@@ -0,0 +1,219 @@
+/*
+ * Copyright © Siemens 2025. ALL RIGHTS RESERVED.
+ * Licensed under the MIT license
+ * See LICENSE file in the top-level directory
+ */
+
+package networking
+
+import (
+	v1 "networkservice/api/siemens_iedge_dmapi_v1"
+	mockgnm "networkservice/internal/networking/mocks/gonetworkmanager"
+	"reflect"
+	"strings"
+	"testing"
+
+	nm "github.com/Wifx/gonetworkmanager/v2"
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/godbus/dbus/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func getMockConnection(settings nm.ConnectionSettings) *mockgnm.MockConnection {
+	mockConnection := &mockgnm.MockConnection{}
+	mockConnection.On("GetSettings").Return(settings, nil)
+	mockConnection.On("GetPath").Return(dbus.ObjectPath("/org/freedesktop/NetworkManager/Settings/1")).Maybe()
+	return mockConnection
+}
+
+func Test_newVlanSettings_CreatesTaggedVlanProfile(t *testing.T) {
+	vlan := &v1.Interface_VlanConf{ID: 100, Label: "X1.100", DHCP: Disabled,
+		Static: &v1.Interface_StaticConf{IPv4: "10.100.0.2", NetMask: "255.255.255.0", Gateway: "10.100.0.1"}}
+
+	settings := newVlanSettings("eth0", vlan)
+
+	assert.Equal(t, VlanType, settings[ConnectionKey][TypeKey])
+	assert.Equal(t, "eth0.100", settings[ConnectionKey][InterfaceNameKey])
+	assert.Equal(t, "eth0.100_static", settings[ConnectionKey][IDKey])
+	assert.Equal(t, map[string]interface{}{VlanParentKey: "eth0", VlanIDKey: uint32(100)}, settings[VlanType])
+	assert.Equal(t, Manual, settings[IPV4Key][MethodKey])
+	assert.Equal(t, "10.100.0.1", settings[IPV4Key][GatewayKey])
+	assert.NotContains(t, settings, EthernetType)
+	assert.True(t, isServiceProfile(settings))
+	assert.Equal(t, "X1.100", userData(settings)[LabelTagKey])
+
+	named := newVlanSettings("eth0", &v1.Interface_VlanConf{ID: 200, Name: "plant", DHCP: Enabled})
+	assert.Equal(t, "plant", named[ConnectionKey][InterfaceNameKey])
+	assert.Equal(t, Auto, named[IPV4Key][MethodKey])
+	assert.NotContains(t, userData(named), LabelTagKey)
+}
+
+func Test_vlanFromSettings_ReadsProfile(t *testing.T) {
+	settings := nm.ConnectionSettings{
+		ConnectionKey: {TypeKey: VlanType, InterfaceNameKey: "eth0.100"},
+		VlanType:      {VlanParentKey: "eth0", VlanIDKey: uint32(100)},
+		IPV4Key: {MethodKey: Manual, GatewayKey: "10.100.0.1", DNSKey: []uint32{IPToUInt32LI("10.100.0.53")},
+			AddressDataKey: []map[string]interface{}{{AddressKey: "10.100.0.2", PrefixKey: uint32(24)}}},
+		UserKey: {UserDataKey: map[string]string{OwnerTagKey: OwnerServiceName, LabelTagKey: "X1.100"}},
+	}
+
+	vlan := vlanFromSettings(settings)
+
+	assert.Equal(t, &v1.Interface_VlanConf{ID: 100, Name: "eth0.100", Label: "X1.100", Parent: "eth0", DHCP: Disabled,
+		Static:    &v1.Interface_StaticConf{IPv4: "10.100.0.2", NetMask: "255.255.255.0", Gateway: "10.100.0.1"},
+		DNSConfig: &v1.Interface_Dns{PrimaryDNS: "10.100.0.53", Servers: []string{"10.100.0.53"}}}, vlan)
+}
+
+func Test_readVlans_ListsVlansOfParentOrderedByID(t *testing.T) {
+	vlan200 := getMockConnection(newVlanSettings("eth0", &v1.Interface_VlanConf{ID: 200, DHCP: Enabled}))
+	foreign := getMockConnection(nm.ConnectionSettings{
+		ConnectionKey: {IDKey: "vlan100", TypeKey: VlanType, InterfaceNameKey: "eth0.100"},
+		VlanType:      {VlanParentKey: "eth0", VlanIDKey: uint32(100)},
+		IPV4Key:       {MethodKey: Auto},
+	})
+	otherParent := getMockConnection(newVlanSettings("eth1", &v1.Interface_VlanConf{ID: 300, DHCP: Enabled}))
+	ethernet := getMockProfile("00:0A:95:9D:68:16_dhcp", "uuid", "eth0", nil, 0)
+
+	patches := gomonkey.ApplyFunc(listAllConnections, func() ([]nm.Connection, error) {
+		return []nm.Connection{vlan200, foreign, otherParent, ethernet}, nil
+	})
+	defer patches.Reset()
+
+	vlans := readVlans("eth0")
+
+	assert.Len(t, vlans.GetInterfaces(), 2)
+	assert.Equal(t, uint32(100), vlans.Interfaces[0].ID)
+	assert.Equal(t, uint32(200), vlans.Interfaces[1].ID)
+	assert.Equal(t, "eth0", vlans.Interfaces[1].Parent)
+	assert.Nil(t, readVlans("eth2"))
+}
+
+func Test_applyVlans_ReplacesVlansOfService(t *testing.T) {
+	mockNetworkManager := &mockgnm.MockNetworkManager{}
+	nc := &NetworkConfigurator{gnm: mockNetworkManager}
+	mockDevice := &mockgnm.MockDeviceWired{}
+	mockDevice.On("GetPropertyInterface").Return("eth0", nil)
+
+	unchangedVlan := &v1.Interface_VlanConf{ID: 100, DHCP: Enabled}
+	unchanged := getMockConnection(newVlanSettings("eth0", unchangedVlan))
+	obsolete := getMockConnection(newVlanSettings("eth0", &v1.Interface_VlanConf{ID: 200, DHCP: Enabled}))
+	obsolete.On("Delete").Return(nil).Once()
+	foreign := getMockConnection(nm.ConnectionSettings{
+		ConnectionKey: {IDKey: "vlan300", TypeKey: VlanType, InterfaceNameKey: "eth0.300"},
+		VlanType:      {VlanParentKey: "eth0", VlanIDKey: uint32(300)},
+		IPV4Key:       {MethodKey: Auto},
+	})
+	foreign.On("Delete").Return(nil).Once()
+	added := &mockgnm.MockConnection{}
+	mockSettings := &mockgnm.MockSettings{}
+	mockSettings.On("AddConnection", mock.MatchedBy(func(settings nm.ConnectionSettings) bool {
+		return vlanID(settings) == 300
+	})).Return(added, nil).Once()
+	mockNetworkManager.On("ActivateConnection", added, nil, mock.Anything).Return(&mockgnm.MockActiveConnection{}, nil).Once()
+
+	patches := gomonkey.NewPatches()
+	defer patches.Reset()
+	patches.ApplyPrivateMethod(reflect.TypeOf(nc), "getDeviceWithMac", func(_ *NetworkConfigurator, mac string) nm.DeviceWired {
+		return mockDevice
+	})
+	patches.ApplyFunc(listAllConnections, func() ([]nm.Connection, error) {
+		return []nm.Connection{unchanged, obsolete, foreign}, nil
+	})
+	patches.ApplyFunc(nm.NewSettings, func() (nm.Settings, error) {
+		return mockSettings, nil
+	})
+
+	protoData := &v1.Interface{MacAddress: "00:0a:95:9d:68:16", Vlans: &v1.Interface_VlanList{
+		Interfaces: []*v1.Interface_VlanConf{unchangedVlan, {ID: 300, DHCP: Enabled}}}}
+	backup, err := nc.applyVlans(protoData, v1.ApplyOptions_DELETE)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "eth0", backup.parent)
+	assert.Len(t, backup.profiles, 2)
+	obsolete.AssertExpectations(t)
+	foreign.AssertExpectations(t)
+	mockSettings.AssertExpectations(t)
+	mockNetworkManager.AssertExpectations(t)
+	unchanged.AssertNotCalled(t, "Update", mock.Anything)
+}
+
+func Test_restoreVlans_RecreatesBackedUpProfiles(t *testing.T) {
+	mockNetworkManager := &mockgnm.MockNetworkManager{}
+	nc := &NetworkConfigurator{gnm: mockNetworkManager}
+	current := getMockConnection(newVlanSettings("eth0", &v1.Interface_VlanConf{ID: 300, DHCP: Enabled}))
+	current.On("Delete").Return(nil).Once()
+	profile := newVlanSettings("eth0", &v1.Interface_VlanConf{ID: 100, DHCP: Enabled})
+	restored := &mockgnm.MockConnection{}
+	mockSettings := &mockgnm.MockSettings{}
+	mockSettings.On("AddConnection", profile).Return(restored, nil).Once()
+	mockNetworkManager.On("ActivateConnection", restored, nil, mock.Anything).Return(&mockgnm.MockActiveConnection{}, nil).Once()
+
+	patches := gomonkey.ApplyFunc(listAllConnections, func() ([]nm.Connection, error) {
+		return []nm.Connection{current}, nil
+	})
+	defer patches.Reset()
+	patches.ApplyFunc(nm.NewSettings, func() (nm.Settings, error) {
+		return mockSettings, nil
+	})
+
+	err := nc.restoreVlans(&vlanBackup{parent: "eth0", profiles: []nm.ConnectionSettings{profile}})
+
+	assert.Nil(t, err)
+	current.AssertExpectations(t)
+	mockSettings.AssertExpectations(t)
+	mockNetworkManager.AssertExpectations(t)
+}
+
+func TestVerifyVlans(t *testing.T) {
+	tests := []struct {
+		name  string
+		vlans []*v1.Interface_VlanConf
+		error string
+	}{
+		{"valid", []*v1.Interface_VlanConf{{ID: 100, Label: "X1.100", DHCP: Enabled}, {ID: 4094, Name: "plant",
+			Static: &v1.Interface_StaticConf{IPv4: "10.0.0.2", NetMask: "255.255.255.0"}}}, ""},
+		{"id zero", []*v1.Interface_VlanConf{{ID: 0}}, "VLAN ID"},
+		{"id too high", []*v1.Interface_VlanConf{{ID: 4095}}, "VLAN ID"},
+		{"duplicate id", []*v1.Interface_VlanConf{{ID: 100}, {ID: 100}}, "given twice"},
+		{"long name", []*v1.Interface_VlanConf{{ID: 100, Name: "enp2s0f1np1.1000"}}, "wrong name"},
+		{"long default name", []*v1.Interface_VlanConf{{ID: 1000}}, "wrong name enp2s0f1np1.1000"},
+		{"default name given", []*v1.Interface_VlanConf{{ID: 100}, {ID: 101, Name: "enp2s0f1np1.100"}}, "given twice"},
+		{"duplicate label", []*v1.Interface_VlanConf{{ID: 100, Label: "x1.1"}, {ID: 101, Label: "X1.1"}}, "given twice"},
+		{"fallback", []*v1.Interface_VlanConf{{ID: 100, DHCP: Fallback}}, "DHCP of VLAN"},
+		{"wrong address", []*v1.Interface_VlanConf{{ID: 100, Static: &v1.Interface_StaticConf{IPv4: "10.0.0"}}}, "wrong ip address"},
+		{"wrong dns", []*v1.Interface_VlanConf{{ID: 100, DNSConfig: &v1.Interface_Dns{Servers: []string{"dns"}}}}, "wrong dns address"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			nc := &NetworkConfigurator{}
+			device := &mockgnm.MockDeviceWired{}
+			device.On("GetPropertyInterface").Return("enp2s0f1np1", nil)
+			patches := gomonkey.ApplyPrivateMethod(reflect.TypeOf(nc), "getDeviceWithMac", func(_ *NetworkConfigurator, _ string) nm.DeviceWired {
+				return device
+			})
+			defer patches.Reset()
+
+			result := &verifyResult{retVal: true}
+			verifyVlans(&v1.Interface{MacAddress: "00:0a:95:9d:68:16", Vlans: &v1.Interface_VlanList{Interfaces: tt.vlans}}, result, nc)
+
+			assert.Equal(t, tt.error == "", result.retVal)
+			assert.True(t, strings.Contains(result.builder.String(), tt.error), result.builder.String())
+		})
+	}
+}
+
+func Test_mergeDesiredState_KeepsVlansIfNotGiven(t *testing.T) {
+	vlans := &v1.Interface_VlanList{Interfaces: []*v1.Interface_VlanConf{{ID: 100, DHCP: Enabled}}}
+	current := &v1.NetworkSettings{Interfaces: []*v1.Interface{{MacAddress: "00:0A:95:9D:68:16", DHCP: Enabled, Vlans: vlans}}}
+
+	merged := mergeDesiredState(current, &v1.NetworkSettings{Interfaces: []*v1.Interface{{MacAddress: "00:0a:95:9d:68:16", DHCP: Disabled}}})
+	assert.Equal(t, vlans, merged.Interfaces[0].Vlans)
+
+	readBack := &v1.Interface_VlanList{Interfaces: []*v1.Interface_VlanConf{{ID: 200, Parent: "eth0", Active: true}}}
+	merged = mergeDesiredState(current, &v1.NetworkSettings{Interfaces: []*v1.Interface{{MacAddress: "00:0a:95:9d:68:16", Vlans: readBack}}})
+	assert.Equal(t, []*v1.Interface_VlanConf{{ID: 200}}, merged.Interfaces[0].Vlans.Interfaces)
+
+	merged = mergeDesiredState(current, &v1.NetworkSettings{Interfaces: []*v1.Interface{{MacAddress: "00:0a:95:9d:68:16", Vlans: &v1.Interface_VlanList{}}}})
+	assert.Empty(t, merged.Interfaces[0].Vlans.GetInterfaces())
+}